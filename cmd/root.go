@@ -8,6 +8,7 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/derailed/popeye/internal/report"
 	"github.com/derailed/popeye/pkg"
@@ -61,12 +62,20 @@ func doIt(cmd *cobra.Command, args []string) {
 	zerolog.SetGlobalLevel(zerolog.DebugLevel)
 	clearScreen()
 	bomb(flags.Validate())
+	initLogs()
+	if cc := *flags.Contexts; len(cc) == 1 {
+		flags.Context = &cc[0]
+	}
 	flags.StandAlone = true
 	popeye, err := pkg.NewPopeye(flags, &log.Logger)
 	if err != nil {
 		bomb(fmt.Errorf("popeye configuration load failed %w", err))
 	}
 	bomb(popeye.Init())
+	if config.IsBoolSet(flags.List) {
+		bomb(popeye.List())
+		return
+	}
 
 	errCount, score, err := popeye.Lint()
 	if err != nil {
@@ -80,6 +89,17 @@ func doIt(cmd *cobra.Command, args []string) {
 	}
 }
 
+// initLogs sends structured logs to stderr when a log level is specified.
+func initLogs() {
+	if !config.IsStrSet(flags.LogLevel) {
+		return
+	}
+	l, err := zerolog.ParseLevel(*flags.LogLevel)
+	bomb(err)
+	zerolog.SetGlobalLevel(l)
+	log.Logger = zerolog.New(os.Stderr).With().Timestamp().Logger()
+}
+
 func bomb(err error) {
 	if err == nil {
 		return
@@ -106,7 +126,78 @@ func initPopeyeFlags() {
 
 	rootCmd.Flags().StringVarP(flags.Output, "out", "o",
 		"standard",
-		"Specify the output type (standard, jurassic, yaml, json, html, junit, score)",
+		"Specify the output type (standard, jurassic, yaml, json, jsonl, html, junit, score)",
+	)
+
+	rootCmd.Flags().BoolVarP(flags.NoColor, "no-color", "",
+		false,
+		"Disable colors. Colors are also turned off when the output is not a terminal or NO_COLOR is set",
+	)
+
+	rootCmd.Flags().StringVarP(flags.LogLevel, "log-level", "",
+		"",
+		"Emit structured scan logs to stderr at the given level (debug, info, warn, error)",
+	)
+
+	rootCmd.Flags().IntVarP(flags.MaxIssues, "max-issues-per-resource", "",
+		0,
+		"Cap the number of issues reported per resource. Scores still account for all issues",
+	)
+
+	rootCmd.Flags().StringVarP(flags.Sort, "sort", "",
+		"name",
+		"Order resources in reports by name or severity (errors first)",
+	)
+
+	rootCmd.Flags().Float32VarP(flags.APIQPS, "api-qps", "",
+		100,
+		"Max Kubernetes API requests per second",
+	)
+
+	rootCmd.Flags().IntVarP(flags.APIBurst, "api-burst", "",
+		100,
+		"Max burst of Kubernetes API requests above the QPS limit",
+	)
+
+	rootCmd.Flags().StringVarP(flags.OTLPEndpoint, "otlp-endpoint", "",
+		"",
+		"Export scan issues as OpenTelemetry log records to the given OTLP/HTTP endpoint",
+	)
+
+	rootCmd.Flags().StringVarP(flags.Webhook.URL, "webhook-url", "",
+		"",
+		"POST the JSON scan report to the given URL",
+	)
+	rootCmd.Flags().StringVarP(flags.Webhook.Token, "webhook-token", "",
+		"",
+		"Bearer token sent with webhook requests",
+	)
+	rootCmd.Flags().DurationVarP(flags.Webhook.Timeout, "webhook-timeout", "",
+		10*time.Second,
+		"Timeout for each webhook request",
+	)
+	rootCmd.Flags().IntVarP(flags.Webhook.Retries, "webhook-retries", "",
+		3,
+		"Number of webhook retries with exponential backoff",
+	)
+	rootCmd.Flags().BoolVarP(flags.Webhook.Fail, "webhook-fail", "",
+		false,
+		"Exit non-zero when the webhook post fails",
+	)
+
+	rootCmd.Flags().StringVarP(flags.HistoryFile, "history-file", "",
+		"",
+		"Append each scan score and issue counts to the given local history file",
+	)
+
+	rootCmd.Flags().BoolVarP(flags.List, "list", "",
+		false,
+		"List the resources that would be scanned given the current filters without running any checks",
+	)
+
+	rootCmd.Flags().BoolVarP(flags.TUI, "tui", "",
+		false,
+		"Browse the scan results in an interactive terminal UI",
 	)
 
 	rootCmd.Flags().BoolVarP(flags.Save, "save", "",
@@ -176,11 +267,11 @@ func initKubeConfigFlags() {
 		"Path to the kubeconfig file to use for CLI requests",
 	)
 
-	rootCmd.Flags().StringVar(
-		flags.Context,
+	rootCmd.Flags().StringSliceVar(
+		flags.Contexts,
 		"context",
-		"",
-		"The name of the kubeconfig context to use",
+		[]string{},
+		"The name of the kubeconfig context(s) to use. Multiple contexts are scanned into a combined report",
 	)
 
 	rootCmd.Flags().StringVar(
@@ -284,6 +375,12 @@ func initFlags() {
 		"",
 		"Prometheus pushgateway auth password",
 	)
+	rootCmd.Flags().Float64SliceVar(
+		flags.PushGateway.Buckets,
+		"push-gtwy-buckets",
+		config.DefaultUtilizationBuckets,
+		"Prometheus container utilization histogram buckets (current/requested ratios)",
+	)
 }
 
 // ----------------------------------------------------------------------------
@@ -23,16 +23,18 @@ type ResourceMetas map[types.GVR]metav1.APIResource
 
 // Aliases represents a collection of resource aliases.
 type Aliases struct {
-	aliases map[string]types.GVR
-	metas   ResourceMetas
-	cilium  bool
+	aliases  map[string]types.GVR
+	metas    ResourceMetas
+	glossary Linters
+	cilium   bool
 }
 
 // NewAliases returns a new instance.
 func NewAliases() *Aliases {
 	a := Aliases{
-		aliases: make(map[string]types.GVR),
-		metas:   make(ResourceMetas),
+		aliases:  make(map[string]types.GVR),
+		metas:    make(ResourceMetas),
+		glossary: NewGlossary(),
 	}
 
 	return &a
@@ -53,17 +55,19 @@ func (a *Aliases) Dump() {
 type ShortNames map[R][]string
 
 var customShortNames = ShortNames{
-	CL:  {"cl"},
-	SEC: {"sec"},
-	DP:  {"dp"},
-	CR:  {"cr"},
-	CRB: {"crb"},
-	RO:  {"ro"},
-	ROB: {"rb"},
-	NP:  {"np"},
-	GWR: {"gwr"},
-	GWC: {"gwc"},
-	GW:  {"gw"},
+	CL:   {"cl"},
+	SEC:  {"sec"},
+	DP:   {"dp"},
+	CR:   {"cr"},
+	CRB:  {"crb"},
+	RO:   {"ro"},
+	ROB:  {"rb"},
+	NP:   {"np"},
+	GWR:  {"gwr"},
+	GWC:  {"gwc"},
+	GW:   {"gw"},
+	INGC: {"ingc"},
+	EPS:  {"eps"},
 }
 
 func (a *Aliases) Inject(ss ShortNames) {
@@ -89,6 +93,11 @@ func (a *Aliases) Init(c types.Connection) error {
 	return a.loadPreferred(c)
 }
 
+// Glossary returns the linters glossary for the discovered resources.
+func (a *Aliases) Glossary() Linters {
+	return a.glossary
+}
+
 func (a *Aliases) Realize() {
 	for gvr, res := range a.metas {
 		a.aliases[res.Name] = gvr
@@ -103,13 +112,13 @@ func (a *Aliases) Realize() {
 				a.aliases[k] = gvr
 			}
 		}
-		if lgvr, ok := Glossary[R(res.SingularName)]; ok {
+		if lgvr, ok := a.glossary[R(res.SingularName)]; ok {
 			if greaterV(gvr.V(), lgvr.V()) {
-				Glossary[R(res.SingularName)] = gvr
+				a.glossary[R(res.SingularName)] = gvr
 			}
-		} else if lgvr, ok := Glossary[R(res.Name)]; ok {
+		} else if lgvr, ok := a.glossary[R(res.Name)]; ok {
 			if greaterV(gvr.V(), lgvr.V()) {
-				Glossary[R(res.Name)] = gvr
+				a.glossary[R(res.Name)] = gvr
 			}
 		}
 	}
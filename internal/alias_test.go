@@ -0,0 +1,24 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Popeye
+
+package internal
+
+import (
+	"testing"
+
+	"github.com/derailed/popeye/types"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestAliasesRealize(t *testing.T) {
+	gvr := types.NewGVR("gateway.networking.k8s.io/v1/gateways")
+	a1, a2 := NewAliases(), NewAliases()
+	a1.metas[gvr] = metav1.APIResource{Name: "gateways", SingularName: "gateway"}
+	a1.Realize()
+	a2.Realize()
+
+	assert.Equal(t, gvr, a1.Glossary()[GW])
+	assert.Equal(t, types.BlankGVR, a2.Glossary()[GW])
+	assert.Equal(t, types.BlankGVR, NewGlossary()[GW])
+}
@@ -25,7 +25,7 @@ func NewClusterRoleBinding(db *db.DB) *ClusterRoleBinding {
 
 // ClusterRoleRefs computes all clusterrole external references.
 func (c *ClusterRoleBinding) ClusterRoleRefs(refs *sync.Map) {
-	txn, it := c.db.MustITFor(internal.Glossary[internal.CRB])
+	txn, it := c.db.MustITFor(c.db.GVR(internal.CRB))
 	defer txn.Abort()
 	for o := it.Next(); o != nil; o = it.Next() {
 		crb := o.(*rbacv1.ClusterRoleBinding)
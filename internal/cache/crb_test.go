@@ -21,7 +21,7 @@ func TestClusterRoleRef(t *testing.T) {
 	l := db.NewLoader(dba)
 
 	ctx := test.MakeCtx(t)
-	assert.NoError(t, test.LoadDB[*rbacv1.ClusterRoleBinding](ctx, l.DB, "auth/crb/1.yaml", internal.Glossary[internal.CRB]))
+	assert.NoError(t, test.LoadDB[*rbacv1.ClusterRoleBinding](ctx, l.DB, "auth/crb/1.yaml", test.Glossary[internal.CRB]))
 
 	cr := cache.NewClusterRoleBinding(dba)
 	var refs sync.Map
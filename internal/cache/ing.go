@@ -27,7 +27,7 @@ func NewIngress(db *db.DB) *Ingress {
 
 // IngressRefs computes all ingress external references.
 func (d *Ingress) IngressRefs(refs *sync.Map) error {
-	txn, it := d.db.MustITFor(internal.Glossary[internal.ING])
+	txn, it := d.db.MustITFor(d.db.GVR(internal.ING))
 	defer txn.Abort()
 	for o := it.Next(); o != nil; o = it.Next() {
 		ing, ok := o.(*netv1.Ingress)
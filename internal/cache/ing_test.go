@@ -20,7 +20,7 @@ func TestIngressRefs(t *testing.T) {
 	l := db.NewLoader(dba)
 
 	ctx := test.MakeCtx(t)
-	assert.NoError(t, test.LoadDB[*netv1.Ingress](ctx, l.DB, "net/ingress/1.yaml", internal.Glossary[internal.ING]))
+	assert.NoError(t, test.LoadDB[*netv1.Ingress](ctx, l.DB, "net/ingress/1.yaml", test.Glossary[internal.ING]))
 
 	var refs sync.Map
 	ing := NewIngress(dba)
@@ -37,8 +37,8 @@ func TestClusterAllocatableMetrics(t *testing.T) {
 	l := db.NewLoader(dba)
 
 	ctx := test.MakeCtx(t)
-	assert.NoError(t, test.LoadDB[*mv1beta1.NodeMetrics](ctx, l.DB, "mx/node/1.yaml", internal.Glossary[internal.NMX]))
-	assert.NoError(t, test.LoadDB[*v1.Node](ctx, l.DB, "core/node/1.yaml", internal.Glossary[internal.NO]))
+	assert.NoError(t, test.LoadDB[*mv1beta1.NodeMetrics](ctx, l.DB, "mx/node/1.yaml", test.Glossary[internal.NMX]))
+	assert.NoError(t, test.LoadDB[*v1.Node](ctx, l.DB, "core/node/1.yaml", test.Glossary[internal.NO]))
 
 	for k := range uu {
 		u := uu[k]
@@ -25,7 +25,7 @@ func NewPod(dba *db.DB) *Pod {
 
 // PodRefs computes all pods external references.
 func (p *Pod) PodRefs(refs *sync.Map) error {
-	txn, it := p.db.MustITFor(internal.Glossary[internal.PO])
+	txn, it := p.db.MustITFor(p.db.GVR(internal.PO))
 	defer txn.Abort()
 	for o := it.Next(); o != nil; o = it.Next() {
 		po, ok := o.(*v1.Pod)
@@ -21,7 +21,7 @@ func TestPodRef(t *testing.T) {
 	l := db.NewLoader(dba)
 
 	ctx := test.MakeCtx(t)
-	assert.NoError(t, test.LoadDB[*v1.Pod](ctx, l.DB, "core/pod/1.yaml", internal.Glossary[internal.PO]))
+	assert.NoError(t, test.LoadDB[*v1.Pod](ctx, l.DB, "core/pod/1.yaml", test.Glossary[internal.PO]))
 
 	cr := cache.NewPod(dba)
 	var refs sync.Map
@@ -28,7 +28,7 @@ func NewRoleBinding(db *db.DB) *RoleBinding {
 
 // RoleRefs computes all role external references.
 func (r *RoleBinding) RoleRefs(refs *sync.Map) {
-	txn, it := r.db.MustITFor(internal.Glossary[internal.ROB])
+	txn, it := r.db.MustITFor(r.db.GVR(internal.ROB))
 	defer txn.Abort()
 	for o := it.Next(); o != nil; o = it.Next() {
 		rb := o.(*rbacv1.RoleBinding)
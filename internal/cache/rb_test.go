@@ -21,7 +21,7 @@ func TestRoleRef(t *testing.T) {
 	l := db.NewLoader(dba)
 
 	ctx := test.MakeCtx(t)
-	assert.NoError(t, test.LoadDB[*rbacv1.RoleBinding](ctx, l.DB, "auth/rob/1.yaml", internal.Glossary[internal.ROB]))
+	assert.NoError(t, test.LoadDB[*rbacv1.RoleBinding](ctx, l.DB, "auth/rob/1.yaml", test.Glossary[internal.ROB]))
 
 	cr := cache.NewRoleBinding(dba)
 	var refs sync.Map
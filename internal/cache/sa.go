@@ -24,7 +24,7 @@ func NewServiceAccount(db *db.DB) *ServiceAccount {
 
 // ServiceAccountRefs computes all serviceaccount external references.
 func (s *ServiceAccount) ServiceAccountRefs(refs *sync.Map) error {
-	txn, it := s.db.MustITFor(internal.Glossary[internal.SA])
+	txn, it := s.db.MustITFor(s.db.GVR(internal.SA))
 	defer txn.Abort()
 	for o := it.Next(); o != nil; o = it.Next() {
 		sa, ok := o.(*v1.ServiceAccount)
@@ -29,7 +29,7 @@ func NewCiliumEndpoint(dba *db.DB) *CiliumEndpoint {
 
 // CiliumEndpointRefs computes all CiliumEndpoints external references.
 func (p *CiliumEndpoint) CEPRefs(refs *sync.Map) error {
-	txn, it := p.db.MustITFor(internal.Glossary[cilium.CEP])
+	txn, it := p.db.MustITFor(p.db.GVR(cilium.CEP))
 	defer txn.Abort()
 	for o := it.Next(); o != nil; o = it.Next() {
 		cep, ok := o.(*v2.CiliumEndpoint)
@@ -3,15 +3,10 @@
 
 package cilium
 
-import (
-	"github.com/derailed/popeye/internal"
-	"github.com/derailed/popeye/types"
-)
+import "github.com/derailed/popeye/internal"
 
 func init() {
-	for _, r := range CiliumRS {
-		internal.Glossary[r] = types.BlankGVR
-	}
+	internal.Rs = append(internal.Rs, CiliumRS...)
 }
 
 const (
@@ -34,7 +34,7 @@ func NewCiliumClusterwideNetworkPolicy(c *issues.Collector, db *db.DB) *CiliumCl
 
 // Lint lints the resource.
 func (s *CiliumClusterwideNetworkPolicy) Lint(ctx context.Context) error {
-	txn, it := s.db.MustITFor(internal.Glossary[cilium.CCNP])
+	txn, it := s.db.MustITFor(s.db.GVR(cilium.CCNP))
 	defer txn.Abort()
 	for o := it.Next(); o != nil; o = it.Next() {
 		ccnp := o.(*v2.CiliumClusterwideNetworkPolicy)
@@ -114,7 +114,7 @@ func (s *CiliumClusterwideNetworkPolicy) checkNodeSel(sel api.EndpointSelector)
 func (s *CiliumClusterwideNetworkPolicy) matchNodesBySel(sel api.EndpointSelector) ([]string, error) {
 	txn := s.db.Txn(false)
 	defer txn.Abort()
-	txn, it := s.db.MustITFor(internal.Glossary[internal.NO])
+	txn, it := s.db.MustITFor(s.db.GVR(internal.NO))
 	defer txn.Abort()
 	mm := make([]string, 0, 10)
 	for o := it.Next(); o != nil; o = it.Next() {
@@ -134,7 +134,7 @@ func (s *CiliumClusterwideNetworkPolicy) matchNodesBySel(sel api.EndpointSelecto
 func (s *CiliumClusterwideNetworkPolicy) matchCEPsBySel(sel api.EndpointSelector) ([]string, error) {
 	txn := s.db.Txn(false)
 	defer txn.Abort()
-	txn, it := s.db.MustITFor(internal.Glossary[cilium.CEP])
+	txn, it := s.db.MustITFor(s.db.GVR(cilium.CEP))
 	defer txn.Abort()
 	mm := make([]string, 0, 10)
 	for o := it.Next(); o != nil; o = it.Next() {
@@ -7,7 +7,6 @@ import (
 	"testing"
 
 	v2 "github.com/cilium/cilium/pkg/k8s/apis/cilium.io/v2"
-	"github.com/derailed/popeye/internal"
 	"github.com/derailed/popeye/internal/cilium"
 	"github.com/derailed/popeye/internal/db"
 	"github.com/derailed/popeye/internal/rules"
@@ -21,8 +20,8 @@ func TestCiliumClusterwideNetworkPolicy(t *testing.T) {
 	l := db.NewLoader(dba)
 
 	ctx := test.MakeCtx(t)
-	assert.NoError(t, test.LoadDB[*v2.CiliumClusterwideNetworkPolicy](ctx, l.DB, "ccnp/1.yaml", internal.Glossary[cilium.CCNP]))
-	assert.NoError(t, test.LoadDB[*v2.CiliumEndpoint](ctx, l.DB, "cep/1.yaml", internal.Glossary[cilium.CEP]))
+	assert.NoError(t, test.LoadDB[*v2.CiliumClusterwideNetworkPolicy](ctx, l.DB, "ccnp/1.yaml", test.Glossary[cilium.CCNP]))
+	assert.NoError(t, test.LoadDB[*v2.CiliumEndpoint](ctx, l.DB, "cep/1.yaml", test.Glossary[cilium.CEP]))
 
 	li := NewCiliumClusterwideNetworkPolicy(test.MakeCollector(t), dba)
 	assert.Nil(t, li.Lint(test.MakeContext("cilium.io/v2/ciliumclusterwidenetworkpolicies", "ciliumclusterwidenetworkpolicies")))
@@ -36,7 +36,7 @@ func NewCiliumEndpoint(c *issues.Collector, db *db.DB) *CiliumEndpoint {
 
 // Lint lints the resource.
 func (s *CiliumEndpoint) Lint(ctx context.Context) error {
-	txn, it := s.db.MustITFor(internal.Glossary[cilium.CEP])
+	txn, it := s.db.MustITFor(s.db.GVR(cilium.CEP))
 	defer txn.Abort()
 	for o := it.Next(); o != nil; o = it.Next() {
 		cep := o.(*v2.CiliumEndpoint)
@@ -61,7 +61,7 @@ func (s *CiliumEndpoint) Lint(ctx context.Context) error {
 
 func (s *CiliumEndpoint) checkID(ctx context.Context, cep *v2.CiliumEndpoint) {
 	fqn := client.FQN("", strconv.Itoa(int(cep.Status.Identity.ID)))
-	_, err := s.db.Find(internal.Glossary[cilium.CID], fqn)
+	_, err := s.db.Find(s.db.GVR(cilium.CID), fqn)
 	if err != nil {
 		s.AddCode(ctx, 1700, fqn)
 	}
@@ -78,7 +78,7 @@ func (s *CiliumEndpoint) checkOwners(ctx context.Context, cep *v2.CiliumEndpoint
 		switch r.Kind {
 		case "Pod":
 			fqn := client.FQN(cep.Namespace, r.Name)
-			o, err := s.db.Find(internal.Glossary[internal.PO], fqn)
+			o, err := s.db.Find(s.db.GVR(internal.PO), fqn)
 			if err != nil {
 				s.AddCode(ctx, 1704, fqn)
 				continue
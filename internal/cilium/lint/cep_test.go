@@ -27,12 +27,12 @@ func TestCiliumEndpoint(t *testing.T) {
 	l := db.NewLoader(dba)
 
 	ctx := test.MakeCtx(t)
-	assert.NoError(t, test.LoadDB[*v2.CiliumEndpoint](ctx, l.DB, "cep/1.yaml", internal.Glossary[cilium.CEP]))
-	assert.NoError(t, test.LoadDB[*v2.CiliumIdentity](ctx, l.DB, "cid/1.yaml", internal.Glossary[cilium.CID]))
-	assert.NoError(t, test.LoadDB[*v1.Pod](ctx, l.DB, "../../../lint/testdata/core/pod/1.yaml", internal.Glossary[internal.PO]))
-	assert.NoError(t, test.LoadDB[*v1.Node](ctx, l.DB, "../../../lint/testdata/core/node/1.yaml", internal.Glossary[internal.NO]))
-	assert.NoError(t, test.LoadDB[*v1.Namespace](ctx, l.DB, "../../../lint/testdata/core/ns/1.yaml", internal.Glossary[internal.NS]))
-	assert.NoError(t, test.LoadDB[*v1.ServiceAccount](ctx, l.DB, "../../../lint/testdata/core/sa/1.yaml", internal.Glossary[internal.SA]))
+	assert.NoError(t, test.LoadDB[*v2.CiliumEndpoint](ctx, l.DB, "cep/1.yaml", test.Glossary[cilium.CEP]))
+	assert.NoError(t, test.LoadDB[*v2.CiliumIdentity](ctx, l.DB, "cid/1.yaml", test.Glossary[cilium.CID]))
+	assert.NoError(t, test.LoadDB[*v1.Pod](ctx, l.DB, "../../../lint/testdata/core/pod/1.yaml", test.Glossary[internal.PO]))
+	assert.NoError(t, test.LoadDB[*v1.Node](ctx, l.DB, "../../../lint/testdata/core/node/1.yaml", test.Glossary[internal.NO]))
+	assert.NoError(t, test.LoadDB[*v1.Namespace](ctx, l.DB, "../../../lint/testdata/core/ns/1.yaml", test.Glossary[internal.NS]))
+	assert.NoError(t, test.LoadDB[*v1.ServiceAccount](ctx, l.DB, "../../../lint/testdata/core/sa/1.yaml", test.Glossary[internal.SA]))
 
 	li := NewCiliumEndpoint(test.MakeCollector(t), dba)
 	assert.Nil(t, li.Lint(test.MakeContext("cilium.io/v2/ciliumendpoints", "ciliumendpoints")))
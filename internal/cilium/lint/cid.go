@@ -40,7 +40,7 @@ func (s *CiliumIdentity) Lint(ctx context.Context) error {
 		return err
 	}
 
-	txn, it := s.db.MustITFor(internal.Glossary[cilium.CID])
+	txn, it := s.db.MustITFor(s.db.GVR(cilium.CID))
 	defer txn.Abort()
 	for o := it.Next(); o != nil; o = it.Next() {
 		cid := o.(*v2.CiliumIdentity)
@@ -92,7 +92,7 @@ func (s *CiliumIdentity) checkNS(ctx context.Context, cid *v2.CiliumIdentity) {
 	if !ok {
 		s.AddCode(ctx, 1601, k8sNSLabel)
 	}
-	_, err := s.db.Find(internal.Glossary[internal.NS], ns)
+	_, err := s.db.Find(s.db.GVR(internal.NS), ns)
 	if err != nil {
 		s.AddCode(ctx, 1602, ns)
 		return
@@ -119,7 +119,7 @@ func (s *CiliumIdentity) checkSA(ctx context.Context, cid *v2.CiliumIdentity) er
 	txn := s.db.Txn(false)
 	defer txn.Abort()
 	saFQN := icache.FQN(ns, sa)
-	o, err := txn.First(internal.Glossary[internal.SA].String(), "id", saFQN)
+	o, err := txn.First(s.db.GVR(internal.SA).String(), "id", saFQN)
 	if err != nil || o == nil {
 		s.AddCode(ctx, 307, "CiliumIdentity", saFQN)
 		return nil
@@ -35,7 +35,7 @@ func NewCiliumNetworkPolicy(c *issues.Collector, db *db.DB) *CiliumNetworkPolicy
 
 // Lint lints the resource.
 func (s *CiliumNetworkPolicy) Lint(ctx context.Context) error {
-	txn, it := s.db.MustITFor(internal.Glossary[cilium.CNP])
+	txn, it := s.db.MustITFor(s.db.GVR(cilium.CNP))
 	defer txn.Abort()
 	for o := it.Next(); o != nil; o = it.Next() {
 		cnp := o.(*v2.CiliumNetworkPolicy)
@@ -99,7 +99,7 @@ func (s *CiliumNetworkPolicy) checkEPSel(ns string, sel api.EndpointSelector) (b
 func (s *CiliumNetworkPolicy) matchCEPsBySel(ns string, sel api.EndpointSelector) ([]string, error) {
 	txn := s.db.Txn(false)
 	defer txn.Abort()
-	txn, it := s.db.MustITForNS(internal.Glossary[cilium.CEP], ns)
+	txn, it := s.db.MustITForNS(s.db.GVR(cilium.CEP), ns)
 	defer txn.Abort()
 	mm := make([]string, 0, 10)
 	for o := it.Next(); o != nil; o = it.Next() {
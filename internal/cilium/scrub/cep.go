@@ -44,7 +44,7 @@ func (s *CiliumEndpoint) Preloads() iscrub.Preloads {
 // Lint all available CiliumEndpoints.
 func (s *CiliumEndpoint) Lint(ctx context.Context) error {
 	for k, f := range s.Preloads() {
-		if err := f(ctx, s.Loader, internal.Glossary[k]); err != nil {
+		if err := f(ctx, s.Loader, s.DB.GVR(k)); err != nil {
 			return err
 		}
 	}
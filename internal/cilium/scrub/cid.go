@@ -42,7 +42,7 @@ func (s *CiliumIdentity) Preloads() iscrub.Preloads {
 // Lint all available CiliumIdentities.
 func (s *CiliumIdentity) Lint(ctx context.Context) error {
 	for k, f := range s.Preloads() {
-		if err := f(ctx, s.Loader, internal.Glossary[k]); err != nil {
+		if err := f(ctx, s.Loader, s.DB.GVR(k)); err != nil {
 			return err
 		}
 	}
@@ -7,7 +7,6 @@ import (
 	"context"
 
 	v2 "github.com/cilium/cilium/pkg/k8s/apis/cilium.io/v2"
-	"github.com/derailed/popeye/internal"
 	"github.com/derailed/popeye/internal/cilium"
 	"github.com/derailed/popeye/internal/cilium/lint"
 	"github.com/derailed/popeye/internal/db"
@@ -39,7 +38,7 @@ func (s *CiliumNetworkPolicy) Preloads() iscrub.Preloads {
 // Lint all available CiliumNetworkPolicys.
 func (s *CiliumNetworkPolicy) Lint(ctx context.Context) error {
 	for k, f := range s.Preloads() {
-		if err := f(ctx, s.Loader, internal.Glossary[k]); err != nil {
+		if err := f(ctx, s.Loader, s.DB.GVR(k)); err != nil {
 			return err
 		}
 	}
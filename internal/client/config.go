@@ -33,6 +33,8 @@ type Config struct {
 	rawConfig      *clientcmdapi.Config
 	restConfig     *restclient.Config
 	mutex          *sync.RWMutex
+	qps            float32
+	burst          int
 }
 
 var _ types.Config = (*Config)(nil)
@@ -42,6 +44,19 @@ func NewConfig(f *genericclioptions.ConfigFlags) *Config {
 	return &Config{
 		flags: f,
 		mutex: &sync.RWMutex{},
+		qps:   defaultQPS,
+		burst: defaultBurst,
+	}
+}
+
+// SetRateLimits caps api server calls to qps requests per second with bursts
+// of up to burst calls. Non positive values retain the defaults.
+func (c *Config) SetRateLimits(qps float32, burst int) {
+	if qps > 0 {
+		c.qps = qps
+	}
+	if burst > 0 {
+		c.burst = burst
 	}
 }
 
@@ -307,8 +322,9 @@ func (c *Config) RESTConfig() (*restclient.Config, error) {
 	if c.restConfig, err = c.flags.ToRESTConfig(); err != nil {
 		return nil, err
 	}
-	c.restConfig.QPS = defaultQPS
-	c.restConfig.Burst = defaultBurst
+	c.restConfig.QPS, c.restConfig.Burst = c.qps, c.burst
+	// Share a single limiter so all clients built off this config draw from the same budget.
+	c.restConfig.RateLimiter = NewRateLimiter(c.qps, c.burst, nil)
 	c.restConfig.Timeout = defaultCallTimeoutDuration
 	restclient.SetDefaultWarningHandler(newLoggerHandler())
 	log.Debug().Msgf("Connecting to API Server %s", c.restConfig.Host)
@@ -245,3 +245,43 @@ func TestNamespaceNames(t *testing.T) {
 	assert.Equal(t, 2, len(nns))
 	assert.Equal(t, []string{"ns1", "ns2"}, nns)
 }
+
+func TestConfigRateLimits(t *testing.T) {
+	kubeConfig := "./testdata/config"
+	uu := map[string]struct {
+		qps    float32
+		burst  int
+		eQPS   float32
+		eBurst int
+	}{
+		"defaults": {
+			eQPS:   100,
+			eBurst: 100,
+		},
+		"custom": {
+			qps:    5,
+			burst:  10,
+			eQPS:   5,
+			eBurst: 10,
+		},
+		"negative": {
+			qps:    -1,
+			burst:  20,
+			eQPS:   100,
+			eBurst: 20,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			cfg := client.NewConfig(&genericclioptions.ConfigFlags{KubeConfig: &kubeConfig})
+			cfg.SetRateLimits(u.qps, u.burst)
+			rc, err := cfg.RESTConfig()
+			assert.NoError(t, err)
+			assert.Equal(t, u.eQPS, rc.QPS)
+			assert.Equal(t, u.eBurst, rc.Burst)
+			assert.Equal(t, u.eQPS, rc.RateLimiter.QPS())
+		})
+	}
+}
@@ -0,0 +1,19 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Popeye
+
+package client
+
+import (
+	"k8s.io/client-go/util/flowcontrol"
+)
+
+// NewRateLimiter returns a token bucket limiter capping api server calls to
+// qps requests per second with bursts of up to burst calls. A nil clock
+// defaults to the wall clock.
+func NewRateLimiter(qps float32, burst int, clk flowcontrol.Clock) flowcontrol.RateLimiter {
+	if clk == nil {
+		return flowcontrol.NewTokenBucketRateLimiter(qps, burst)
+	}
+
+	return flowcontrol.NewTokenBucketRateLimiterWithClock(qps, burst, clk)
+}
@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Popeye
+
+package client_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/popeye/internal/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimiter(t *testing.T) {
+	uu := map[string]struct {
+		qps   float32
+		burst int
+		calls int
+		e     time.Duration
+	}{
+		"within-burst": {
+			qps:   2,
+			burst: 5,
+			calls: 5,
+		},
+		"over-qps": {
+			qps:   2,
+			burst: 1,
+			calls: 5,
+			e:     2 * time.Second,
+		},
+		"burst-then-qps": {
+			qps:   10,
+			burst: 5,
+			calls: 15,
+			e:     time.Second,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			clk := newFakeClock()
+			l := client.NewRateLimiter(u.qps, u.burst, clk)
+			for i := 0; i < u.calls; i++ {
+				l.Accept()
+			}
+			assert.InDelta(t, u.e, clk.slept, float64(time.Millisecond))
+		})
+	}
+}
+
+// Helpers...
+
+type fakeClock struct {
+	now   time.Time
+	slept time.Duration
+}
+
+func newFakeClock() *fakeClock {
+	return &fakeClock{now: time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)}
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func (c *fakeClock) Since(t time.Time) time.Duration {
+	return c.now.Sub(t)
+}
+
+func (c *fakeClock) Sleep(d time.Duration) {
+	c.now = c.now.Add(d)
+	c.slept += d
+}
@@ -31,7 +31,7 @@ func (r *Resource) List(ctx context.Context) ([]runtime.Object, error) {
 	if !ok {
 		panic(fmt.Sprintf("BOOM no namespace in context %s", r.gvr))
 	}
-	if r.gvr == internal.Glossary[internal.NS] {
+	if internal.R(r.gvr.R()) == internal.NS {
 		ns = client.AllNamespaces
 	}
 
@@ -6,8 +6,6 @@ package db
 import (
 	"fmt"
 
-	"github.com/rs/zerolog/log"
-
 	"github.com/derailed/popeye/internal"
 	"github.com/derailed/popeye/internal/client"
 	"github.com/derailed/popeye/internal/db/schema"
@@ -15,18 +13,48 @@ import (
 	"github.com/hashicorp/go-memdb"
 	batchv1 "k8s.io/api/batch/v1"
 	v1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	nodev1 "k8s.io/api/node/v1"
+	schedv1 "k8s.io/api/scheduling/v1"
+	storagev1 "k8s.io/api/storage/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	mv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
 )
 
 type DB struct {
 	*memdb.MemDB
+	glossary internal.Linters
+	logger   internal.Logger
 }
 
-func NewDB(db *memdb.MemDB) *DB {
+func NewDB(db *memdb.MemDB, gl internal.Linters) *DB {
 	return &DB{
-		MemDB: db,
+		MemDB:    db,
+		glossary: gl,
+	}
+}
+
+// GVR returns the resource gvr for the given linter or a blank gvr if the
+// resource is not available on the cluster.
+func (db *DB) GVR(r internal.R) types.GVR {
+	if gvr, ok := db.glossary[r]; ok {
+		return gvr
+	}
+
+	return types.BlankGVR
+}
+
+// SetLogger sets the scan logger.
+func (db *DB) SetLogger(l internal.Logger) {
+	db.logger = l
+}
+
+func (db *DB) scanLogger() internal.Logger {
+	if db.logger == nil {
+		return internal.DefaultLogger()
 	}
+
+	return db.logger
 }
 
 func (db *DB) ITFor(gvr types.GVR) (*memdb.Txn, memdb.ResultIterator, error) {
@@ -64,7 +92,7 @@ func (db *DB) MustITFor(gvr types.GVR) (*memdb.Txn, memdb.ResultIterator) {
 }
 
 func (db *DB) ListNodes() (map[string]*v1.Node, error) {
-	txn, it := db.MustITFor(internal.Glossary[internal.NO])
+	txn, it := db.MustITFor(db.GVR(internal.NO))
 	defer txn.Abort()
 
 	mm := make(map[string]*v1.Node)
@@ -79,8 +107,104 @@ func (db *DB) ListNodes() (map[string]*v1.Node, error) {
 	return mm, nil
 }
 
+// ListPriorityClasses returns all priority classes keyed by name.
+func (db *DB) ListPriorityClasses() (map[string]*schedv1.PriorityClass, error) {
+	gvr := db.GVR(internal.PC)
+	if gvr == types.BlankGVR {
+		return nil, nil
+	}
+	txn, it, err := db.ITFor(gvr)
+	if err != nil {
+		return nil, err
+	}
+	defer txn.Abort()
+
+	mm := make(map[string]*schedv1.PriorityClass)
+	for o := it.Next(); o != nil; o = it.Next() {
+		pc, ok := o.(*schedv1.PriorityClass)
+		if !ok {
+			return nil, fmt.Errorf("expecting priorityclass but got %T", o)
+		}
+		mm[pc.Name] = pc
+	}
+
+	return mm, nil
+}
+
+// ListRuntimeClasses returns all runtime classes keyed by name.
+func (db *DB) ListRuntimeClasses() (map[string]*nodev1.RuntimeClass, error) {
+	gvr := db.GVR(internal.RTC)
+	if gvr == types.BlankGVR {
+		return nil, nil
+	}
+	txn, it, err := db.ITFor(gvr)
+	if err != nil {
+		return nil, err
+	}
+	defer txn.Abort()
+
+	mm := make(map[string]*nodev1.RuntimeClass)
+	for o := it.Next(); o != nil; o = it.Next() {
+		rc, ok := o.(*nodev1.RuntimeClass)
+		if !ok {
+			return nil, fmt.Errorf("expecting runtimeclass but got %T", o)
+		}
+		mm[rc.Name] = rc
+	}
+
+	return mm, nil
+}
+
+// ListIngressClasses returns all ingress classes keyed by name.
+func (db *DB) ListIngressClasses() (map[string]*netv1.IngressClass, error) {
+	gvr := db.GVR(internal.INGC)
+	if gvr == types.BlankGVR {
+		return nil, nil
+	}
+	txn, it, err := db.ITFor(gvr)
+	if err != nil {
+		return nil, err
+	}
+	defer txn.Abort()
+
+	mm := make(map[string]*netv1.IngressClass)
+	for o := it.Next(); o != nil; o = it.Next() {
+		ic, ok := o.(*netv1.IngressClass)
+		if !ok {
+			return nil, fmt.Errorf("expecting ingressclass but got %T", o)
+		}
+		mm[ic.Name] = ic
+	}
+
+	return mm, nil
+}
+
+// ListStorageClasses returns all storage classes keyed by name.
+func (db *DB) ListStorageClasses() (map[string]*storagev1.StorageClass, error) {
+	gvr := db.GVR(internal.SC)
+	if gvr == types.BlankGVR {
+		return nil, nil
+	}
+	txn, it, err := db.ITFor(gvr)
+	if err != nil {
+		return nil, err
+	}
+	defer txn.Abort()
+
+	mm := make(map[string]*storagev1.StorageClass)
+	for o := it.Next(); o != nil; o = it.Next() {
+		sc, ok := o.(*storagev1.StorageClass)
+		if !ok {
+			return nil, fmt.Errorf("expecting storageclass but got %T", o)
+		}
+		mm[sc.Name] = sc
+	}
+
+	return mm, nil
+}
+
 func (db *DB) FindPMX(fqn string) (*mv1beta1.PodMetrics, error) {
-	gvr := internal.Glossary[internal.PMX]
+	gvr := db.GVR(internal.PMX)
 	if gvr == types.BlankGVR {
 		return nil, nil
 	}
@@ -100,7 +224,7 @@ func (db *DB) FindPMX(fqn string) (*mv1beta1.PodMetrics, error) {
 }
 
 func (db *DB) FindNMX(fqn string) (*mv1beta1.NodeMetrics, error) {
-	gvr := internal.Glossary[internal.NMX]
+	gvr := db.GVR(internal.NMX)
 	if gvr == types.BlankGVR {
 		return nil, nil
 	}
@@ -120,7 +244,7 @@ func (db *DB) FindNMX(fqn string) (*mv1beta1.NodeMetrics, error) {
 }
 
 func (db *DB) ListNMX() ([]*mv1beta1.NodeMetrics, error) {
-	gvr := internal.Glossary[internal.NMX]
+	gvr := db.GVR(internal.NMX)
 	if gvr == types.BlankGVR {
 		return nil, nil
 	}
@@ -147,7 +271,7 @@ func (db *DB) Find(kind types.GVR, fqn string) (any, error) {
 	defer txn.Abort()
 	o, err := txn.First(kind.String(), "id", fqn)
 	if err != nil || o == nil {
-		log.Error().Err(err).Msgf("db.find unable to find object: [%s]%s", kind, fqn)
+		db.scanLogger().Log(internal.ErrorLog, "db find failed", "gvr", kind.String(), "fqn", fqn, "error", err)
 		return nil, fmt.Errorf("object not found: %q", fqn)
 	}
 
@@ -158,18 +282,19 @@ func (db *DB) Dump(gvr types.GVR) {
 	txn, it := db.MustITFor(gvr)
 	defer txn.Abort()
 
-	log.Debug().Msgf("> Dumping %q", gvr)
+	l := db.scanLogger()
+	l.Log(internal.DebugLog, "db dump started", "gvr", gvr.String())
 	for o := it.Next(); o != nil; o = it.Next() {
 		m := o.(schema.MetaAccessor)
-		log.Debug().Msgf("  o %s/%s", m.GetNamespace(), m.GetName())
+		l.Log(internal.DebugLog, "db dump", "gvr", gvr.String(), "fqn", client.FQN(m.GetNamespace(), m.GetName()))
 	}
-	log.Debug().Msg("< Done")
+	l.Log(internal.DebugLog, "db dump completed", "gvr", gvr.String())
 }
 
 func (db *DB) FindPod(ns string, sel map[string]string) (*v1.Pod, error) {
 	txn := db.Txn(false)
 	defer txn.Abort()
-	txn, it := db.MustITFor(internal.Glossary[internal.PO])
+	txn, it := db.MustITFor(db.GVR(internal.PO))
 	defer txn.Abort()
 	for o := it.Next(); o != nil; o = it.Next() {
 		po, ok := o.(*v1.Pod)
@@ -190,7 +315,7 @@ func (db *DB) FindPod(ns string, sel map[string]string) (*v1.Pod, error) {
 func (db *DB) FindJobs(fqn string) ([]*batchv1.Job, error) {
 	txn := db.Txn(false)
 	defer txn.Abort()
-	txn, it := db.MustITFor(internal.Glossary[internal.JOB])
+	txn, it := db.MustITFor(db.GVR(internal.JOB))
 	defer txn.Abort()
 
 	cns, cn := client.Namespaced(fqn)
@@ -219,7 +344,7 @@ func (db *DB) FindJobs(fqn string) ([]*batchv1.Job, error) {
 func (db *DB) FindPods(ns string, sel map[string]string) ([]*v1.Pod, error) {
 	txn := db.Txn(false)
 	defer txn.Abort()
-	txn, it := db.MustITFor(internal.Glossary[internal.PO])
+	txn, it := db.MustITFor(db.GVR(internal.PO))
 	defer txn.Abort()
 	pp := make([]*v1.Pod, 0, 10)
 	for o := it.Next(); o != nil; o = it.Next() {
@@ -245,7 +370,7 @@ func (db *DB) FindPodsBySel(ns string, sel *metav1.LabelSelector) ([]*v1.Pod, er
 
 	txn := db.Txn(false)
 	defer txn.Abort()
-	txn, it := db.MustITFor(internal.Glossary[internal.PO])
+	txn, it := db.MustITFor(db.GVR(internal.PO))
 	defer txn.Abort()
 	pp := make([]*v1.Pod, 0, 10)
 	for o := it.Next(); o != nil; o = it.Next() {
@@ -271,7 +396,7 @@ func (db *DB) FindNSBySel(sel *metav1.LabelSelector) ([]*v1.Namespace, error) {
 
 	txn := db.Txn(false)
 	defer txn.Abort()
-	txn, it := db.MustITFor(internal.Glossary[internal.NS])
+	txn, it := db.MustITFor(db.GVR(internal.NS))
 	defer txn.Abort()
 	nss := make([]*v1.Namespace, 0, 10)
 	for o := it.Next(); o != nil; o = it.Next() {
@@ -290,7 +415,7 @@ func (db *DB) FindNSBySel(sel *metav1.LabelSelector) ([]*v1.Namespace, error) {
 func (db *DB) FindNS(ns string) (*v1.Namespace, error) {
 	txn := db.Txn(false)
 	defer txn.Abort()
-	o, err := txn.First(internal.Glossary[internal.NS].String(), "ns", ns)
+	o, err := txn.First(db.GVR(internal.NS).String(), "ns", ns)
 	if err != nil {
 		return nil, err
 	}
@@ -309,7 +434,7 @@ func (db *DB) FindNSNameBySel(sel *metav1.LabelSelector) ([]string, error) {
 
 	txn := db.Txn(false)
 	defer txn.Abort()
-	txn, it := db.MustITFor(internal.Glossary[internal.NS])
+	txn, it := db.MustITFor(db.GVR(internal.NS))
 	defer txn.Abort()
 	nss := make([]string, 0, 10)
 	for o := it.Next(); o != nil; o = it.Next() {
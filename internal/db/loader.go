@@ -9,12 +9,12 @@ import (
 	"fmt"
 	"strings"
 	"sync"
+	"sync/atomic"
 
 	"github.com/derailed/popeye/internal"
 	"github.com/derailed/popeye/internal/client"
 	"github.com/derailed/popeye/internal/dao"
 	"github.com/derailed/popeye/types"
-	"github.com/rs/zerolog/log"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -26,6 +26,7 @@ type CastFn[T any] func(o runtime.Object) (*T, error)
 type Loader struct {
 	DB     *DB
 	loaded map[types.GVR]struct{}
+	calls  atomic.Int64
 	mx     sync.RWMutex
 }
 
@@ -38,6 +39,11 @@ func NewLoader(db *DB) *Loader {
 	return &l
 }
 
+// Calls returns the number of resource load requests.
+func (l *Loader) Calls() int64 {
+	return l.calls.Load()
+}
+
 func (l *Loader) isLoaded(gvr types.GVR) bool {
 	l.mx.RLock()
 	defer l.mx.RUnlock()
@@ -56,6 +62,7 @@ func (l *Loader) setLoaded(gvr types.GVR) {
 
 // LoadResource loads resource and save to db.
 func LoadResource[T metav1.ObjectMetaAccessor](ctx context.Context, l *Loader, gvr types.GVR) error {
+	l.calls.Add(1)
 	if l.isLoaded(gvr) || gvr == types.BlankGVR {
 		return nil
 	}
@@ -112,14 +119,14 @@ func Save[T metav1.ObjectMetaAccessor](ctx context.Context, dba *DB, gvr types.G
 }
 
 func (l *Loader) LoadPodMX(ctx context.Context) error {
-	pmxGVR := internal.Glossary[internal.PMX]
+	pmxGVR := l.DB.GVR(internal.PMX)
 	if l.isLoaded(pmxGVR) {
 		return nil
 	}
 
 	c := mustExtractFactory(ctx).Client()
 
-	log.Debug().Msg("PRELOAD PMX")
+	internal.ExtractLogger(ctx).Log(internal.DebugLog, "preload", "gvr", pmxGVR.String())
 	ll, err := l.fetchPodsMetrics(c)
 	if err != nil {
 		return err
@@ -142,11 +149,11 @@ func (l *Loader) LoadNodeMX(ctx context.Context) error {
 		return nil
 	}
 
-	nmxGVR := internal.Glossary[internal.NMX]
+	nmxGVR := l.DB.GVR(internal.NMX)
 	if l.isLoaded(nmxGVR) {
 		return nil
 	}
-	log.Debug().Msg("PRELOAD NMX")
+	internal.ExtractLogger(ctx).Log(internal.DebugLog, "preload", "gvr", nmxGVR.String())
 	ll, err := l.fetchNodesMetrics(c)
 	if err != nil {
 		return err
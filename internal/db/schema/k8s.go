@@ -9,11 +9,11 @@ import (
 	"github.com/hashicorp/go-memdb"
 )
 
-// Init initializes db tables.
-func Init() *memdb.DBSchema {
+// Init initializes db tables for the resources available in the glossary.
+func Init(gl internal.Linters) *memdb.DBSchema {
 	var sc memdb.DBSchema
 	sc.Tables = make(map[string]*memdb.TableSchema)
-	for _, gvr := range internal.Glossary {
+	for _, gvr := range gl {
 		if gvr == types.BlankGVR {
 			continue
 		}
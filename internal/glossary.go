@@ -12,14 +12,6 @@ import (
 
 type R string
 
-var Glossary = make(Linters)
-
-func init() {
-	for _, r := range Rs {
-		Glossary[r] = types.BlankGVR
-	}
-}
-
 const (
 	CM   R = "configmaps"
 	CL   R = "cluster"
@@ -51,15 +43,33 @@ const (
 	GW   R = "gateways"
 	GWC  R = "gatewayclasses"
 	GWR  R = "httproutes"
+	PC   R = "priorityclasses"
+	RTC  R = "runtimeclasses"
+	SC   R = "storageclasses"
+	INGC R = "ingressclasses"
+	EPS  R = "endpointslices"
+	RCL  R = "resourceclaims"
+	RCT  R = "resourceclaimtemplates"
 )
 
 var Rs = []R{
 	CL, CM, EP, NS, NO, PV, PVC, PO, SEC, SA, SVC, DP, DS, RS, STS, CR,
-	CRB, RO, ROB, ING, NP, PDB, HPA, PMX, NMX, CJOB, JOB, GW, GWC, GWR,
+	CRB, RO, ROB, ING, NP, PDB, HPA, PMX, NMX, CJOB, JOB, GW, GWC, GWR, PC,
+	RTC, SC, INGC, EPS, RCL, RCT,
 }
 
 type Linters map[R]types.GVR
 
+// NewGlossary returns a glossary with all linted resources yet to be discovered.
+func NewGlossary() Linters {
+	ll := make(Linters, len(Rs))
+	for _, r := range Rs {
+		ll[r] = types.BlankGVR
+	}
+
+	return ll
+}
+
 func (ll Linters) Dump() {
 	log.Debug().Msg("\nLinters...")
 	kk := make([]R, 0, len(ll))
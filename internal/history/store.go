@@ -0,0 +1,120 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Popeye
+
+package history
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+)
+
+const historyFileMode = 0644
+
+// Scan tracks a scan summary.
+type Scan struct {
+	Timestamp time.Time `json:"timestamp"`
+	Cluster   string    `json:"cluster,omitempty"`
+	Context   string    `json:"context,omitempty"`
+	Score     int       `json:"score"`
+	Grade     string    `json:"grade"`
+	Errors    int       `json:"errors"`
+	Warnings  int       `json:"warnings"`
+	Infos     int       `json:"infos"`
+}
+
+// Store persists scan summaries to a local file, one JSON document per line.
+type Store struct {
+	path string
+	mx   sync.Mutex
+}
+
+// NewStore returns a new instance.
+func NewStore(path string) *Store {
+	return &Store{path: path}
+}
+
+// Path returns the history file location.
+func (s *Store) Path() string {
+	return s.path
+}
+
+// Append records a scan.
+func (s *Store) Append(sc Scan) error {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	raw, err := json.Marshal(sc)
+	if err != nil {
+		return err
+	}
+	if dir := filepath.Dir(s.path); dir != "" {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return err
+		}
+	}
+	f, err := os.OpenFile(s.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, historyFileMode)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	_, err = f.Write(append(raw, '\n'))
+
+	return err
+}
+
+// Last returns up to the n most recent scans in chronological order.
+// If a context is given, only scans for that context are returned.
+// A non positive n returns all matching scans.
+func (s *Store) Last(n int, ct string) ([]Scan, error) {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	f, err := os.Open(s.path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var (
+		ss   []Scan
+		line int
+	)
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line++
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		var sc Scan
+		if err := json.Unmarshal(scanner.Bytes(), &sc); err != nil {
+			return nil, fmt.Errorf("history %s:%d: %w", s.path, line, err)
+		}
+		if ct != "" && sc.Context != ct {
+			continue
+		}
+		ss = append(ss, sc)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	sort.SliceStable(ss, func(i, j int) bool {
+		return ss[i].Timestamp.Before(ss[j].Timestamp)
+	})
+	if n > 0 && len(ss) > n {
+		ss = ss[len(ss)-n:]
+	}
+
+	return ss, nil
+}
@@ -0,0 +1,88 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Popeye
+
+package history
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStoreLast(t *testing.T) {
+	t0 := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	s := NewStore(filepath.Join(t.TempDir(), "history", "scans.json"))
+	for i, ct := range []string{"c1", "c2", "c1", "c1", "c2"} {
+		assert.NoError(t, s.Append(Scan{
+			Timestamp: t0.Add(time.Duration(i) * time.Hour),
+			Context:   ct,
+			Score:     80 + i,
+			Errors:    i,
+		}))
+	}
+
+	uu := map[string]struct {
+		n      int
+		ct     string
+		scores []int
+	}{
+		"all": {
+			scores: []int{80, 81, 82, 83, 84},
+		},
+		"last-2": {
+			n:      2,
+			scores: []int{83, 84},
+		},
+		"context": {
+			ct:     "c1",
+			scores: []int{80, 82, 83},
+		},
+		"context-last": {
+			n:      1,
+			ct:     "c2",
+			scores: []int{84},
+		},
+		"overflow": {
+			n:      10,
+			ct:     "c2",
+			scores: []int{81, 84},
+		},
+		"unknown-context": {
+			ct: "c3",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ss, err := s.Last(u.n, u.ct)
+			assert.NoError(t, err)
+			assert.Equal(t, len(u.scores), len(ss))
+			for i, sc := range ss {
+				assert.Equal(t, u.scores[i], sc.Score)
+				if i > 0 {
+					assert.True(t, ss[i-1].Timestamp.Before(sc.Timestamp))
+				}
+			}
+		})
+	}
+}
+
+func TestStoreLastNoFile(t *testing.T) {
+	s := NewStore(filepath.Join(t.TempDir(), "scans.json"))
+	ss, err := s.Last(5, "")
+
+	assert.NoError(t, err)
+	assert.Empty(t, ss)
+}
+
+func TestStoreLastCorrupted(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "scans.json")
+	assert.NoError(t, os.WriteFile(path, []byte("{\"score\":10}\nblee\n"), 0644))
+	_, err := NewStore(path).Last(0, "")
+
+	assert.ErrorContains(t, err, "scans.json:2")
+}
@@ -5,6 +5,8 @@ package issues
 
 import (
 	_ "embed"
+	"fmt"
+	"sync"
 
 	"github.com/derailed/popeye/internal/rules"
 	"gopkg.in/yaml.v2"
@@ -13,6 +15,31 @@ import (
 //go:embed assets/codes.yaml
 var codes string
 
+var (
+	customCodes   = make(rules.Glossary)
+	customCodesMX sync.RWMutex
+)
+
+// RegisterCode registers a custom linter code. Built-in codes can not be
+// redefined and severity must range from info to error.
+func RegisterCode(id rules.ID, c rules.Code) error {
+	if !validSeverity(c.Severity) {
+		return fmt.Errorf("code %d severity %d is out of range [%d, %d]", id, c.Severity, rules.InfoLevel, rules.ErrorLevel)
+	}
+	cc, err := LoadCodes()
+	if err != nil {
+		return err
+	}
+	if _, ok := cc.Glossary[id]; ok {
+		return fmt.Errorf("code %d is already defined", id)
+	}
+	customCodesMX.Lock()
+	defer customCodesMX.Unlock()
+	customCodes[id] = &c
+
+	return nil
+}
+
 // Codes represents a collection of linter codes.
 type Codes struct {
 	Glossary rules.Glossary `yaml:"codes"`
@@ -25,6 +52,15 @@ func LoadCodes() (*Codes, error) {
 		return &cc, err
 	}
 
+	customCodesMX.RLock()
+	defer customCodesMX.RUnlock()
+	for id, c := range customCodes {
+		if _, ok := cc.Glossary[id]; !ok {
+			co := *c
+			cc.Glossary[id] = &co
+		}
+	}
+
 	return &cc, nil
 }
 
@@ -15,11 +15,43 @@ func TestCodesLoad(t *testing.T) {
 	cc, err := issues.LoadCodes()
 
 	assert.Nil(t, err)
-	assert.Equal(t, 116, len(cc.Glossary))
+	assert.Equal(t, 225, len(cc.Glossary))
 	assert.Equal(t, "No liveness probe", cc.Glossary[103].Message)
 	assert.Equal(t, rules.WarnLevel, cc.Glossary[103].Severity)
 }
 
+func TestRegisterCode(t *testing.T) {
+	uu := map[string]struct {
+		id  rules.ID
+		c   rules.Code
+		err bool
+	}{
+		"builtin": {
+			id:  100,
+			c:   rules.Code{Message: "blah", Severity: rules.WarnLevel},
+			err: true,
+		},
+		"no-severity": {
+			id:  20000,
+			c:   rules.Code{Message: "blah"},
+			err: true,
+		},
+		"out-of-range": {
+			id:  20001,
+			c:   rules.Code{Message: "blah", Severity: 4},
+			err: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			err := issues.RegisterCode(u.id, u.c)
+			assert.Equal(t, u.err, err != nil)
+		})
+	}
+}
+
 func TestRefine(t *testing.T) {
 	cc, err := issues.LoadCodes()
 	assert.Nil(t, err)
@@ -125,8 +125,15 @@ func (o Outcome) MaxSeverity(section string) rules.Level {
 }
 
 func (o Outcome) MarshalJSON() ([]byte, error) {
+	kk := make([]string, 0, len(o))
+	for k := range o {
+		kk = append(kk, k)
+	}
+	slices.SortFunc(kk, SortKeys)
+
 	out := make([]string, 0, len(o))
-	for k, v := range o {
+	for _, k := range kk {
+		v := o[k]
 		if len(v) == 0 {
 			continue
 		}
@@ -193,6 +200,35 @@ func (o Outcome) Filter(level rules.Level) Outcome {
 	return o
 }
 
+// Truncate returns a copy capping the number of issues per resource. The most
+// severe issues are kept and the dropped ones are summarized by a trailing note
+// carrying their highest severity.
+func (o Outcome) Truncate(limit int) Outcome {
+	if limit <= 0 {
+		return o
+	}
+	out := make(Outcome, len(o))
+	for k, ii := range o {
+		if len(ii) <= limit {
+			out[k] = ii
+			continue
+		}
+		vv := slices.Clone(ii)
+		slices.SortStableFunc(vv, func(a, b Issue) int {
+			return int(b.Level) - int(a.Level)
+		})
+		more := vv[limit:]
+		out[k] = append(vv[:limit:limit], Issue{
+			Group:   Root,
+			GVR:     more[0].GVR,
+			Level:   more.MaxSeverity(),
+			Message: fmt.Sprintf("(+%d more)", len(more)),
+		})
+	}
+
+	return out
+}
+
 func (o Outcome) Dump() {
 	if len(o) == 0 {
 		fmt.Println("No ISSUES!")
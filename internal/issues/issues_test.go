@@ -57,3 +57,63 @@ func TestGroup(t *testing.T) {
 	assert.Equal(t, rules.ErrorLevel, o["s2"].MaxSeverity())
 	assert.Equal(t, 2, len(grp))
 }
+
+func TestOutcomeTruncate(t *testing.T) {
+	gvr := types.NewGVR("v1/pods")
+	o := Outcome{
+		"p1": Issues{
+			New(gvr, Root, rules.InfoLevel, "i1"),
+			New(gvr, "c1", rules.WarnLevel, "i2"),
+			New(gvr, "c1", rules.InfoLevel, "i3"),
+			New(gvr, Root, rules.ErrorLevel, "i4"),
+			New(gvr, "c2", rules.WarnLevel, "i5"),
+			New(gvr, "c2", rules.InfoLevel, "i6"),
+		},
+		"p2": Issues{
+			New(gvr, Root, rules.InfoLevel, "i1"),
+		},
+	}
+
+	uu := map[string]struct {
+		max  int
+		msgs []string
+		last rules.Level
+	}{
+		"none": {
+			msgs: []string{"i1", "i2", "i3", "i4", "i5", "i6"},
+			last: rules.InfoLevel,
+		},
+		"cap-2": {
+			max:  2,
+			msgs: []string{"i4", "i2", "(+4 more)"},
+			last: rules.WarnLevel,
+		},
+		"cap-4": {
+			max:  4,
+			msgs: []string{"i4", "i2", "i5", "i1", "(+2 more)"},
+			last: rules.InfoLevel,
+		},
+		"cap-above": {
+			max:  6,
+			msgs: []string{"i1", "i2", "i3", "i4", "i5", "i6"},
+			last: rules.InfoLevel,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			to := o.Truncate(u.max)
+
+			ii := to["p1"]
+			assert.Equal(t, len(u.msgs), len(ii))
+			for i, m := range u.msgs {
+				assert.Equal(t, m, ii[i].Message)
+			}
+			assert.Equal(t, u.last, ii[len(ii)-1].Level)
+			assert.Equal(t, rules.ErrorLevel, ii.MaxSeverity())
+			assert.Equal(t, 1, len(to["p2"]))
+			assert.Equal(t, 6, len(o["p1"]))
+		})
+	}
+}
@@ -8,13 +8,16 @@ type ContextKey string
 
 // A collection of context keys.
 const (
-	KeyFactory    ContextKey = "factory"
-	KeyLabels     ContextKey = "labels"
-	KeyFields     ContextKey = "fields"
-	KeyOverAllocs ContextKey = "overAllocs"
-	KeyRunInfo    ContextKey = "runInfo"
-	KeyConfig     ContextKey = "config"
-	KeyNamespace  ContextKey = "namespace"
-	KeyVersion    ContextKey = "version"
-	KeyDB         ContextKey = "db"
+	KeyFactory     ContextKey = "factory"
+	KeyLabels      ContextKey = "labels"
+	KeyFields      ContextKey = "fields"
+	KeyOverAllocs  ContextKey = "overAllocs"
+	KeyRunInfo     ContextKey = "runInfo"
+	KeyConfig      ContextKey = "config"
+	KeyNamespace   ContextKey = "namespace"
+	KeyVersion     ContextKey = "version"
+	KeyDB          ContextKey = "db"
+	KeyUtilization ContextKey = "utilization"
+	KeyLogger      ContextKey = "logger"
+	KeyAllocatable ContextKey = "allocatable"
 )
@@ -0,0 +1,140 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Popeye
+
+package lint
+
+import (
+	"context"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+)
+
+// nodeOperators maps node selector operators to label selector operators.
+var nodeOperators = map[v1.NodeSelectorOperator]selection.Operator{
+	v1.NodeSelectorOpIn:           selection.In,
+	v1.NodeSelectorOpNotIn:        selection.NotIn,
+	v1.NodeSelectorOpExists:       selection.Exists,
+	v1.NodeSelectorOpDoesNotExist: selection.DoesNotExist,
+	v1.NodeSelectorOpGt:           selection.GreaterThan,
+	v1.NodeSelectorOpLt:           selection.LessThan,
+}
+
+// checkNodeConstraints flags pods whose nodeSelector and required node
+// affinity cannot be satisfied by any one node.
+func (s *Pod) checkNodeConstraints(ctx context.Context, spec v1.PodSpec, nn map[string]*v1.Node) {
+	terms := requiredNodeTerms(spec.Affinity)
+	if len(spec.NodeSelector) == 0 || len(terms) == 0 || len(nn) == 0 {
+		return
+	}
+	sel := labels.SelectorFromSet(spec.NodeSelector)
+	for _, no := range nn {
+		if sel.Matches(labels.Set(no.Labels)) && matchNodeTerms(no, terms) {
+			return
+		}
+	}
+	s.AddCode(ctx, 230, sel.String(), nodeTermsString(terms))
+}
+
+// checkAffinityLabels flags required node affinity expressions keyed on a
+// label no node carries. Negative operators are satisfied by absent labels.
+func (s *Pod) checkAffinityLabels(ctx context.Context, spec v1.PodSpec, nn map[string]*v1.Node) {
+	terms := requiredNodeTerms(spec.Affinity)
+	if len(terms) == 0 || len(nn) == 0 {
+		return
+	}
+	keys := make(map[string]struct{})
+	for _, no := range nn {
+		for k := range no.Labels {
+			keys[k] = struct{}{}
+		}
+	}
+
+	seen := make(map[string]struct{})
+	for _, t := range terms {
+		for _, r := range t.MatchExpressions {
+			if r.Operator == v1.NodeSelectorOpNotIn || r.Operator == v1.NodeSelectorOpDoesNotExist {
+				continue
+			}
+			if _, ok := keys[r.Key]; ok {
+				continue
+			}
+			if _, ok := seen[r.Key]; ok {
+				continue
+			}
+			seen[r.Key] = struct{}{}
+			s.AddCode(ctx, 238, r.Key)
+		}
+	}
+}
+
+func requiredNodeTerms(a *v1.Affinity) []v1.NodeSelectorTerm {
+	if a == nil || a.NodeAffinity == nil || a.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution == nil {
+		return nil
+	}
+
+	return a.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms
+}
+
+// matchNodeTerms checks if a node matches any of the given terms.
+func matchNodeTerms(no *v1.Node, terms []v1.NodeSelectorTerm) bool {
+	for _, t := range terms {
+		if matchNodeTerm(no, t) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// matchNodeTerm checks if a node matches all the term requirements.
+// An empty term matches no nodes.
+func matchNodeTerm(no *v1.Node, t v1.NodeSelectorTerm) bool {
+	if len(t.MatchExpressions) == 0 && len(t.MatchFields) == 0 {
+		return false
+	}
+	sel, err := nodeSelector(t.MatchExpressions)
+	if err != nil || !sel.Matches(labels.Set(no.Labels)) {
+		return false
+	}
+	for _, f := range t.MatchFields {
+		if f.Key != "metadata.name" {
+			return false
+		}
+	}
+	sel, err = nodeSelector(t.MatchFields)
+	if err != nil {
+		return false
+	}
+
+	return sel.Matches(labels.Set{"metadata.name": no.Name})
+}
+
+func nodeSelector(rr []v1.NodeSelectorRequirement) (labels.Selector, error) {
+	sel := labels.NewSelector()
+	for _, r := range rr {
+		req, err := labels.NewRequirement(r.Key, nodeOperators[r.Operator], r.Values)
+		if err != nil {
+			return nil, err
+		}
+		sel = sel.Add(*req)
+	}
+
+	return sel, nil
+}
+
+func nodeTermsString(terms []v1.NodeSelectorTerm) string {
+	ss := make([]string, 0, len(terms))
+	for _, t := range terms {
+		rr := append(append([]v1.NodeSelectorRequirement{}, t.MatchExpressions...), t.MatchFields...)
+		sel, err := nodeSelector(rr)
+		if err != nil {
+			continue
+		}
+		ss = append(ss, "("+sel.String()+")")
+	}
+
+	return strings.Join(ss, " or ")
+}
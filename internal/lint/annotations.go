@@ -0,0 +1,62 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Popeye
+
+package lint
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// deprecatedAnnotations maps deprecated annotations to their current equivalent.
+// Keys ending with a slash match annotation prefixes. An empty equivalent
+// denotes an annotation without replacement.
+var deprecatedAnnotations = map[string]string{
+	"kubernetes.io/change-cause":                      "",
+	"scheduler.alpha.kubernetes.io/critical-pod":      "spec.priorityClassName",
+	"scheduler.alpha.kubernetes.io/tolerations":       "spec.tolerations",
+	"scheduler.alpha.kubernetes.io/affinity":          "spec.affinity",
+	"pod.beta.kubernetes.io/init-containers":          "spec.initContainers",
+	"seccomp.security.alpha.kubernetes.io/pod":        "spec.securityContext.seccompProfile",
+	"container.seccomp.security.alpha.kubernetes.io/": "securityContext.seccompProfile",
+	"container.apparmor.security.beta.kubernetes.io/": "securityContext.appArmorProfile",
+	"autoscaling.alpha.kubernetes.io/metrics":         "autoscaling/v2 spec.metrics",
+	"autoscaling.alpha.kubernetes.io/conditions":      "autoscaling/v2 status.conditions",
+}
+
+// checkDeprecatedAnnotations flags deprecated annotations and their GA equivalent if any.
+func checkDeprecatedAnnotations(ctx context.Context, c Collector, mm ...metav1.ObjectMeta) {
+	for _, m := range mm {
+		kk := make([]string, 0, len(m.Annotations))
+		for k := range m.Annotations {
+			kk = append(kk, k)
+		}
+		sort.Strings(kk)
+		for _, k := range kk {
+			r, ok := deprecatedAnnotation(k)
+			switch {
+			case !ok:
+			case r == "":
+				c.AddCode(ctx, 416, k)
+			default:
+				c.AddCode(ctx, 413, k, r)
+			}
+		}
+	}
+}
+
+func deprecatedAnnotation(k string) (string, bool) {
+	if r, ok := deprecatedAnnotations[k]; ok {
+		return r, true
+	}
+	if i := strings.LastIndex(k, "/"); i > 0 {
+		if r, ok := deprecatedAnnotations[k[:i+1]]; ok {
+			return r, true
+		}
+	}
+
+	return "", false
+}
@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Popeye
+
+package lint
+
+import (
+	"testing"
+
+	"github.com/derailed/popeye/internal"
+	"github.com/derailed/popeye/internal/test"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestCheckDeprecatedAnnotations(t *testing.T) {
+	uu := map[string]struct {
+		annotations, tplAnnotations map[string]string
+		issues                      []string
+	}{
+		"none": {},
+		"current": {
+			annotations: map[string]string{"deployment.kubernetes.io/revision": "3"},
+		},
+		"change-cause": {
+			annotations: map[string]string{
+				"deployment.kubernetes.io/revision": "3",
+				"kubernetes.io/change-cause":        "kubectl set image --record",
+			},
+			issues: []string{
+				`[POP-416] Deprecated annotation "kubernetes.io/change-cause" in use. It has no replacement`,
+			},
+		},
+		"template-prefix": {
+			tplAnnotations: map[string]string{
+				"container.apparmor.security.beta.kubernetes.io/c1": "runtime/default",
+				"scheduler.alpha.kubernetes.io/critical-pod":        "",
+			},
+			issues: []string{
+				`[POP-413] Deprecated annotation "container.apparmor.security.beta.kubernetes.io/c1" in use. Use securityContext.appArmorProfile instead`,
+				`[POP-413] Deprecated annotation "scheduler.alpha.kubernetes.io/critical-pod" in use. Use spec.priorityClassName instead`,
+			},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			co := test.MakeCollector(t)
+			co.InitOutcome("default/dp1")
+			ctx := test.MakeContext("apps/v1/deployments", "deployments")
+			ctx = internal.WithSpec(ctx, SpecFor("default/dp1", nil))
+			checkDeprecatedAnnotations(ctx, co,
+				metav1.ObjectMeta{Annotations: u.annotations},
+				metav1.ObjectMeta{Annotations: u.tplAnnotations},
+			)
+
+			ii := co.Outcome()["default/dp1"]
+			assert.Equal(t, len(u.issues), len(ii))
+			for i, m := range u.issues {
+				assert.Equal(t, m, ii[i].Message)
+			}
+		})
+	}
+}
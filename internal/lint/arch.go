@@ -0,0 +1,88 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Popeye
+
+package lint
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/derailed/popeye/internal"
+	"github.com/derailed/popeye/types"
+	v1 "k8s.io/api/core/v1"
+)
+
+const (
+	archLabel       = "kubernetes.io/arch"
+	execFormatError = "exec format error"
+)
+
+// checkArch flags containers whose image architecture does not match their
+// node. The api does not surface the pulled image platform, so a container
+// terminating with an exec format error stands in for a mismatch. Absent
+// such evidence, pods free to land on any node of a mixed-arch pool are
+// flagged instead.
+func (s *Pod) checkArch(ctx context.Context, po *v1.Pod, nn map[string]*v1.Node) {
+	if len(nn) == 0 {
+		return
+	}
+
+	if no, ok := nn[po.Spec.NodeName]; ok {
+		var mismatch bool
+		for _, cs := range append(append([]v1.ContainerStatus{}, po.Status.InitContainerStatuses...), po.Status.ContainerStatuses...) {
+			if execFormatFailure(cs.State) || execFormatFailure(cs.LastTerminationState) {
+				s.AddSubCode(internal.WithGroup(ctx, types.NewGVR("containers"), cs.Name), 242, cs.Name, nodeArch(no), no.Name)
+				mismatch = true
+			}
+		}
+		if mismatch {
+			return
+		}
+	}
+
+	archs := make(map[string]struct{})
+	for _, no := range nn {
+		if a := nodeArch(no); a != "" {
+			archs[a] = struct{}{}
+		}
+	}
+	if len(archs) < 2 || pinsArch(po.Spec) {
+		return
+	}
+	aa := make([]string, 0, len(archs))
+	for a := range archs {
+		aa = append(aa, a)
+	}
+	sort.Strings(aa)
+	s.AddCode(ctx, 243, strings.Join(aa, ", "), archLabel)
+}
+
+func execFormatFailure(st v1.ContainerState) bool {
+	return st.Terminated != nil && strings.Contains(strings.ToLower(st.Terminated.Message), execFormatError)
+}
+
+func nodeArch(no *v1.Node) string {
+	if a, ok := no.Labels[archLabel]; ok {
+		return a
+	}
+
+	return no.Status.NodeInfo.Architecture
+}
+
+// pinsArch checks if a pod constrains the node architecture via its node
+// selector or required node affinity.
+func pinsArch(spec v1.PodSpec) bool {
+	if _, ok := spec.NodeSelector[archLabel]; ok {
+		return true
+	}
+	for _, t := range requiredNodeTerms(spec.Affinity) {
+		for _, r := range t.MatchExpressions {
+			if r.Key == archLabel {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,102 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Popeye
+
+package lint
+
+import (
+	"testing"
+
+	"github.com/derailed/popeye/internal"
+	"github.com/derailed/popeye/internal/db"
+	"github.com/derailed/popeye/internal/rules"
+	"github.com/derailed/popeye/internal/test"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestPodCheckArch(t *testing.T) {
+	execFailed := []v1.ContainerStatus{
+		{
+			Name: "c1",
+			LastTerminationState: v1.ContainerState{
+				Terminated: &v1.ContainerStateTerminated{ExitCode: 1, Reason: "Error", Message: "exec /app: exec format error"},
+			},
+		},
+	}
+	archAffinity := &v1.Affinity{
+		NodeAffinity: &v1.NodeAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: &v1.NodeSelector{
+				NodeSelectorTerms: []v1.NodeSelectorTerm{
+					{MatchExpressions: []v1.NodeSelectorRequirement{
+						{Key: "kubernetes.io/arch", Operator: v1.NodeSelectorOpIn, Values: []string{"amd64"}},
+					}},
+				},
+			},
+		},
+	}
+	uu := map[string]struct {
+		nodes  string
+		spec   v1.PodSpec
+		status v1.PodStatus
+		issue  string
+		level  rules.Level
+	}{
+		"matched-arch": {
+			nodes: "core/node/1.yaml",
+			spec:  v1.PodSpec{NodeName: "n1"},
+		},
+		"mixed-arch": {
+			nodes: "core/node/3.yaml",
+			spec:  v1.PodSpec{NodeName: "n1"},
+			issue: `[POP-243] Node pool mixes architectures (amd64, arm64) but pod sets no kubernetes.io/arch node selector. Images must be multi-arch`,
+			level: rules.InfoLevel,
+		},
+		"mixed-arch-selector": {
+			nodes: "core/node/3.yaml",
+			spec:  v1.PodSpec{NodeName: "n1", NodeSelector: map[string]string{"kubernetes.io/arch": "amd64"}},
+		},
+		"mixed-arch-affinity": {
+			nodes: "core/node/3.yaml",
+			spec:  v1.PodSpec{NodeName: "n1", Affinity: archAffinity},
+		},
+		"exec-format": {
+			nodes:  "core/node/3.yaml",
+			spec:   v1.PodSpec{NodeName: "n2"},
+			status: v1.PodStatus{ContainerStatuses: execFailed},
+			issue:  `[POP-242] Container "c1" hit an exec format error on arm64 node "n2". Image was likely built for another architecture`,
+			level:  rules.WarnLevel,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			dba, err := test.NewTestDB()
+			assert.NoError(t, err)
+			l := db.NewLoader(dba)
+			ctx := test.MakeCtx(t)
+			assert.NoError(t, test.LoadDB[*v1.Node](ctx, l.DB, u.nodes, test.Glossary[internal.NO]))
+
+			p := NewPod(test.MakeCollector(t), dba)
+			fqn := "default/p1"
+			p.InitOutcome(fqn)
+			ctx = internal.WithSpec(test.MakeContext("v1/pods", "pods"), SpecFor(fqn, nil))
+			po := v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "p1"},
+				Spec:       u.spec,
+				Status:     u.status,
+			}
+			p.checkArch(ctx, &po, listNodes(dba))
+
+			ii := p.Outcome()[fqn]
+			if u.issue == "" {
+				assert.Equal(t, 0, len(ii))
+				return
+			}
+			assert.Equal(t, 1, len(ii))
+			assert.Equal(t, u.issue, ii[0].Message)
+			assert.Equal(t, u.level, ii[0].Level)
+		})
+	}
+}
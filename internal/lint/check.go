@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Popeye
+
+package lint
+
+import (
+	"context"
+	"sync"
+
+	"github.com/derailed/popeye/internal"
+)
+
+// Check represents a resource check.
+type Check[T any] interface {
+	// Check lints the given resource and records issues on the collector.
+	Check(ctx context.Context, c Collector, o T)
+}
+
+// CheckFunc represents a resource check function.
+type CheckFunc[T any] func(ctx context.Context, c Collector, o T)
+
+// Check runs the check.
+func (f CheckFunc[T]) Check(ctx context.Context, c Collector, o T) {
+	f(ctx, c, o)
+}
+
+type checkFn func(context.Context, Collector, any)
+
+type checkRegistry struct {
+	checks map[internal.R][]checkFn
+	mx     sync.RWMutex
+}
+
+var checks = checkRegistry{checks: make(map[internal.R][]checkFn)}
+
+// RegisterCheck registers a custom check for the given resource. Custom checks
+// run in registration order once the linter native checks are done.
+func RegisterCheck[T any](r internal.R, c Check[T]) {
+	checks.mx.Lock()
+	defer checks.mx.Unlock()
+
+	checks.checks[r] = append(checks.checks[r], func(ctx context.Context, co Collector, o any) {
+		if t, ok := o.(T); ok {
+			c.Check(ctx, co, t)
+		}
+	})
+}
+
+// runChecks runs the checks shared by all linters followed by the custom
+// checks registered for the given resource. Linters call it last.
+func runChecks(ctx context.Context, r internal.R, c Collector, o any) {
+	checkFinalizers(ctx, c, o)
+
+	checks.mx.RLock()
+	cc := checks.checks[r]
+	checks.mx.RUnlock()
+
+	for _, fn := range cc {
+		fn(ctx, c, o)
+	}
+}
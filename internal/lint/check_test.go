@@ -0,0 +1,48 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Popeye
+
+package lint
+
+import (
+	"context"
+	"testing"
+
+	"github.com/derailed/popeye/internal"
+	"github.com/derailed/popeye/internal/db"
+	"github.com/derailed/popeye/internal/issues"
+	"github.com/derailed/popeye/internal/rules"
+	"github.com/derailed/popeye/internal/test"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+)
+
+func TestRegisterCheck(t *testing.T) {
+	defer func() {
+		checks.mx.Lock()
+		delete(checks.checks, internal.PO)
+		checks.mx.Unlock()
+	}()
+
+	assert.NoError(t, issues.RegisterCode(10000, rules.Code{Message: "Pod %s is not labeled with a team", Severity: rules.WarnLevel}))
+	assert.Error(t, issues.RegisterCode(200, rules.Code{Message: "blee", Severity: rules.WarnLevel}))
+	RegisterCheck[*v1.Pod](internal.PO, CheckFunc[*v1.Pod](func(ctx context.Context, c Collector, po *v1.Pod) {
+		if _, ok := po.Labels["team"]; !ok {
+			c.AddCode(ctx, 10000, po.Name)
+		}
+	}))
+
+	dba, err := test.NewTestDB()
+	assert.NoError(t, err)
+	l := db.NewLoader(dba)
+	ctx := test.MakeCtx(t)
+	assert.NoError(t, test.LoadDB[*v1.Pod](ctx, l.DB, "core/pod/1.yaml", test.Glossary[internal.PO]))
+
+	po := NewPod(test.MakeCollector(t), dba)
+	assert.Nil(t, po.Lint(test.MakeContext("v1/pods", "pods")))
+
+	ii := po.Outcome()["default/p1"]
+	assert.True(t, len(ii) > 1)
+	last := ii[len(ii)-1]
+	assert.Equal(t, `[POP-10000] Pod p1 is not labeled with a team`, last.Message)
+	assert.Equal(t, rules.WarnLevel, last.Level)
+}
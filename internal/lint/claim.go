@@ -0,0 +1,51 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Popeye
+
+package lint
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/derailed/popeye/internal"
+	"github.com/derailed/popeye/internal/cache"
+	"github.com/derailed/popeye/internal/db"
+	v1 "k8s.io/api/core/v1"
+)
+
+// checkSharedClaims flags replicated workloads mounting a claim that can only
+// be attached to a single node.
+func checkSharedClaims(ctx context.Context, c Collector, dba *db.DB, ns string, spec v1.PodSpec, replicas *int32) {
+	count := replicasOrDefault(replicas)
+	if count <= 1 {
+		return
+	}
+	for _, v := range spec.Volumes {
+		if v.PersistentVolumeClaim == nil {
+			continue
+		}
+		o, err := dba.Find(dba.GVR(internal.PVC), cache.FQN(ns, v.PersistentVolumeClaim.ClaimName))
+		if err != nil {
+			continue
+		}
+		pvc, ok := o.(*v1.PersistentVolumeClaim)
+		if !ok || multiNode(pvc.Spec.AccessModes, v.PersistentVolumeClaim.ReadOnly) {
+			continue
+		}
+		mm := make([]string, 0, len(pvc.Spec.AccessModes))
+		for _, m := range pvc.Spec.AccessModes {
+			mm = append(mm, string(m))
+		}
+		c.AddCode(ctx, 512, pvc.Name, strings.Join(mm, ","), count)
+	}
+}
+
+// multiNode checks if a claim can be mounted from several nodes at once.
+func multiNode(mm []v1.PersistentVolumeAccessMode, readOnly bool) bool {
+	if slices.Contains(mm, v1.ReadWriteMany) {
+		return true
+	}
+
+	return readOnly && slices.Contains(mm, v1.ReadOnlyMany)
+}
@@ -0,0 +1,83 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Popeye
+
+package lint
+
+import (
+	"testing"
+
+	"github.com/derailed/popeye/internal"
+	"github.com/derailed/popeye/internal/rules"
+	"github.com/derailed/popeye/internal/test"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+)
+
+func TestCheckSharedClaims(t *testing.T) {
+	uu := map[string]struct {
+		claim    string
+		readOnly bool
+		replicas int32
+		issue    string
+	}{
+		"rwo-shared": {
+			claim:    "rwo",
+			replicas: 3,
+			issue:    `[POP-512] Claim "rwo" with access mode ReadWriteOnce is shared by 3 replicas. Use ReadWriteMany or a per-replica volumeClaimTemplate`,
+		},
+		"rwo-single": {
+			claim:    "rwo",
+			replicas: 1,
+		},
+		"rwx-shared": {
+			claim:    "rwx",
+			replicas: 3,
+		},
+		"rox-read-only": {
+			claim:    "rox",
+			readOnly: true,
+			replicas: 3,
+		},
+		"per-replica": {
+			replicas: 3,
+		},
+		"unknown-claim": {
+			claim:    "zorg",
+			replicas: 3,
+		},
+	}
+
+	dba, err := test.NewTestDB()
+	assert.NoError(t, err)
+	assert.NoError(t, test.LoadDB[*v1.PersistentVolumeClaim](test.MakeCtx(t), dba, "core/pvc/2.yaml", test.Glossary[internal.PVC]))
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			co := test.MakeCollector(t)
+			co.InitOutcome("default/dp1")
+			ctx := test.MakeContext("apps/v1/deployments", "deployments")
+			ctx = internal.WithSpec(ctx, SpecFor("default/dp1", nil))
+			var spec v1.PodSpec
+			if u.claim != "" {
+				spec.Volumes = []v1.Volume{{
+					Name: "data",
+					VolumeSource: v1.VolumeSource{PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{
+						ClaimName: u.claim,
+						ReadOnly:  u.readOnly,
+					}},
+				}}
+			}
+			checkSharedClaims(ctx, co, dba, "default", spec, &u.replicas)
+
+			ii := co.Outcome()["default/dp1"]
+			if u.issue == "" {
+				assert.Equal(t, 0, len(ii))
+				return
+			}
+			assert.Equal(t, 1, len(ii))
+			assert.Equal(t, u.issue, ii[0].Message)
+			assert.Equal(t, rules.ErrorLevel, ii[0].Level)
+		})
+	}
+}
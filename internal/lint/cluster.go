@@ -27,6 +27,7 @@ type (
 	ClusterLister interface {
 		ListVersion() (*semver.Version, error)
 		HasMetrics() bool
+		PodsMetricsCoverage() (int, int)
 	}
 )
 
@@ -40,9 +41,26 @@ func NewCluster(co *issues.Collector, lister ClusterLister) *Cluster {
 
 // Lint cleanse the resource.
 func (c *Cluster) Lint(ctx context.Context) error {
+	c.checkMetrics(ctx)
+
 	return c.checkVersion(ctx)
 }
 
+func (c *Cluster) checkMetrics(ctx context.Context) {
+	ctx = internal.WithSpec(ctx, SpecFor("Metrics", nil))
+	if !c.HasMetrics() {
+		c.AddCode(ctx, 409)
+		return
+	}
+
+	pods, covered := c.PodsMetricsCoverage()
+	if covered < pods {
+		c.AddCode(ctx, 410, covered, pods, ToPerc(int64(covered), int64(pods)))
+		return
+	}
+	c.AddCode(ctx, 411, pods)
+}
+
 func (c *Cluster) checkVersion(ctx context.Context) error {
 	rev, err := c.ListVersion()
 	if err != nil {
@@ -18,14 +18,24 @@ import (
 
 func TestClusterLint(t *testing.T) {
 	uu := map[string]struct {
-		major, minor string
-		metrics      bool
-		e            issues.Outcome
+		major, minor  string
+		metrics       bool
+		pods, covered int
+		e             issues.Outcome
 	}{
 		"good": {
 			major: "1", minor: "29",
 			metrics: true,
+			pods:    2, covered: 2,
 			e: map[string]issues.Issues{
+				"Metrics": {
+					{
+						GVR:     "clusters",
+						Group:   issues.Root,
+						Message: "[POP-411] Metrics available for all 2 running pods",
+						Level:   rules.OkLevel,
+					},
+				},
 				"Version": {
 					{
 						GVR:     "clusters",
@@ -39,7 +49,16 @@ func TestClusterLint(t *testing.T) {
 		"plus": {
 			major: "1", minor: "29+",
 			metrics: true,
+			pods:    4, covered: 1,
 			e: map[string]issues.Issues{
+				"Metrics": {
+					{
+						GVR:     "clusters",
+						Group:   issues.Root,
+						Message: "[POP-410] Partial metrics coverage. Only 1/4 running pods (25%) report metrics",
+						Level:   rules.InfoLevel,
+					},
+				},
 				"Version": {
 					{
 						GVR:     "clusters",
@@ -54,6 +73,14 @@ func TestClusterLint(t *testing.T) {
 			major: "1", minor: "11",
 			metrics: false,
 			e: map[string]issues.Issues{
+				"Metrics": {
+					{
+						GVR:     "clusters",
+						Group:   issues.Root,
+						Message: "[POP-409] No metrics-server detected. Utilization checks were skipped",
+						Level:   rules.WarnLevel,
+					},
+				},
 				"Version": {
 					{
 						GVR:     "clusters",
@@ -72,7 +99,7 @@ func TestClusterLint(t *testing.T) {
 		t.Run(k, func(t *testing.T) {
 			cl := NewCluster(
 				test.MakeCollector(t),
-				newMockCluster(u.major, u.minor, u.metrics),
+				newMockCluster(u.major, u.minor, u.metrics, u.pods, u.covered),
 			)
 
 			assert.Nil(t, cl.Lint(ctx))
@@ -84,12 +111,13 @@ func TestClusterLint(t *testing.T) {
 // Helpers...
 
 type mockCluster struct {
-	major, minor string
-	metrics      bool
+	major, minor  string
+	metrics       bool
+	pods, covered int
 }
 
-func newMockCluster(major, minor string, metrics bool) mockCluster {
-	return mockCluster{major: major, minor: minor, metrics: metrics}
+func newMockCluster(major, minor string, metrics bool, pods, covered int) mockCluster {
+	return mockCluster{major: major, minor: minor, metrics: metrics, pods: pods, covered: covered}
 }
 
 func (c mockCluster) ListVersion() (*semver.Version, error) {
@@ -99,3 +127,7 @@ func (c mockCluster) ListVersion() (*semver.Version, error) {
 func (c mockCluster) HasMetrics() bool {
 	return c.metrics
 }
+
+func (c mockCluster) PodsMetricsCoverage() (int, int) {
+	return c.pods, c.covered
+}
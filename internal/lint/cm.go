@@ -5,6 +5,8 @@ package lint
 
 import (
 	"context"
+	"sort"
+	"strings"
 	"sync"
 
 	"github.com/derailed/popeye/internal"
@@ -15,6 +17,11 @@ import (
 	v1 "k8s.io/api/core/v1"
 )
 
+// cmUsage tracks the workloads consuming a ConfigMap by consumption form.
+type cmUsage struct {
+	env, volume internal.StringSet
+}
+
 // ConfigMap tracks ConfigMap sanitization.
 type ConfigMap struct {
 	*issues.Collector
@@ -41,11 +48,11 @@ func (s *ConfigMap) Lint(ctx context.Context) error {
 		return err
 	}
 
-	return s.checkStale(ctx, &cmRefs)
+	return s.checkStale(ctx, &cmRefs, s.usages())
 }
 
-func (s *ConfigMap) checkStale(ctx context.Context, refs *sync.Map) error {
-	txn, it := s.db.MustITFor(internal.Glossary[internal.CM])
+func (s *ConfigMap) checkStale(ctx context.Context, refs *sync.Map, uu map[string]*cmUsage) error {
+	txn, it := s.db.MustITFor(s.db.GVR(internal.CM))
 	defer txn.Abort()
 	for o := it.Next(); o != nil; o = it.Next() {
 		cm := o.(*v1.ConfigMap)
@@ -55,24 +62,124 @@ func (s *ConfigMap) checkStale(ctx context.Context, refs *sync.Map) error {
 		if s.system.skip(fqn) {
 			continue
 		}
+		checkHelmOwnership(ctx, s, cm.ObjectMeta)
+		checkUsageDrift(ctx, s, uu[fqn])
+		s.checkKeys(ctx, refs, fqn, cm.Data)
+		runChecks(ctx, internal.CM, s, cm)
+	}
 
-		keys, ok := refs.Load(cache.ResFqn(cache.ConfigMapKey, fqn))
+	return nil
+}
+
+// checkKeys flags unused ConfigMaps and unreferenced keys.
+func (s *ConfigMap) checkKeys(ctx context.Context, refs *sync.Map, fqn string, data map[string]string) {
+	keys, ok := refs.Load(cache.ResFqn(cache.ConfigMapKey, fqn))
+	if !ok {
+		s.AddCode(ctx, 400)
+		return
+	}
+	if keys.(internal.StringSet).Has(internal.All) {
+		return
+	}
+	kk := make(internal.StringSet, len(data))
+	for k := range data {
+		kk.Add(k)
+	}
+	deltas := keys.(internal.StringSet).Diff(kk)
+	for k := range deltas {
+		s.AddCode(ctx, 401, k)
+	}
+}
+
+// checkUsageDrift flags ConfigMaps consumed as env vars by some workloads and
+// mounted as volumes by others, as only the latter see live updates.
+func checkUsageDrift(ctx context.Context, c Collector, u *cmUsage) {
+	if u == nil || len(u.env) == 0 || len(u.volume) == 0 {
+		return
+	}
+	c.AddCode(ctx, 415, joinSet(u.env), joinSet(u.volume))
+}
+
+// usages computes how pods consume ConfigMaps across the cluster.
+func (s *ConfigMap) usages() map[string]*cmUsage {
+	uu := make(map[string]*cmUsage)
+	add := func(ns, name, wl string, env bool) {
+		fqn := client.FQN(ns, name)
+		u, ok := uu[fqn]
 		if !ok {
-			s.AddCode(ctx, 400)
-			continue
+			u = &cmUsage{env: make(internal.StringSet), volume: make(internal.StringSet)}
+			uu[fqn] = u
+		}
+		if env {
+			u.env.Add(wl)
+		} else {
+			u.volume.Add(wl)
 		}
-		if keys.(internal.StringSet).Has(internal.All) {
+	}
+
+	txn, it := s.db.MustITFor(s.db.GVR(internal.PO))
+	defer txn.Abort()
+	for o := it.Next(); o != nil; o = it.Next() {
+		po, ok := o.(*v1.Pod)
+		if !ok {
 			continue
 		}
-		kk := make(internal.StringSet, len(cm.Data))
-		for k := range cm.Data {
-			kk.Add(k)
+		wl := podWorkload(po)
+		for _, cc := range [][]v1.Container{po.Spec.InitContainers, po.Spec.Containers} {
+			for _, co := range cc {
+				for _, e := range co.EnvFrom {
+					if e.ConfigMapRef != nil {
+						add(po.Namespace, e.ConfigMapRef.Name, wl, true)
+					}
+				}
+				for _, e := range co.Env {
+					if e.ValueFrom != nil && e.ValueFrom.ConfigMapKeyRef != nil {
+						add(po.Namespace, e.ValueFrom.ConfigMapKeyRef.Name, wl, true)
+					}
+				}
+			}
 		}
-		deltas := keys.(internal.StringSet).Diff(kk)
-		for k := range deltas {
-			s.AddCode(ctx, 401, k)
+		for _, v := range po.Spec.Volumes {
+			if v.ConfigMap != nil {
+				add(po.Namespace, v.ConfigMap.Name, wl, false)
+			}
+			if v.Projected == nil {
+				continue
+			}
+			for _, src := range v.Projected.Sources {
+				if src.ConfigMap != nil {
+					add(po.Namespace, src.ConfigMap.Name, wl, false)
+				}
+			}
 		}
 	}
 
-	return nil
+	return uu
+}
+
+// podWorkload returns the kind/name of the workload managing a pod.
+func podWorkload(po *v1.Pod) string {
+	for _, r := range po.OwnerReferences {
+		if r.Controller == nil || !*r.Controller {
+			continue
+		}
+		if h, ok := po.Labels["pod-template-hash"]; ok && r.Kind == "ReplicaSet" {
+			if n, ok := strings.CutSuffix(r.Name, "-"+h); ok {
+				return "Deployment/" + n
+			}
+		}
+		return r.Kind + "/" + r.Name
+	}
+
+	return "Pod/" + po.Name
+}
+
+func joinSet(ss internal.StringSet) string {
+	kk := make([]string, 0, len(ss))
+	for k := range ss {
+		kk = append(kk, k)
+	}
+	sort.Strings(kk)
+
+	return strings.Join(kk, ", ")
 }
@@ -12,6 +12,7 @@ import (
 	"github.com/derailed/popeye/internal/test"
 	"github.com/stretchr/testify/assert"
 	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func TestConfigMapLint(t *testing.T) {
@@ -20,8 +21,8 @@ func TestConfigMapLint(t *testing.T) {
 	l := db.NewLoader(dba)
 
 	ctx := test.MakeCtx(t)
-	assert.NoError(t, test.LoadDB[*v1.ConfigMap](ctx, l.DB, "core/cm/1.yaml", internal.Glossary[internal.CM]))
-	assert.NoError(t, test.LoadDB[*v1.Pod](ctx, l.DB, "core/pod/1.yaml", internal.Glossary[internal.PO]))
+	assert.NoError(t, test.LoadDB[*v1.ConfigMap](ctx, l.DB, "core/cm/1.yaml", test.Glossary[internal.CM]))
+	assert.NoError(t, test.LoadDB[*v1.Pod](ctx, l.DB, "core/pod/1.yaml", test.Glossary[internal.PO]))
 
 	cm := NewConfigMap(test.MakeCollector(t), dba)
 	assert.Nil(t, cm.Lint(test.MakeContext("v1/configmaps", "configmaps")))
@@ -45,3 +46,59 @@ func TestConfigMapLint(t *testing.T) {
 	assert.Equal(t, `[POP-400] Used? Unable to locate resource reference`, ii[0].Message)
 	assert.Equal(t, rules.InfoLevel, ii[0].Level)
 }
+
+func TestConfigMapCheckUsageDrift(t *testing.T) {
+	dba, err := test.NewTestDB()
+	assert.NoError(t, err)
+	l := db.NewLoader(dba)
+
+	ctx := test.MakeCtx(t)
+	assert.NoError(t, test.LoadDB[*v1.ConfigMap](ctx, l.DB, "core/cm/2.yaml", test.Glossary[internal.CM]))
+	assert.NoError(t, test.LoadDB[*v1.Pod](ctx, l.DB, "core/pod/5.yaml", test.Glossary[internal.PO]))
+
+	cm := NewConfigMap(test.MakeCollector(t), dba)
+	assert.Nil(t, cm.Lint(test.MakeContext("v1/configmaps", "configmaps")))
+	assert.Equal(t, 2, len(cm.Outcome()))
+
+	ii := cm.Outcome()["default/mixed"]
+	assert.Equal(t, 1, len(ii))
+	assert.Equal(t, `[POP-415] Consumed as env by Deployment/api but mounted as a volume by StatefulSet/db. Only volume mounts pick up live updates`, ii[0].Message)
+	assert.Equal(t, rules.InfoLevel, ii[0].Level)
+
+	assert.Equal(t, 0, len(cm.Outcome()["default/consistent"]))
+}
+
+func Test_podWorkload(t *testing.T) {
+	yes := true
+	uu := map[string]struct {
+		po v1.Pod
+		e  string
+	}{
+		"bare": {
+			po: v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "p1"}},
+			e:  "Pod/p1",
+		},
+		"deployment": {
+			po: v1.Pod{ObjectMeta: metav1.ObjectMeta{
+				Name:            "dp1-abc-xyz",
+				Labels:          map[string]string{"pod-template-hash": "abc"},
+				OwnerReferences: []metav1.OwnerReference{{Kind: "ReplicaSet", Name: "dp1-abc", Controller: &yes}},
+			}},
+			e: "Deployment/dp1",
+		},
+		"daemonset": {
+			po: v1.Pod{ObjectMeta: metav1.ObjectMeta{
+				Name:            "ds1-xyz",
+				OwnerReferences: []metav1.OwnerReference{{Kind: "DaemonSet", Name: "ds1", Controller: &yes}},
+			}},
+			e: "DaemonSet/ds1",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, podWorkload(&u.po))
+		})
+	}
+}
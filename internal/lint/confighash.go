@@ -0,0 +1,104 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Popeye
+
+package lint
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"sort"
+
+	"github.com/derailed/popeye/internal"
+	"github.com/derailed/popeye/internal/client"
+	"github.com/derailed/popeye/internal/db"
+	v1 "k8s.io/api/core/v1"
+)
+
+// checkConfigHash flags pod templates whose config hash annotation drifted
+// from the ConfigMaps they reference.
+func checkConfigHash(ctx context.Context, c Collector, dba *db.DB, key, ns string, tpl v1.PodTemplateSpec) {
+	if key == "" {
+		return
+	}
+	hash, ok := tpl.Annotations[key]
+	if !ok {
+		return
+	}
+	cms := make([]*v1.ConfigMap, 0, 1)
+	for _, n := range configMapRefs(tpl.Spec) {
+		o, err := dba.Find(dba.GVR(internal.CM), client.FQN(ns, n))
+		if err != nil {
+			continue
+		}
+		if cm, ok := o.(*v1.ConfigMap); ok {
+			cms = append(cms, cm)
+		}
+	}
+	if len(cms) == 0 {
+		return
+	}
+	h, err := configMapsHash(cms)
+	if err != nil {
+		c.AddErr(ctx, err)
+		return
+	}
+	if h != hash {
+		c.AddCode(ctx, 412, key, h)
+	}
+}
+
+// configMapsHash computes the sha256 of the given ConfigMaps data. Each data
+// map is JSON encoded with sorted keys and hashed in the given order. This
+// input is documented in the README and must stay stable.
+func configMapsHash(cms []*v1.ConfigMap) (string, error) {
+	h := sha256.New()
+	for _, cm := range cms {
+		raw, err := json.Marshal(cm.Data)
+		if err != nil {
+			return "", err
+		}
+		h.Write(raw)
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// configMapRefs returns the sorted names of the ConfigMaps referenced by a pod spec.
+func configMapRefs(spec v1.PodSpec) []string {
+	refs := make(map[string]struct{})
+	for _, v := range spec.Volumes {
+		if v.ConfigMap != nil {
+			refs[v.ConfigMap.Name] = struct{}{}
+		}
+		if v.Projected == nil {
+			continue
+		}
+		for _, s := range v.Projected.Sources {
+			if s.ConfigMap != nil {
+				refs[s.ConfigMap.Name] = struct{}{}
+			}
+		}
+	}
+	for _, co := range append(append([]v1.Container{}, spec.InitContainers...), spec.Containers...) {
+		for _, e := range co.EnvFrom {
+			if e.ConfigMapRef != nil {
+				refs[e.ConfigMapRef.Name] = struct{}{}
+			}
+		}
+		for _, e := range co.Env {
+			if e.ValueFrom != nil && e.ValueFrom.ConfigMapKeyRef != nil {
+				refs[e.ValueFrom.ConfigMapKeyRef.Name] = struct{}{}
+			}
+		}
+	}
+
+	nn := make([]string, 0, len(refs))
+	for n := range refs {
+		nn = append(nn, n)
+	}
+	sort.Strings(nn)
+
+	return nn
+}
@@ -0,0 +1,109 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Popeye
+
+package lint
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	"github.com/derailed/popeye/internal"
+	"github.com/derailed/popeye/internal/rules"
+	"github.com/derailed/popeye/internal/test"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestCheckConfigHash(t *testing.T) {
+	const key = "checksum/config"
+
+	dba, err := test.NewTestDB()
+	assert.NoError(t, err)
+	ctx := test.MakeCtx(t)
+	assert.NoError(t, test.LoadDB[*v1.ConfigMap](ctx, dba, "core/cm/1.yaml", test.Glossary[internal.CM]))
+
+	var cms []*v1.ConfigMap
+	for _, n := range []string{"cm1", "cm2"} {
+		o, err := dba.Find(test.Glossary[internal.CM], "default/"+n)
+		assert.NoError(t, err)
+		cms = append(cms, o.(*v1.ConfigMap))
+	}
+	hash, err := configMapsHash(cms)
+	assert.NoError(t, err)
+
+	uu := map[string]struct {
+		key, hash string
+		issue     string
+	}{
+		"disabled": {
+			hash: "blee",
+		},
+		"no-annotation": {
+			key: key,
+		},
+		"match": {
+			key:  key,
+			hash: hash,
+		},
+		"mismatch": {
+			key:   key,
+			hash:  "blee",
+			issue: `[POP-412] Config hash annotation "checksum/config" does not match referenced ConfigMaps hash ` + hash + `. Pods may run stale config`,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			co := test.MakeCollector(t)
+			co.InitOutcome("default/dp1")
+			ctx := test.MakeContext("apps/v1/deployments", "deployments")
+			ctx = internal.WithSpec(ctx, SpecFor("default/dp1", nil))
+
+			tpl := v1.PodTemplateSpec{
+				Spec: v1.PodSpec{
+					Volumes: []v1.Volume{
+						{Name: "v1", VolumeSource: v1.VolumeSource{ConfigMap: &v1.ConfigMapVolumeSource{
+							LocalObjectReference: v1.LocalObjectReference{Name: "cm2"},
+						}}},
+					},
+					Containers: []v1.Container{
+						{
+							Name: "c1",
+							EnvFrom: []v1.EnvFromSource{
+								{ConfigMapRef: &v1.ConfigMapEnvSource{LocalObjectReference: v1.LocalObjectReference{Name: "cm1"}}},
+							},
+						},
+					},
+				},
+			}
+			if u.hash != "" {
+				tpl.ObjectMeta = metav1.ObjectMeta{Annotations: map[string]string{key: u.hash}}
+			}
+			checkConfigHash(ctx, co, dba, u.key, "default", tpl)
+
+			ii := co.Outcome()["default/dp1"]
+			if u.issue == "" {
+				assert.Equal(t, 0, len(ii))
+				return
+			}
+			assert.Equal(t, 1, len(ii))
+			assert.Equal(t, u.issue, ii[0].Message)
+			assert.Equal(t, rules.WarnLevel, ii[0].Level)
+		})
+	}
+}
+
+func TestConfigMapsHashInput(t *testing.T) {
+	cms := []*v1.ConfigMap{
+		{Data: map[string]string{"b": "2", "a": "1"}},
+		{Data: map[string]string{"c": "<&>"}},
+	}
+	h, err := configMapsHash(cms)
+	assert.NoError(t, err)
+
+	sum := sha256.Sum256([]byte(`{"a":"1","b":"2"}{"c":"\u003c\u0026\u003e"}`))
+	assert.Equal(t, hex.EncodeToString(sum[:]), h)
+}
@@ -5,12 +5,16 @@ package lint
 
 import (
 	"context"
+	"fmt"
 	"strings"
+	"time"
 
 	"github.com/derailed/popeye/internal"
 	"github.com/derailed/popeye/internal/client"
+	"github.com/derailed/popeye/internal/db"
 	"github.com/derailed/popeye/types"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
@@ -46,10 +50,133 @@ func (c *Container) sanitize(ctx context.Context, co v1.Container, checkProbes b
 		c.checkImageRegistry(ctx, co.Image)
 	}
 	c.checkResources(ctx, co)
+	c.checkQuantities(ctx, co)
 	if checkProbes {
 		c.checkProbes(ctx, co)
 	}
 	c.checkNamedPorts(ctx, co)
+	c.checkCommand(ctx, co)
+	c.checkRootFS(ctx, co)
+	c.checkCapabilities(ctx, co)
+	c.checkInteractive(ctx, co)
+	c.checkEnvSize(ctx, co)
+}
+
+// checkEnvSize flags oversized inline env values.
+func (c *Container) checkEnvSize(ctx context.Context, co v1.Container) {
+	limit := c.MaxEnvValueSize()
+	for _, e := range co.Env {
+		if n := len(e.Value); n > limit {
+			c.AddSubCode(ctx, 132, e.Name, n, limit)
+		}
+	}
+}
+
+// checkInteractive flags containers keeping stdin or a tty attached.
+func (c *Container) checkInteractive(ctx context.Context, co v1.Container) {
+	var ff []string
+	if co.Stdin {
+		ff = append(ff, "stdin")
+	}
+	if co.TTY {
+		ff = append(ff, "tty")
+	}
+	if len(ff) > 0 {
+		c.AddSubCode(ctx, 130, co.Name, strings.Join(ff, " and "))
+	}
+}
+
+// writablePaths tracks common paths containers are expected to write to.
+var writablePaths = []string{"/tmp", "/var/tmp", "/run", "/var/run", "/var/cache", "/var/log"}
+
+func (c *Container) checkRootFS(ctx context.Context, co v1.Container) {
+	if sc := co.SecurityContext; sc != nil && sc.ReadOnlyRootFilesystem != nil && *sc.ReadOnlyRootFilesystem {
+		return
+	}
+	if hasWritableMount(co) {
+		return
+	}
+	c.AddSubCode(ctx, 119, co.Name)
+}
+
+// hasWritableMount checks if a container mounts a writable volume at a common writable path.
+func hasWritableMount(co v1.Container) bool {
+	for _, m := range co.VolumeMounts {
+		if m.ReadOnly {
+			continue
+		}
+		for _, p := range writablePaths {
+			if m.MountPath == p || strings.HasPrefix(m.MountPath, p+"/") {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// dangerousCapabilities tracks capabilities granting host level privileges.
+var dangerousCapabilities = map[v1.Capability]struct{}{
+	"SYS_ADMIN":  {},
+	"NET_ADMIN":  {},
+	"SYS_PTRACE": {},
+}
+
+// defaultCapabilities tracks the container runtime default capability set.
+var defaultCapabilities = map[v1.Capability]struct{}{
+	"AUDIT_WRITE":      {},
+	"CHOWN":            {},
+	"DAC_OVERRIDE":     {},
+	"FOWNER":           {},
+	"FSETID":           {},
+	"KILL":             {},
+	"MKNOD":            {},
+	"NET_BIND_SERVICE": {},
+	"NET_RAW":          {},
+	"SETFCAP":          {},
+	"SETGID":           {},
+	"SETPCAP":          {},
+	"SETUID":           {},
+	"SYS_CHROOT":       {},
+}
+
+func (c *Container) checkCapabilities(ctx context.Context, co v1.Container) {
+	if co.SecurityContext == nil || co.SecurityContext.Capabilities == nil {
+		return
+	}
+	caps := co.SecurityContext.Capabilities
+	var dangerous, extra []string
+	for _, cp := range caps.Add {
+		cp = normalizeCapability(cp)
+		if _, ok := dangerousCapabilities[cp]; ok {
+			dangerous = append(dangerous, string(cp))
+			continue
+		}
+		if _, ok := defaultCapabilities[cp]; !ok {
+			extra = append(extra, string(cp))
+		}
+	}
+	if len(dangerous) > 0 {
+		c.AddSubCode(ctx, 120, co.Name, strings.Join(dangerous, ", "))
+	}
+	// Dropping ALL and adding back explicitly is the recommended practice.
+	if len(extra) > 0 && !dropsAll(caps) {
+		c.AddSubCode(ctx, 121, co.Name, strings.Join(extra, ", "))
+	}
+}
+
+func dropsAll(caps *v1.Capabilities) bool {
+	for _, cp := range caps.Drop {
+		if normalizeCapability(cp) == "ALL" {
+			return true
+		}
+	}
+
+	return false
+}
+
+func normalizeCapability(cp v1.Capability) v1.Capability {
+	return v1.Capability(strings.TrimPrefix(strings.ToUpper(string(cp)), "CAP_"))
 }
 
 func (c *Container) checkImageTags(ctx context.Context, image string) {
@@ -90,11 +217,128 @@ func (c *Container) checkProbes(ctx context.Context, co v1.Container) {
 		c.AddSubCode(ctx, 103)
 	} else {
 		c.checkNamedProbe(ctx, co.LivenessProbe, true)
+		c.checkProbeNamedPort(ctx, co, co.LivenessProbe, "Liveness")
+		c.checkExecProbe(ctx, co, co.LivenessProbe, "Liveness")
+		c.checkProbeScheme(ctx, co, co.LivenessProbe, "Liveness")
 	}
 	if co.ReadinessProbe == nil {
 		c.AddSubCode(ctx, 104)
 	} else {
 		c.checkNamedProbe(ctx, co.ReadinessProbe, false)
+		c.checkProbePort(ctx, co, co.ReadinessProbe)
+		c.checkProbeNamedPort(ctx, co, co.ReadinessProbe, "Readiness")
+		c.checkExecProbe(ctx, co, co.ReadinessProbe, "Readiness")
+		c.checkProbeScheme(ctx, co, co.ReadinessProbe, "Readiness")
+		c.checkReadinessTiming(ctx, co.ReadinessProbe)
+	}
+	if co.StartupProbe != nil {
+		c.checkProbeNamedPort(ctx, co, co.StartupProbe, "Startup")
+		c.checkExecProbe(ctx, co, co.StartupProbe, "Startup")
+		c.checkProbeScheme(ctx, co, co.StartupProbe, "Startup")
+	}
+}
+
+// shellessImages tracks image name hints for images shipping without a shell.
+var shellessImages = []string{"distroless", "scratch"}
+
+// shells tracks common shell binaries.
+var shells = map[string]struct{}{
+	"/bin/sh":   {},
+	"/bin/bash": {},
+	"/bin/ash":  {},
+	"sh":        {},
+	"bash":      {},
+}
+
+// checkExecProbe flags shell based exec probes on images likely lacking a shell.
+func (c *Container) checkExecProbe(ctx context.Context, co v1.Container, p *v1.Probe, kind string) {
+	if p == nil || p.ProbeHandler.Exec == nil || len(p.ProbeHandler.Exec.Command) == 0 {
+		return
+	}
+	if _, ok := shells[p.ProbeHandler.Exec.Command[0]]; !ok {
+		return
+	}
+	img := strings.ToLower(co.Image)
+	for _, hint := range shellessImages {
+		if strings.Contains(img, hint) {
+			c.AddSubCode(ctx, 127, kind, strings.Join(p.ProbeHandler.Exec.Command, " "), co.Image)
+			return
+		}
+	}
+}
+
+// Probe timing defaults as set by the api server.
+const (
+	defaultProbePeriod           = 10
+	defaultProbeFailureThreshold = 3
+	defaultProbeSuccessThreshold = 1
+)
+
+// maxReadinessWindow tracks how long a failing container may keep receiving
+// traffic before its endpoint is pulled out of rotation.
+const maxReadinessWindow = time.Minute
+
+func (c *Container) checkReadinessTiming(ctx context.Context, p *v1.Probe) {
+	period, failures, successes := p.PeriodSeconds, p.FailureThreshold, p.SuccessThreshold
+	if period <= 0 {
+		period = defaultProbePeriod
+	}
+	if failures <= 0 {
+		failures = defaultProbeFailureThreshold
+	}
+	if successes <= 0 {
+		successes = defaultProbeSuccessThreshold
+	}
+	if w := time.Duration(period*failures) * time.Second; w > maxReadinessWindow {
+		c.AddSubCode(ctx, 122, w)
+	}
+	if successes > 1 {
+		c.AddSubCode(ctx, 123, successes)
+	}
+}
+
+func (c *Container) checkProbeScheme(ctx context.Context, co v1.Container, p *v1.Probe, kind string) {
+	get := p.ProbeHandler.HTTPGet
+	if get == nil || get.Scheme != "" {
+		return
+	}
+	if isHTTPSPort(co, get.Port) {
+		c.AddSubCode(ctx, 118, kind, get.Port.String())
+	}
+}
+
+// isHTTPSPort checks if a probe port is inferred to serve TLS traffic.
+func isHTTPSPort(co v1.Container, port intstr.IntOrString) bool {
+	const (
+		httpsName = "https"
+		httpsPort = 443
+	)
+	for _, cp := range co.Ports {
+		if (port.Type == intstr.String && cp.Name == port.StrVal) || (port.Type == intstr.Int && cp.ContainerPort == port.IntVal) {
+			return cp.Name == httpsName || cp.ContainerPort == httpsPort
+		}
+	}
+
+	return port.StrVal == httpsName || (port.Type == intstr.Int && port.IntVal == httpsPort)
+}
+
+func (c *Container) checkProbePort(ctx context.Context, co v1.Container, p *v1.Probe) {
+	port, ok := probePort(p)
+	if !ok || port.Type != intstr.Int || len(co.Ports) == 0 {
+		return
+	}
+	for _, cp := range co.Ports {
+		if cp.ContainerPort == port.IntVal {
+			return
+		}
+	}
+	c.AddSubCode(ctx, 116, port.String())
+}
+
+// checkProbeNamedPort flags probes referencing undeclared named ports.
+func (c *Container) checkProbeNamedPort(ctx context.Context, co v1.Container, p *v1.Probe, kind string) {
+	if port, ok := probePort(p); ok && namedPortsFor(co).dangling(port) {
+		c.AddSubCode(ctx, 126, kind, port.StrVal)
 	}
 }
 
@@ -122,6 +366,52 @@ func (c *Container) checkResources(ctx context.Context, co v1.Container) {
 	}
 }
 
+// maxCPUCores tracks the cpu quantity past which a request is deemed bogus.
+const maxCPUCores = 1000
+
+// withAllocatable records the largest node allocatable resources in the context.
+func withAllocatable(ctx context.Context, dba *db.DB) context.Context {
+	return context.WithValue(ctx, internal.KeyAllocatable, maxAllocatable(dba))
+}
+
+// checkQuantities flags resource quantities that are most likely typos.
+func (c *Container) checkQuantities(ctx context.Context, co v1.Container) {
+	alloc, _ := ctx.Value(internal.KeyAllocatable).(v1.ResourceList)
+	for _, r := range []struct {
+		kind string
+		rl   v1.ResourceList
+	}{
+		{"request", co.Resources.Requests},
+		{"limit", co.Resources.Limits},
+	} {
+		for _, n := range []v1.ResourceName{v1.ResourceCPU, v1.ResourceMemory} {
+			q, ok := r.rl[n]
+			if !ok {
+				continue
+			}
+			if reason, ok := bogusQuantity(n, q, alloc); ok {
+				c.AddSubCode(ctx, 134, n, r.kind, q.String(), reason)
+			}
+		}
+	}
+}
+
+func bogusQuantity(n v1.ResourceName, q resource.Quantity, alloc v1.ResourceList) (string, bool) {
+	mem, hasMEM := alloc[v1.ResourceMemory]
+	switch {
+	case q.Sign() < 0:
+		return "quantity is negative", true
+	case n == v1.ResourceMemory && q.MilliValue()%1000 != 0:
+		return "memory is expressed in fractional bytes. Did you mean Mi?", true
+	case n == v1.ResourceCPU && q.Cmp(*resource.NewQuantity(maxCPUCores, resource.DecimalSI)) >= 0:
+		return fmt.Sprintf("cpu exceeds %d cores", maxCPUCores), true
+	case n == v1.ResourceMemory && hasMEM && q.Cmp(mem) > 0:
+		return fmt.Sprintf("memory exceeds the largest node allocatable %s", mem.String()), true
+	default:
+		return "", false
+	}
+}
+
 func (c *Container) checkNamedPorts(ctx context.Context, co v1.Container) {
 	for _, p := range co.Ports {
 		if len(p.Name) == 0 {
@@ -130,6 +420,19 @@ func (c *Container) checkNamedPorts(ctx context.Context, co v1.Container) {
 	}
 }
 
+func (c *Container) checkCommand(ctx context.Context, co v1.Container) {
+	if len(co.Command) == 0 {
+		return
+	}
+	if strings.TrimSpace(strings.Join(co.Command, "")) == "" {
+		c.AddSubCode(ctx, 115, co.Name)
+		return
+	}
+	if len(co.Args) == 0 {
+		c.AddSubCode(ctx, 114, co.Name)
+	}
+}
+
 func (c *Container) checkUtilization(ctx context.Context, co v1.Container, cmx client.Metrics) {
 	cpu, mem, qos := containerResources(co)
 	if cpu != nil && mem != nil {
@@ -145,6 +448,7 @@ func (c *Container) checkMetrics(ctx context.Context, qos qos, list, clist v1.Re
 	ccpu, cmem := clist.Cpu(), clist.Memory()
 	percCPU, cpuLimit := ToPerc(toMC(*ccpu), toMC(*cpu)), int64(c.PodCPULimit())
 	percMEM, memLimit := ToPerc(toMB(*cmem), toMB(*mem)), int64(c.PodMEMLimit())
+	recordUtilization(ctx, percCPU, percMEM)
 
 	switch qos {
 	case qosBurstable:
@@ -164,6 +468,16 @@ func (c *Container) checkMetrics(ctx context.Context, qos qos, list, clist v1.Re
 	}
 }
 
+// recordUtilization tracks container current/requested ratios when requested.
+func recordUtilization(ctx context.Context, percCPU, percMEM int64) {
+	u, ok := ctx.Value(internal.KeyUtilization).(*internal.Utilization)
+	if !ok {
+		return
+	}
+	ns, _ := namespaced(internal.MustExtractRunInfo(ctx).Spec.FQN)
+	u.Record(ns, float64(percCPU)/100, float64(percMEM)/100)
+}
+
 func (c *Container) allowedRegistryListExists() bool {
 	return len(c.LimitCollector.AllowedRegistries()) > 0
 }
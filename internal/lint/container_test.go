@@ -4,16 +4,20 @@
 package lint
 
 import (
+	"context"
+	"strings"
 	"testing"
 
 	"github.com/derailed/popeye/internal"
 	"github.com/derailed/popeye/internal/client"
+	"github.com/derailed/popeye/internal/db"
 	"github.com/derailed/popeye/internal/issues"
 	"github.com/derailed/popeye/internal/rules"
 	"github.com/derailed/popeye/internal/test"
 	"github.com/derailed/popeye/types"
 	"github.com/stretchr/testify/assert"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
@@ -267,12 +271,559 @@ func TestContainerCheckNamedPorts(t *testing.T) {
 	}
 }
 
+func TestContainerCheckCommand(t *testing.T) {
+	uu := map[string]struct {
+		command, args []string
+		issues        int
+		severity      rules.Level
+	}{
+		"no-command": {},
+		"command-args": {
+			command: []string{"/bin/fred"},
+			args:    []string{"--blee"},
+		},
+		"command-only": {
+			command:  []string{"/bin/fred"},
+			issues:   1,
+			severity: rules.InfoLevel,
+		},
+		"blank-command": {
+			command:  []string{" ", ""},
+			args:     []string{"--blee"},
+			issues:   1,
+			severity: rules.WarnLevel,
+		},
+	}
+
+	ctx := test.MakeContext("containers", "container")
+	ctx = internal.WithSpec(ctx, SpecFor("default/p1", nil))
+	ctx = internal.WithGroup(ctx, types.NewGVR("containers"), "c1")
+	for k := range uu {
+		u := uu[k]
+		co := makeContainer("c1", coOpts{})
+		co.Command, co.Args = u.command, u.args
+
+		l := NewContainer("default/p1", newRangeCollector(t))
+		t.Run(k, func(t *testing.T) {
+			l.checkCommand(ctx, co)
+
+			assert.Equal(t, u.issues, len(l.Outcome()["default/p1"]))
+			if u.issues != 0 {
+				assert.Equal(t, u.severity, l.Outcome().For("default/p1", "c1").MaxSeverity())
+			}
+		})
+	}
+}
+
+func TestContainerCheckProbePort(t *testing.T) {
+	uu := map[string]struct {
+		probe  v1.ProbeHandler
+		issues int
+	}{
+		"match": {
+			probe: v1.ProbeHandler{HTTPGet: &v1.HTTPGetAction{Port: intstr.FromInt(8080)}},
+		},
+		"match-named": {
+			probe: v1.ProbeHandler{TCPSocket: &v1.TCPSocketAction{Port: intstr.FromString("http")}},
+		},
+		"exec": {
+			probe: v1.ProbeHandler{Exec: &v1.ExecAction{Command: []string{"ls"}}},
+		},
+		"mismatch": {
+			probe:  v1.ProbeHandler{HTTPGet: &v1.HTTPGetAction{Port: intstr.FromInt(9090)}},
+			issues: 1,
+		},
+		"mismatch-named": {
+			probe:  v1.ProbeHandler{HTTPGet: &v1.HTTPGetAction{Port: intstr.FromString("metrics")}},
+			issues: 1,
+		},
+	}
+
+	ctx := test.MakeContext("containers", "container")
+	ctx = internal.WithSpec(ctx, SpecFor("default/p1", nil))
+	ctx = internal.WithGroup(ctx, types.NewGVR("containers"), "c1")
+	for k := range uu {
+		u := uu[k]
+		co := makeContainer("c1", coOpts{})
+		co.Ports = []v1.ContainerPort{{Name: "http", ContainerPort: 8080}}
+		co.ReadinessProbe = &v1.Probe{ProbeHandler: u.probe}
+
+		l := NewContainer("default/p1", newRangeCollector(t))
+		t.Run(k, func(t *testing.T) {
+			l.checkProbePort(ctx, co, co.ReadinessProbe)
+			l.checkProbeNamedPort(ctx, co, co.ReadinessProbe, "Readiness")
+
+			assert.Equal(t, u.issues, len(l.Outcome()["default/p1"]))
+			if u.issues != 0 {
+				assert.Equal(t, rules.WarnLevel, l.Outcome().For("default/p1", "c1").MaxSeverity())
+			}
+		})
+	}
+}
+
+func TestContainerCheckExecProbe(t *testing.T) {
+	uu := map[string]struct {
+		image string
+		cmd   []string
+		issue string
+	}{
+		"distroless-shell": {
+			image: "gcr.io/distroless/static:nonroot",
+			cmd:   []string{"/bin/sh", "-c", "test -f /tmp/healthy"},
+			issue: `[POP-127] Liveness probe runs "/bin/sh -c test -f /tmp/healthy" but image "gcr.io/distroless/static:nonroot" likely ships no shell`,
+		},
+		"distroless-binary": {
+			image: "gcr.io/distroless/static:nonroot",
+			cmd:   []string{"/app/healthcheck"},
+		},
+		"normal-shell": {
+			image: "alpine:3.19",
+			cmd:   []string{"/bin/sh", "-c", "test -f /tmp/healthy"},
+		},
+	}
+
+	ctx := test.MakeContext("containers", "container")
+	ctx = internal.WithSpec(ctx, SpecFor("default/p1", nil))
+	ctx = internal.WithGroup(ctx, types.NewGVR("containers"), "c1")
+	for k := range uu {
+		u := uu[k]
+		co := makeContainer("c1", coOpts{image: u.image})
+		probe := &v1.Probe{ProbeHandler: v1.ProbeHandler{Exec: &v1.ExecAction{Command: u.cmd}}}
+
+		l := NewContainer("default/p1", newRangeCollector(t))
+		t.Run(k, func(t *testing.T) {
+			l.checkExecProbe(ctx, co, probe, "Liveness")
+
+			ii := l.Outcome()["default/p1"]
+			if u.issue == "" {
+				assert.Equal(t, 0, len(ii))
+				return
+			}
+			assert.Equal(t, 1, len(ii))
+			assert.Equal(t, u.issue, ii[0].Message)
+			assert.Equal(t, rules.InfoLevel, ii[0].Level)
+		})
+	}
+}
+
+func TestContainerCheckProbeNamedPort(t *testing.T) {
+	uu := map[string]struct {
+		kind  string
+		port  intstr.IntOrString
+		issue string
+	}{
+		"valid": {
+			kind: "Liveness",
+			port: intstr.FromString("http"),
+		},
+		"typo": {
+			kind:  "Liveness",
+			port:  intstr.FromString("htpp"),
+			issue: `[POP-126] Liveness probe references named port "htpp" which is not declared by the container`,
+		},
+		"startup-typo": {
+			kind:  "Startup",
+			port:  intstr.FromString("metrics"),
+			issue: `[POP-126] Startup probe references named port "metrics" which is not declared by the container`,
+		},
+		"number": {
+			kind: "Liveness",
+			port: intstr.FromInt(9090),
+		},
+	}
+
+	ctx := test.MakeContext("containers", "container")
+	ctx = internal.WithSpec(ctx, SpecFor("default/p1", nil))
+	ctx = internal.WithGroup(ctx, types.NewGVR("containers"), "c1")
+	for k := range uu {
+		u := uu[k]
+		co := makeContainer("c1", coOpts{})
+		co.Ports = []v1.ContainerPort{{Name: "http", ContainerPort: 8080}}
+		probe := &v1.Probe{ProbeHandler: v1.ProbeHandler{HTTPGet: &v1.HTTPGetAction{Port: u.port}}}
+
+		l := NewContainer("default/p1", newRangeCollector(t))
+		t.Run(k, func(t *testing.T) {
+			l.checkProbeNamedPort(ctx, co, probe, u.kind)
+
+			ii := l.Outcome()["default/p1"]
+			if u.issue == "" {
+				assert.Equal(t, 0, len(ii))
+				return
+			}
+			assert.Equal(t, 1, len(ii))
+			assert.Equal(t, u.issue, ii[0].Message)
+			assert.Equal(t, rules.WarnLevel, ii[0].Level)
+		})
+	}
+}
+
+func TestContainerCheckProbeScheme(t *testing.T) {
+	uu := map[string]struct {
+		get    v1.HTTPGetAction
+		issues int
+	}{
+		"https-default": {
+			get:    v1.HTTPGetAction{Port: intstr.FromString("https")},
+			issues: 1,
+		},
+		"https-443": {
+			get:    v1.HTTPGetAction{Port: intstr.FromInt(443)},
+			issues: 1,
+		},
+		"https-explicit": {
+			get: v1.HTTPGetAction{Port: intstr.FromString("https"), Scheme: v1.URISchemeHTTPS},
+		},
+		"http": {
+			get: v1.HTTPGetAction{Port: intstr.FromString("http")},
+		},
+		"http-explicit": {
+			get: v1.HTTPGetAction{Port: intstr.FromInt(8080), Scheme: v1.URISchemeHTTP},
+		},
+	}
+
+	ctx := test.MakeContext("containers", "container")
+	ctx = internal.WithSpec(ctx, SpecFor("default/p1", nil))
+	ctx = internal.WithGroup(ctx, types.NewGVR("containers"), "c1")
+	for k := range uu {
+		u := uu[k]
+		co := makeContainer("c1", coOpts{})
+		co.Ports = []v1.ContainerPort{
+			{Name: "http", ContainerPort: 8080},
+			{Name: "https", ContainerPort: 443},
+		}
+		co.LivenessProbe = &v1.Probe{ProbeHandler: v1.ProbeHandler{HTTPGet: &u.get}}
+
+		l := NewContainer("default/p1", newRangeCollector(t))
+		t.Run(k, func(t *testing.T) {
+			l.checkProbeScheme(ctx, co, co.LivenessProbe, "Liveness")
+
+			assert.Equal(t, u.issues, len(l.Outcome()["default/p1"]))
+			if u.issues != 0 {
+				assert.Equal(t, rules.WarnLevel, l.Outcome().For("default/p1", "c1").MaxSeverity())
+				assert.Equal(t, "[POP-118] Liveness probe targets HTTPS port "+u.get.Port.String()+" but uses the default HTTP scheme", l.Outcome()["default/p1"][0].Message)
+			}
+		})
+	}
+}
+
+func TestContainerCheckReadinessTiming(t *testing.T) {
+	uu := map[string]struct {
+		probe v1.Probe
+		ee    []string
+	}{
+		"defaults": {},
+		"aggressive": {
+			probe: v1.Probe{PeriodSeconds: 2, FailureThreshold: 2, SuccessThreshold: 1},
+		},
+		"boundary": {
+			probe: v1.Probe{PeriodSeconds: 20, FailureThreshold: 3},
+		},
+		"lax": {
+			probe: v1.Probe{PeriodSeconds: 30, FailureThreshold: 5},
+			ee: []string{
+				`[POP-122] Readiness probe takes 2m30s to detect a failing container. Traffic keeps flowing to it in the meantime`,
+			},
+		},
+		"lax-success": {
+			probe: v1.Probe{FailureThreshold: 10, SuccessThreshold: 3},
+			ee: []string{
+				`[POP-122] Readiness probe takes 1m40s to detect a failing container. Traffic keeps flowing to it in the meantime`,
+				`[POP-123] Readiness probe requires 3 consecutive successes. Probe handler must be safe to repeat`,
+			},
+		},
+	}
+
+	ctx := test.MakeContext("containers", "container")
+	ctx = internal.WithSpec(ctx, SpecFor("default/p1", nil))
+	ctx = internal.WithGroup(ctx, types.NewGVR("containers"), "c1")
+	for k := range uu {
+		u := uu[k]
+		l := NewContainer("default/p1", newRangeCollector(t))
+		t.Run(k, func(t *testing.T) {
+			l.checkReadinessTiming(ctx, &u.probe)
+
+			ii := l.Outcome()["default/p1"]
+			assert.Equal(t, len(u.ee), len(ii))
+			for i, e := range u.ee {
+				assert.Equal(t, e, ii[i].Message)
+				assert.Equal(t, rules.InfoLevel, ii[i].Level)
+			}
+		})
+	}
+}
+
+func TestContainerCheckRootFS(t *testing.T) {
+	ro, rw := true, false
+	uu := map[string]struct {
+		sc     *v1.SecurityContext
+		mounts []v1.VolumeMount
+		issues int
+	}{
+		"read-only": {
+			sc: &v1.SecurityContext{ReadOnlyRootFilesystem: &ro},
+		},
+		"writable": {
+			sc:     &v1.SecurityContext{ReadOnlyRootFilesystem: &rw},
+			issues: 1,
+		},
+		"unset": {
+			issues: 1,
+		},
+		"tmp-volume": {
+			mounts: []v1.VolumeMount{{Name: "tmp", MountPath: "/tmp"}},
+		},
+		"nested-volume": {
+			mounts: []v1.VolumeMount{{Name: "logs", MountPath: "/var/log/app"}},
+		},
+		"read-only-volume": {
+			mounts: []v1.VolumeMount{{Name: "tmp", MountPath: "/tmp", ReadOnly: true}},
+			issues: 1,
+		},
+		"other-volume": {
+			mounts: []v1.VolumeMount{{Name: "data", MountPath: "/data"}},
+			issues: 1,
+		},
+	}
+
+	ctx := test.MakeContext("containers", "container")
+	ctx = internal.WithSpec(ctx, SpecFor("default/p1", nil))
+	ctx = internal.WithGroup(ctx, types.NewGVR("containers"), "c1")
+	for k := range uu {
+		u := uu[k]
+		co := makeContainer("c1", coOpts{})
+		co.SecurityContext, co.VolumeMounts = u.sc, u.mounts
+
+		l := NewContainer("default/p1", newRangeCollector(t))
+		t.Run(k, func(t *testing.T) {
+			l.checkRootFS(ctx, co)
+
+			assert.Equal(t, u.issues, len(l.Outcome()["default/p1"]))
+			if u.issues != 0 {
+				assert.Equal(t, rules.InfoLevel, l.Outcome().For("default/p1", "c1").MaxSeverity())
+				assert.Equal(t, `[POP-119] Container "c1" root filesystem is writable. Consider setting readOnlyRootFilesystem`, l.Outcome()["default/p1"][0].Message)
+			}
+		})
+	}
+}
+
+func TestContainerCheckInteractive(t *testing.T) {
+	uu := map[string]struct {
+		stdin, tty bool
+		e          string
+	}{
+		"neither": {},
+		"stdin": {
+			stdin: true,
+			e:     `[POP-130] Container "c1" has stdin enabled. Likely a debugging leftover`,
+		},
+		"tty": {
+			tty: true,
+			e:   `[POP-130] Container "c1" has tty enabled. Likely a debugging leftover`,
+		},
+		"both": {
+			stdin: true,
+			tty:   true,
+			e:     `[POP-130] Container "c1" has stdin and tty enabled. Likely a debugging leftover`,
+		},
+	}
+
+	ctx := test.MakeContext("containers", "container")
+	ctx = internal.WithSpec(ctx, SpecFor("default/p1", nil))
+	ctx = internal.WithGroup(ctx, types.NewGVR("containers"), "c1")
+	for k := range uu {
+		u := uu[k]
+		co := makeContainer("c1", coOpts{})
+		co.Stdin, co.TTY = u.stdin, u.tty
+
+		l := NewContainer("default/p1", newRangeCollector(t))
+		t.Run(k, func(t *testing.T) {
+			l.checkInteractive(ctx, co)
+
+			ii := l.Outcome()["default/p1"]
+			if u.e == "" {
+				assert.Equal(t, 0, len(ii))
+				return
+			}
+			assert.Equal(t, 1, len(ii))
+			assert.Equal(t, u.e, ii[0].Message)
+			assert.Equal(t, rules.InfoLevel, ii[0].Level)
+		})
+	}
+}
+
+func TestContainerCheckEnvSize(t *testing.T) {
+	uu := map[string]struct {
+		size int
+		e    string
+	}{
+		"normal": {
+			size: 64,
+		},
+		"at-threshold": {
+			size: 4096,
+		},
+		"oversized": {
+			size: 5000,
+			e:    `[POP-132] Env "BLOB" value is 5000 bytes, above the 4096 bytes threshold. Large values belong in a ConfigMap or Secret`,
+		},
+	}
+
+	ctx := test.MakeContext("containers", "container")
+	ctx = internal.WithSpec(ctx, SpecFor("default/p1", nil))
+	ctx = internal.WithGroup(ctx, types.NewGVR("containers"), "c1")
+	for k := range uu {
+		u := uu[k]
+		co := makeContainer("c1", coOpts{})
+		co.Env = []v1.EnvVar{
+			{Name: "SMALL", Value: "fred"},
+			{Name: "BLOB", Value: strings.Repeat("x", u.size)},
+		}
+
+		l := NewContainer("default/p1", newRangeCollector(t))
+		t.Run(k, func(t *testing.T) {
+			l.checkEnvSize(ctx, co)
+
+			ii := l.Outcome()["default/p1"]
+			if u.e == "" {
+				assert.Equal(t, 0, len(ii))
+				return
+			}
+			assert.Equal(t, 1, len(ii))
+			assert.Equal(t, u.e, ii[0].Message)
+			assert.Equal(t, rules.InfoLevel, ii[0].Level)
+		})
+	}
+}
+
+func TestContainerCheckQuantities(t *testing.T) {
+	alloc := v1.ResourceList{v1.ResourceMemory: resource.MustParse("64Gi")}
+	uu := map[string]struct {
+		requests, limits, alloc v1.ResourceList
+		issues                  []string
+	}{
+		"normal": {
+			requests: v1.ResourceList{
+				v1.ResourceCPU:    resource.MustParse("100m"),
+				v1.ResourceMemory: resource.MustParse("128Mi"),
+			},
+			limits: v1.ResourceList{v1.ResourceMemory: resource.MustParse("256Mi")},
+		},
+		"millibytes": {
+			requests: v1.ResourceList{v1.ResourceMemory: resource.MustParse("100m")},
+			issues: []string{
+				`[POP-134] Suspicious memory request "100m": memory is expressed in fractional bytes. Did you mean Mi?`,
+			},
+		},
+		"absurd-cpu": {
+			requests: v1.ResourceList{v1.ResourceCPU: resource.MustParse("2000")},
+			limits:   v1.ResourceList{v1.ResourceCPU: resource.MustParse("2k")},
+			issues: []string{
+				`[POP-134] Suspicious cpu request "2k": cpu exceeds 1000 cores`,
+				`[POP-134] Suspicious cpu limit "2k": cpu exceeds 1000 cores`,
+			},
+		},
+		"negative": {
+			limits: v1.ResourceList{v1.ResourceMemory: resource.MustParse("-1Gi")},
+			issues: []string{
+				`[POP-134] Suspicious memory limit "-1Gi": quantity is negative`,
+			},
+		},
+		"within-node-memory": {
+			requests: v1.ResourceList{v1.ResourceMemory: resource.MustParse("32Gi")},
+			limits:   v1.ResourceList{v1.ResourceMemory: resource.MustParse("64Gi")},
+			alloc:    alloc,
+		},
+		"beyond-node-memory": {
+			requests: v1.ResourceList{v1.ResourceMemory: resource.MustParse("32Gi")},
+			limits:   v1.ResourceList{v1.ResourceMemory: resource.MustParse("1000Gi")},
+			alloc:    alloc,
+			issues: []string{
+				`[POP-134] Suspicious memory limit "1000Gi": memory exceeds the largest node allocatable 64Gi`,
+			},
+		},
+		"no-nodes": {
+			limits: v1.ResourceList{v1.ResourceMemory: resource.MustParse("1000Gi")},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		co := makeContainer("c1", coOpts{})
+		co.Resources = v1.ResourceRequirements{Requests: u.requests, Limits: u.limits}
+
+		ctx := test.MakeContext("containers", "container")
+		ctx = internal.WithSpec(ctx, SpecFor("default/p1", nil))
+		ctx = internal.WithGroup(ctx, types.NewGVR("containers"), "c1")
+		ctx = context.WithValue(ctx, internal.KeyAllocatable, u.alloc)
+		l := NewContainer("default/p1", newRangeCollector(t))
+		t.Run(k, func(t *testing.T) {
+			l.checkQuantities(ctx, co)
+
+			ii := l.Outcome()["default/p1"]
+			assert.Equal(t, len(u.issues), len(ii))
+			for i, m := range u.issues {
+				assert.Equal(t, m, ii[i].Message)
+				assert.Equal(t, rules.WarnLevel, ii[i].Level)
+			}
+		})
+	}
+}
+
+func TestContainerCheckCapabilities(t *testing.T) {
+	uu := map[string]struct {
+		caps *v1.Capabilities
+		ee   []string
+	}{
+		"none": {},
+		"dangerous": {
+			caps: &v1.Capabilities{Add: []v1.Capability{"SYS_ADMIN", "CAP_NET_ADMIN"}},
+			ee:   []string{`[POP-120] Container "c1" adds dangerous capabilities: SYS_ADMIN, NET_ADMIN`},
+		},
+		"benign": {
+			caps: &v1.Capabilities{Add: []v1.Capability{"IPC_LOCK", "CHOWN"}},
+			ee:   []string{`[POP-121] Container "c1" adds non default capabilities: IPC_LOCK`},
+		},
+		"default": {
+			caps: &v1.Capabilities{Add: []v1.Capability{"NET_BIND_SERVICE"}},
+		},
+		"drop-all": {
+			caps: &v1.Capabilities{Drop: []v1.Capability{"ALL"}, Add: []v1.Capability{"IPC_LOCK"}},
+		},
+		"drop-all-dangerous": {
+			caps: &v1.Capabilities{Drop: []v1.Capability{"ALL"}, Add: []v1.Capability{"SYS_PTRACE", "IPC_LOCK"}},
+			ee:   []string{`[POP-120] Container "c1" adds dangerous capabilities: SYS_PTRACE`},
+		},
+	}
+
+	ctx := test.MakeContext("containers", "container")
+	ctx = internal.WithSpec(ctx, SpecFor("default/p1", nil))
+	ctx = internal.WithGroup(ctx, types.NewGVR("containers"), "c1")
+	for k := range uu {
+		u := uu[k]
+		co := makeContainer("c1", coOpts{})
+		if u.caps != nil {
+			co.SecurityContext = &v1.SecurityContext{Capabilities: u.caps}
+		}
+
+		l := NewContainer("default/p1", newRangeCollector(t))
+		t.Run(k, func(t *testing.T) {
+			l.checkCapabilities(ctx, co)
+
+			ii := l.Outcome()["default/p1"]
+			assert.Equal(t, len(u.ee), len(ii))
+			for i, e := range u.ee {
+				assert.Equal(t, e, ii[i].Message)
+			}
+		})
+	}
+}
+
 func TestContainerLint(t *testing.T) {
 	uu := map[string]struct {
 		co     v1.Container
 		issues int
 	}{
-		"NoImgNoProbs": {makeContainer("c1", coOpts{}), 3},
+		"NoImgNoProbs": {makeContainer("c1", coOpts{}), 4},
 	}
 
 	ctx := test.MakeContext("containers", "container")
@@ -282,7 +833,7 @@ func TestContainerLint(t *testing.T) {
 		t.Run(k, func(t *testing.T) {
 			c.sanitize(ctx, u.co, true)
 
-			assert.Equal(t, 3, len(c.Outcome()[""]))
+			assert.Equal(t, 4, len(c.Outcome()[""]))
 			assert.Equal(t, u.issues, len(c.Outcome().For("", "c1")))
 		})
 	}
@@ -347,3 +898,16 @@ func makeContainer(n string, opts coOpts) v1.Container {
 
 	return co
 }
+
+func TestWithAllocatable(t *testing.T) {
+	dba, err := test.NewTestDB()
+	assert.NoError(t, err)
+	l := db.NewLoader(dba)
+	assert.NoError(t, test.LoadDB[*v1.Node](test.MakeCtx(t), l.DB, "core/node/1.yaml", test.Glossary[internal.NO]))
+
+	ctx := withAllocatable(context.Background(), dba)
+	alloc, ok := ctx.Value(internal.KeyAllocatable).(v1.ResourceList)
+	assert.True(t, ok)
+	mem := alloc[v1.ResourceMemory]
+	assert.Equal(t, "8124744Ki", mem.String())
+}
@@ -66,7 +66,7 @@ func (s *ClusterRole) Lint(ctx context.Context) error {
 }
 
 func (s *ClusterRole) checkStale(ctx context.Context, refs *sync.Map) {
-	txn, it := s.db.MustITFor(internal.Glossary[internal.CR])
+	txn, it := s.db.MustITFor(s.db.GVR(internal.CR))
 	defer txn.Abort()
 	for o := it.Next(); o != nil; o = it.Next() {
 		cr := o.(*rbacv1.ClusterRole)
@@ -79,5 +79,6 @@ func (s *ClusterRole) checkStale(ctx context.Context, refs *sync.Map) {
 		if _, ok := refs.Load(cache.ResFqn(cache.ClusterRoleKey, fqn)); !ok {
 			s.AddCode(ctx, 400)
 		}
+		runChecks(ctx, internal.CR, s, cr)
 	}
 }
@@ -39,7 +39,7 @@ func (c *ClusterRoleBinding) Lint(ctx context.Context) error {
 }
 
 func (c *ClusterRoleBinding) checkInUse(ctx context.Context) {
-	txn, it := c.db.MustITFor(internal.Glossary[internal.CRB])
+	txn, it := c.db.MustITFor(c.db.GVR(internal.CRB))
 	defer txn.Abort()
 	for o := it.Next(); o != nil; o = it.Next() {
 		crb := o.(*rbacv1.ClusterRoleBinding)
@@ -50,22 +50,23 @@ func (c *ClusterRoleBinding) checkInUse(ctx context.Context) {
 
 		switch crb.RoleRef.Kind {
 		case "ClusterRole":
-			if !c.db.Exists(internal.Glossary[internal.CR], crb.RoleRef.Name) {
+			if !c.db.Exists(c.db.GVR(internal.CR), crb.RoleRef.Name) {
 				c.AddCode(ctx, 1300, crb.RoleRef.Kind, crb.RoleRef.Name)
 			}
 		case "Role":
 			rFQN := cache.FQN(crb.Namespace, crb.RoleRef.Name)
-			if !c.db.Exists(internal.Glossary[internal.RO], rFQN) {
+			if !c.db.Exists(c.db.GVR(internal.RO), rFQN) {
 				c.AddCode(ctx, 1300, crb.RoleRef.Kind, rFQN)
 			}
 		}
 		for _, s := range crb.Subjects {
 			if s.Kind == "ServiceAccount" {
 				safqn := cache.FQN(s.Namespace, s.Name)
-				if !c.db.Exists(internal.Glossary[internal.SA], safqn) {
+				if !c.db.Exists(c.db.GVR(internal.SA), safqn) {
 					c.AddCode(ctx, 1300, s.Kind, safqn)
 				}
 			}
 		}
+		runChecks(ctx, internal.CRB, c, crb)
 	}
 }
@@ -5,6 +5,7 @@ package lint
 
 import (
 	"context"
+	"time"
 
 	"github.com/derailed/popeye/internal"
 	"github.com/derailed/popeye/internal/cache"
@@ -12,10 +13,15 @@ import (
 	"github.com/derailed/popeye/internal/dao"
 	"github.com/derailed/popeye/internal/db"
 	"github.com/derailed/popeye/internal/issues"
+	"github.com/derailed/popeye/types"
 	batchv1 "k8s.io/api/batch/v1"
 	v1 "k8s.io/api/core/v1"
 )
 
+// forbidRiskInterval flags Forbid schedules without job history firing at
+// least this often.
+const forbidRiskInterval = 5 * time.Minute
+
 // CronJob tracks CronJob linting.
 type CronJob struct {
 	*issues.Collector
@@ -34,7 +40,8 @@ func NewCronJob(co *issues.Collector, db *db.DB) *CronJob {
 // Lint cleanse the resource.
 func (s *CronJob) Lint(ctx context.Context) error {
 	over := pullOverAllocs(ctx)
-	txn, it := s.db.MustITFor(internal.Glossary[internal.CJOB])
+	ctx = withAllocatable(ctx, s.db)
+	txn, it := s.db.MustITFor(s.db.GVR(internal.CJOB))
 	defer txn.Abort()
 	for o := it.Next(); o != nil; o = it.Next() {
 		cj := o.(*batchv1.CronJob)
@@ -42,8 +49,11 @@ func (s *CronJob) Lint(ctx context.Context) error {
 		s.InitOutcome(fqn)
 		ctx = internal.WithSpec(ctx, SpecFor(fqn, cj))
 		s.checkCronJob(ctx, fqn, cj)
+		checkActiveDeadline(ctx, s, cj.Spec.JobTemplate.Spec)
+		s.checkConcurrency(ctx, fqn, cj)
 		s.checkContainers(ctx, fqn, cj.Spec.JobTemplate.Spec.Template.Spec)
 		s.checkUtilization(ctx, over, fqn)
+		runChecks(ctx, internal.CJOB, s, cj)
 	}
 
 	return nil
@@ -51,7 +61,7 @@ func (s *CronJob) Lint(ctx context.Context) error {
 
 // CheckCronJob checks if CronJob contract is currently happy or not.
 func (s *CronJob) checkCronJob(ctx context.Context, fqn string, cj *batchv1.CronJob) {
-	checkEvents(ctx, s.Collector, internal.CJOB, "", "CronJob", fqn)
+	checkEvents(ctx, s.Collector, s.db.GVR(internal.CJOB), "", "CronJob", fqn)
 
 	if cj.Spec.Suspend != nil && *cj.Spec.Suspend {
 		s.AddCode(ctx, 1500, cj.Kind)
@@ -66,12 +76,39 @@ func (s *CronJob) checkCronJob(ctx context.Context, fqn string, cj *batchv1.Cron
 
 	if sa := cj.Spec.JobTemplate.Spec.Template.Spec.ServiceAccountName; sa != "" {
 		saFQN := client.FQN(cj.Namespace, sa)
-		if !s.db.Exists(internal.Glossary[internal.SA], saFQN) {
+		if !s.db.Exists(s.db.GVR(internal.SA), saFQN) {
 			s.AddCode(ctx, 307, cj.Kind, sa)
 		}
 	}
 }
 
+// checkConcurrency flags Forbid schedules firing faster than jobs complete.
+// Without job history, very frequent schedules are flagged as a risk.
+func (s *CronJob) checkConcurrency(ctx context.Context, fqn string, cj *batchv1.CronJob) {
+	if cj.Spec.ConcurrencyPolicy != batchv1.ForbidConcurrent {
+		return
+	}
+	every, err := scheduleInterval(cj.Spec.Schedule)
+	if err != nil {
+		return
+	}
+	jj, err := s.db.FindJobs(fqn)
+	if err != nil {
+		s.AddErr(ctx, err)
+		return
+	}
+	runtime, ok := longestRun(jj)
+	if !ok {
+		if every <= forbidRiskInterval {
+			s.AddCode(ctx, 1506, cj.Spec.Schedule, every)
+		}
+		return
+	}
+	if runtime >= every {
+		s.AddCode(ctx, 1505, cj.Spec.Schedule, every, runtime.Round(time.Second))
+	}
+}
+
 // CheckContainers runs thru CronJob template and checks pod configuration.
 func (s *CronJob) checkContainers(ctx context.Context, fqn string, spec v1.PodSpec) {
 	c := NewContainer(fqn, s)
@@ -100,8 +137,8 @@ func (s *CronJob) checkUtilization(ctx context.Context, over bool, fqn string) {
 
 // Helpers...
 
-func checkEvents(ctx context.Context, ii *issues.Collector, r internal.R, kind, object, fqn string) {
-	ee, err := dao.EventsFor(ctx, internal.Glossary[r], kind, object, fqn)
+func checkEvents(ctx context.Context, ii *issues.Collector, gvr types.GVR, kind, object, fqn string) {
+	ee, err := dao.EventsFor(ctx, gvr, kind, object, fqn)
 	if err != nil {
 		ii.AddErr(ctx, err)
 		return
@@ -111,6 +148,25 @@ func checkEvents(ctx context.Context, ii *issues.Collector, r internal.R, kind,
 	}
 }
 
+// longestRun returns the longest duration among completed jobs.
+func longestRun(jj []*batchv1.Job) (time.Duration, bool) {
+	var (
+		longest time.Duration
+		ok      bool
+	)
+	for _, j := range jj {
+		if j.Status.StartTime == nil || j.Status.CompletionTime == nil {
+			continue
+		}
+		if d := j.Status.CompletionTime.Sub(j.Status.StartTime.Time); d > longest {
+			longest = d
+		}
+		ok = true
+	}
+
+	return longest, ok
+}
+
 func jobResourceUsage(dba *db.DB, jobs []*batchv1.Job) ConsumptionMetrics {
 	var mx ConsumptionMetrics
 
@@ -27,25 +27,29 @@ func TestCronJobLint(t *testing.T) {
 	l := db.NewLoader(dba)
 
 	ctx := test.MakeCtx(t)
-	assert.NoError(t, test.LoadDB[*batchv1.CronJob](ctx, l.DB, "batch/cjob/1.yaml", internal.Glossary[internal.CJOB]))
-	assert.NoError(t, test.LoadDB[*batchv1.Job](ctx, l.DB, "batch/job/1.yaml", internal.Glossary[internal.JOB]))
-	assert.NoError(t, test.LoadDB[*v1.ServiceAccount](ctx, l.DB, "core/sa/1.yaml", internal.Glossary[internal.SA]))
-	assert.NoError(t, test.LoadDB[*v1.Pod](ctx, l.DB, "core/pod/1.yaml", internal.Glossary[internal.PO]))
-	assert.NoError(t, test.LoadDB[*mv1beta1.PodMetrics](ctx, l.DB, "mx/pod/1.yaml", internal.Glossary[internal.PMX]))
+	assert.NoError(t, test.LoadDB[*batchv1.CronJob](ctx, l.DB, "batch/cjob/1.yaml", test.Glossary[internal.CJOB]))
+	assert.NoError(t, test.LoadDB[*batchv1.Job](ctx, l.DB, "batch/job/1.yaml", test.Glossary[internal.JOB]))
+	assert.NoError(t, test.LoadDB[*v1.ServiceAccount](ctx, l.DB, "core/sa/1.yaml", test.Glossary[internal.SA]))
+	assert.NoError(t, test.LoadDB[*v1.Pod](ctx, l.DB, "core/pod/1.yaml", test.Glossary[internal.PO]))
+	assert.NoError(t, test.LoadDB[*mv1beta1.PodMetrics](ctx, l.DB, "mx/pod/1.yaml", test.Glossary[internal.PMX]))
 
 	cj := NewCronJob(test.MakeCollector(t), dba)
 	assert.Nil(t, cj.Lint(test.MakeContext("batch/v1/cronjobs", "cronjobs")))
 	assert.Equal(t, 2, len(cj.Outcome()))
 
 	ii := cj.Outcome()["default/cj1"]
-	assert.Equal(t, 2, len(ii))
-	assert.Equal(t, `[POP-503] At current load, CPU under allocated. Current:2000m vs Requested:1m (200000.00%)`, ii[0].Message)
-	assert.Equal(t, rules.WarnLevel, ii[0].Level)
-	assert.Equal(t, `[POP-505] At current load, Memory under allocated. Current:20Mi vs Requested:1Mi (2000.00%)`, ii[1].Message)
-	assert.Equal(t, rules.WarnLevel, ii[1].Level)
+	assert.Equal(t, 4, len(ii))
+	assert.Equal(t, `[POP-1504] No activeDeadlineSeconds set. Runaway jobs may run forever`, ii[0].Message)
+	assert.Equal(t, rules.InfoLevel, ii[0].Level)
+	assert.Equal(t, `[POP-119] Container "c1" root filesystem is writable. Consider setting readOnlyRootFilesystem`, ii[1].Message)
+	assert.Equal(t, rules.InfoLevel, ii[1].Level)
+	assert.Equal(t, `[POP-503] At current load, CPU under allocated. Current:2000m vs Requested:1m (200000.00%)`, ii[2].Message)
+	assert.Equal(t, rules.WarnLevel, ii[2].Level)
+	assert.Equal(t, `[POP-505] At current load, Memory under allocated. Current:20Mi vs Requested:1Mi (2000.00%)`, ii[3].Message)
+	assert.Equal(t, rules.WarnLevel, ii[3].Level)
 
 	ii = cj.Outcome()["default/cj2"]
-	assert.Equal(t, 6, len(ii))
+	assert.Equal(t, 9, len(ii))
 	assert.Equal(t, `[POP-1500] CronJob is suspended`, ii[0].Message)
 	assert.Equal(t, rules.WarnLevel, ii[0].Level)
 	assert.Equal(t, `[POP-1501] No active jobs detected`, ii[1].Message)
@@ -54,8 +58,77 @@ func TestCronJobLint(t *testing.T) {
 	assert.Equal(t, rules.WarnLevel, ii[2].Level)
 	assert.Equal(t, `[POP-307] CronJob references a non existing ServiceAccount: "sa-bozo"`, ii[3].Message)
 	assert.Equal(t, rules.WarnLevel, ii[3].Level)
-	assert.Equal(t, `[POP-100] Untagged docker image in use`, ii[4].Message)
-	assert.Equal(t, rules.ErrorLevel, ii[4].Level)
-	assert.Equal(t, `[POP-106] No resources requests/limits defined`, ii[5].Message)
-	assert.Equal(t, rules.WarnLevel, ii[5].Level)
+	assert.Equal(t, `[POP-1504] No activeDeadlineSeconds set. Runaway jobs may run forever`, ii[4].Message)
+	assert.Equal(t, rules.InfoLevel, ii[4].Level)
+	assert.Equal(t, "[POP-1506] Schedule \"* * * * *\" fires every 1m0s with Forbid concurrency and no job history to gauge runtime. Runs may be skipped", ii[5].Message)
+	assert.Equal(t, rules.InfoLevel, ii[5].Level)
+	assert.Equal(t, `[POP-100] Untagged docker image in use`, ii[6].Message)
+	assert.Equal(t, rules.ErrorLevel, ii[6].Level)
+	assert.Equal(t, `[POP-106] No resources requests/limits defined`, ii[7].Message)
+	assert.Equal(t, rules.WarnLevel, ii[7].Level)
+	assert.Equal(t, `[POP-119] Container "c1" root filesystem is writable. Consider setting readOnlyRootFilesystem`, ii[8].Message)
+	assert.Equal(t, rules.InfoLevel, ii[8].Level)
+}
+
+func TestCronJobCheckConcurrency(t *testing.T) {
+	uu := map[string]struct {
+		name, schedule string
+		policy         batchv1.ConcurrencyPolicy
+		issue          string
+	}{
+		"allow": {
+			name:     "cj1",
+			schedule: "*/5 * * * *",
+			policy:   batchv1.AllowConcurrent,
+		},
+		"spaced": {
+			name:     "cj1",
+			schedule: "*/15 * * * *",
+			policy:   batchv1.ForbidConcurrent,
+		},
+		"fast-long-jobs": {
+			name:     "cj1",
+			schedule: "*/5 * * * *",
+			policy:   batchv1.ForbidConcurrent,
+			issue:    `[POP-1505] Schedule "*/5 * * * *" fires every 5m0s but recent jobs ran up to 7m30s. Forbid concurrency will skip runs`,
+		},
+		"no-history-spaced": {
+			name:     "cj2",
+			schedule: "@hourly",
+			policy:   batchv1.ForbidConcurrent,
+		},
+		"no-history-fast": {
+			name:     "cj2",
+			schedule: "* * * * *",
+			policy:   batchv1.ForbidConcurrent,
+			issue:    `[POP-1506] Schedule "* * * * *" fires every 1m0s with Forbid concurrency and no job history to gauge runtime. Runs may be skipped`,
+		},
+	}
+
+	dba, err := test.NewTestDB()
+	assert.NoError(t, err)
+	l := db.NewLoader(dba)
+	ctx := test.MakeCtx(t)
+	assert.NoError(t, test.LoadDB[*batchv1.Job](ctx, l.DB, "batch/job/2.yaml", test.Glossary[internal.JOB]))
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			s := NewCronJob(test.MakeCollector(t), dba)
+			fqn := "default/" + u.name
+			s.InitOutcome(fqn)
+			ctx := internal.WithSpec(test.MakeContext("batch/v1/cronjobs", "cronjobs"), SpecFor(fqn, nil))
+			cj := batchv1.CronJob{Spec: batchv1.CronJobSpec{Schedule: u.schedule, ConcurrencyPolicy: u.policy}}
+			s.checkConcurrency(ctx, fqn, &cj)
+
+			ii := s.Outcome()[fqn]
+			if u.issue == "" {
+				assert.Equal(t, 0, len(ii))
+				return
+			}
+			assert.Equal(t, 1, len(ii))
+			assert.Equal(t, u.issue, ii[0].Message)
+			assert.Equal(t, rules.InfoLevel, ii[0].Level)
+		})
+	}
 }
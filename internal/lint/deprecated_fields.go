@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Popeye
+
+package lint
+
+import (
+	"context"
+
+	"github.com/blang/semver/v4"
+	"github.com/derailed/popeye/internal"
+	"github.com/derailed/popeye/internal/dag"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/version"
+)
+
+// deprecatedVolume tracks an in-tree volume plugin slated for removal.
+type deprecatedVolume struct {
+	field               string
+	deprecated, removed string
+	replace             string
+	uses                func(v1.VolumeSource) bool
+}
+
+// deprecatedVolumes lists in-tree volume plugins by the version they were
+// deprecated in. A blank removed version means the plugin still ships.
+var deprecatedVolumes = []deprecatedVolume{
+	{"gitRepo", "1.11", "", "an init container cloning into an emptyDir", func(v v1.VolumeSource) bool { return v.GitRepo != nil }},
+	{"scaleIO", "1.16", "1.22", "a ScaleIO CSI driver", func(v v1.VolumeSource) bool { return v.ScaleIO != nil }},
+	{"gcePersistentDisk", "1.17", "1.28", "the pd.csi.storage.gke.io CSI driver", func(v v1.VolumeSource) bool { return v.GCEPersistentDisk != nil }},
+	{"awsElasticBlockStore", "1.19", "1.27", "the ebs.csi.aws.com CSI driver", func(v v1.VolumeSource) bool { return v.AWSElasticBlockStore != nil }},
+	{"azureDisk", "1.19", "1.27", "the disk.csi.azure.com CSI driver", func(v v1.VolumeSource) bool { return v.AzureDisk != nil }},
+	{"flocker", "1.22", "1.25", "a CSI driver", func(v v1.VolumeSource) bool { return v.Flocker != nil }},
+	{"quobyte", "1.22", "1.25", "a Quobyte CSI driver", func(v v1.VolumeSource) bool { return v.Quobyte != nil }},
+	{"storageos", "1.22", "1.25", "a StorageOS CSI driver", func(v v1.VolumeSource) bool { return v.StorageOS != nil }},
+	{"glusterfs", "1.25", "1.26", "a GlusterFS CSI driver", func(v v1.VolumeSource) bool { return v.Glusterfs != nil }},
+	{"cephfs", "1.28", "1.31", "the cephfs.csi.ceph.com CSI driver", func(v v1.VolumeSource) bool { return v.CephFS != nil }},
+	{"rbd", "1.28", "1.31", "the rbd.csi.ceph.com CSI driver", func(v v1.VolumeSource) bool { return v.RBD != nil }},
+}
+
+// checkDeprecatedFields flags volumes relying on in-tree plugins deprecated or
+// removed on the current cluster version.
+func (s *Pod) checkDeprecatedFields(ctx context.Context, spec v1.PodSpec) {
+	rev, ok := clusterVersion(ctx)
+	if !ok {
+		return
+	}
+	for _, v := range spec.Volumes {
+		for _, d := range deprecatedVolumes {
+			if !d.uses(v.VolumeSource) {
+				continue
+			}
+			if d.removed != "" && rev.GTE(semver.MustParse(d.removed+".0")) {
+				s.AddCode(ctx, 240, v.Name, d.field, "removed", d.removed, d.replace)
+				continue
+			}
+			if rev.GTE(semver.MustParse(d.deprecated + ".0")) {
+				s.AddCode(ctx, 240, v.Name, d.field, "deprecated", d.deprecated, d.replace)
+			}
+		}
+	}
+}
+
+// clusterVersion returns the api server version stashed in the context.
+func clusterVersion(ctx context.Context) (*semver.Version, bool) {
+	info, ok := ctx.Value(internal.KeyVersion).(*version.Info)
+	if !ok {
+		return nil, false
+	}
+	rev, err := dag.ParseVersion(info)
+	if err != nil {
+		return nil, false
+	}
+
+	return rev, true
+}
@@ -0,0 +1,80 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Popeye
+
+package lint
+
+import (
+	"context"
+	"testing"
+
+	"github.com/derailed/popeye/internal"
+	"github.com/derailed/popeye/internal/rules"
+	"github.com/derailed/popeye/internal/test"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/version"
+)
+
+func TestPodCheckDeprecatedFields(t *testing.T) {
+	glusterfs := v1.Volume{
+		Name:         "data",
+		VolumeSource: v1.VolumeSource{Glusterfs: &v1.GlusterfsVolumeSource{EndpointsName: "gfs", Path: "vol"}},
+	}
+	cephfs := v1.Volume{
+		Name:         "shared",
+		VolumeSource: v1.VolumeSource{CephFS: &v1.CephFSVolumeSource{Monitors: []string{"m1"}}},
+	}
+	uu := map[string]struct {
+		version *version.Info
+		vv      []v1.Volume
+		issues  []string
+	}{
+		"no-version": {
+			vv: []v1.Volume{glusterfs},
+		},
+		"no-deprecated": {
+			version: &version.Info{Major: "1", Minor: "29"},
+			vv:      []v1.Volume{{Name: "tmp", VolumeSource: v1.VolumeSource{EmptyDir: &v1.EmptyDirVolumeSource{}}}},
+		},
+		"old-cluster": {
+			version: &version.Info{Major: "1", Minor: "24"},
+			vv:      []v1.Volume{glusterfs, cephfs},
+		},
+		"deprecated": {
+			version: &version.Info{Major: "1", Minor: "25"},
+			vv:      []v1.Volume{glusterfs, cephfs},
+			issues: []string{
+				`[POP-240] Volume "data" uses glusterfs which is deprecated as of Kubernetes v1.25. Use a GlusterFS CSI driver instead`,
+			},
+		},
+		"new-cluster": {
+			version: &version.Info{Major: "1", Minor: "29+"},
+			vv:      []v1.Volume{glusterfs, cephfs},
+			issues: []string{
+				`[POP-240] Volume "data" uses glusterfs which is removed as of Kubernetes v1.26. Use a GlusterFS CSI driver instead`,
+				`[POP-240] Volume "shared" uses cephfs which is deprecated as of Kubernetes v1.28. Use the cephfs.csi.ceph.com CSI driver instead`,
+			},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			p := NewPod(test.MakeCollector(t), nil)
+			fqn := "default/p1"
+			p.InitOutcome(fqn)
+			ctx := internal.WithSpec(test.MakeContext("v1/pods", "pods"), SpecFor(fqn, nil))
+			if u.version != nil {
+				ctx = context.WithValue(ctx, internal.KeyVersion, u.version)
+			}
+			p.checkDeprecatedFields(ctx, v1.PodSpec{Volumes: u.vv})
+
+			ii := p.Outcome()[fqn]
+			assert.Equal(t, len(u.issues), len(ii))
+			for i, msg := range u.issues {
+				assert.Equal(t, msg, ii[i].Message)
+				assert.Equal(t, rules.WarnLevel, ii[i].Level)
+			}
+		})
+	}
+}
@@ -0,0 +1,51 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Popeye
+
+package lint
+
+import (
+	"context"
+	"strings"
+
+	"github.com/derailed/popeye/internal"
+	"github.com/derailed/popeye/types"
+	v1 "k8s.io/api/core/v1"
+)
+
+// ImageDigestAnnotation prefixes the pod annotation recording a container
+// resolved image digest, ie popeye.sh/image-digest.nginx: sha256:...
+const ImageDigestAnnotation = "popeye.sh/image-digest."
+
+// checkImageDigests flags containers running an image digest other than the
+// one pinned by their spec or recorded via annotations.
+func (s *Pod) checkImageDigests(ctx context.Context, po *v1.Pod) {
+	ids := make(map[string]string, len(po.Status.ContainerStatuses))
+	for _, cs := range po.Status.ContainerStatuses {
+		ids[cs.Name] = cs.ImageID
+	}
+	for _, co := range po.Spec.Containers {
+		running := imageDigest(ids[co.Name])
+		if running == "" {
+			continue
+		}
+		want, expected := imageDigest(co.Image), co.Image
+		if want == "" {
+			want = po.Annotations[ImageDigestAnnotation+co.Name]
+			expected = want
+		}
+		if want == "" || want == running {
+			continue
+		}
+		s.AddSubCode(internal.WithGroup(ctx, types.NewGVR("containers"), co.Name), 232, co.Name, running, expected)
+	}
+}
+
+// imageDigest extracts the digest from a pinned image reference.
+func imageDigest(ref string) string {
+	i := strings.LastIndex(ref, "@")
+	if i < 0 {
+		return ""
+	}
+
+	return ref[i+1:]
+}
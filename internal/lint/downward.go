@@ -0,0 +1,113 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Popeye
+
+package lint
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/derailed/popeye/internal"
+	"github.com/derailed/popeye/internal/rules"
+	"github.com/derailed/popeye/types"
+	v1 "k8s.io/api/core/v1"
+)
+
+var (
+	// envFieldPaths tracks the pod fields exposable as env vars.
+	envFieldPaths = map[string]struct{}{
+		"metadata.name":           {},
+		"metadata.namespace":      {},
+		"metadata.uid":            {},
+		"spec.nodeName":           {},
+		"spec.serviceAccountName": {},
+		"status.hostIP":           {},
+		"status.hostIPs":          {},
+		"status.podIP":            {},
+		"status.podIPs":           {},
+	}
+
+	// volumeFieldPaths tracks the pod fields exposable as downward API volume files.
+	volumeFieldPaths = map[string]struct{}{
+		"metadata.name":        {},
+		"metadata.namespace":   {},
+		"metadata.uid":         {},
+		"metadata.labels":      {},
+		"metadata.annotations": {},
+	}
+
+	// metaKeyPath matches a single label or annotation selector.
+	metaKeyPath = regexp.MustCompile(`^metadata\.(labels|annotations)\['[^']+'\]$`)
+)
+
+// checkDownwardAPI flags downward API references to unsupported pod fields or
+// to container resources that are not set.
+func (s *Pod) checkDownwardAPI(ctx context.Context, spec v1.PodSpec) {
+	cc := make([]v1.Container, 0, len(spec.InitContainers)+len(spec.Containers))
+	cc = append(cc, spec.InitContainers...)
+	cc = append(cc, spec.Containers...)
+	for _, co := range cc {
+		cctx := internal.WithGroup(ctx, types.NewGVR("containers"), co.Name)
+		for _, e := range co.Env {
+			if e.ValueFrom == nil {
+				continue
+			}
+			if ref := e.ValueFrom.FieldRef; ref != nil && !validFieldPath(envFieldPaths, ref.FieldPath) {
+				s.AddSubCode(cctx, 228, ref.FieldPath)
+			}
+			if ref := e.ValueFrom.ResourceFieldRef; ref != nil {
+				n := ref.ContainerName
+				if n == "" {
+					n = co.Name
+				}
+				checkResourceFieldRef(cctx, s.AddSubCode, cc, n, ref.Resource)
+			}
+		}
+	}
+
+	for _, v := range spec.Volumes {
+		if v.DownwardAPI == nil {
+			continue
+		}
+		for _, i := range v.DownwardAPI.Items {
+			if i.FieldRef != nil && !validFieldPath(volumeFieldPaths, i.FieldRef.FieldPath) {
+				s.AddCode(ctx, 228, i.FieldRef.FieldPath)
+			}
+			if ref := i.ResourceFieldRef; ref != nil {
+				checkResourceFieldRef(ctx, s.AddCode, cc, ref.ContainerName, ref.Resource)
+			}
+		}
+	}
+}
+
+// checkResourceFieldRef flags unsupported resources or resources not set on
+// the referenced container using the given issue recorder.
+func checkResourceFieldRef(ctx context.Context, add func(context.Context, rules.ID, ...interface{}), cc []v1.Container, n, res string) {
+	kind, name, ok := strings.Cut(res, ".")
+	if !ok || (kind != "limits" && kind != "requests") {
+		add(ctx, 228, res)
+		return
+	}
+	for _, co := range cc {
+		if co.Name != n {
+			continue
+		}
+		rl := co.Resources.Limits
+		if kind == "requests" {
+			rl = co.Resources.Requests
+		}
+		if _, ok := rl[v1.ResourceName(name)]; !ok {
+			add(ctx, 229, res, n)
+		}
+		return
+	}
+}
+
+func validFieldPath(paths map[string]struct{}, p string) bool {
+	if _, ok := paths[p]; ok {
+		return true
+	}
+
+	return metaKeyPath.MatchString(p)
+}
@@ -5,16 +5,30 @@ package lint
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/derailed/popeye/internal"
 	"github.com/derailed/popeye/internal/client"
 	"github.com/derailed/popeye/internal/db"
 	"github.com/derailed/popeye/internal/issues"
+	"github.com/derailed/popeye/types"
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
+// ScaleToZeroAnnotation marks a deployment as intentionally scaled to zero.
+const ScaleToZeroAnnotation = "popeye.sh/scale-to-zero"
+
 // Deployment tracks Deployment sanitization.
 type Deployment struct {
 	*issues.Collector
@@ -33,27 +47,101 @@ func NewDeployment(co *issues.Collector, db *db.DB) *Deployment {
 // Lint cleanse the resource.
 func (s *Deployment) Lint(ctx context.Context) error {
 	over := pullOverAllocs(ctx)
-	txn, it := s.db.MustITFor(internal.Glossary[internal.DP])
+	ctx = withAllocatable(ctx, s.db)
+	dd := s.listDeployments()
+	dups := duplicateTemplates(dd)
+	txn, it := s.db.MustITFor(s.db.GVR(internal.DP))
 	defer txn.Abort()
 	for o := it.Next(); o != nil; o = it.Next() {
 		dp := o.(*appsv1.Deployment)
 		fqn := client.FQN(dp.Namespace, dp.Name)
 		s.InitOutcome(fqn)
 		ctx = internal.WithSpec(ctx, SpecFor(fqn, dp))
+		checkHelmOwnership(ctx, s, dp.ObjectMeta)
+		checkDeprecatedAnnotations(ctx, s, dp.ObjectMeta, dp.Spec.Template.ObjectMeta)
+		checkConfigHash(ctx, s, s.db, s.ConfigHashAnnotation(), dp.Namespace, dp.Spec.Template)
+		checkRecommendedLabels(ctx, s, s.RecommendedLabels(), dp.ObjectMeta, dp.Spec.Template.ObjectMeta)
 		s.checkDeployment(ctx, dp)
+		checkGeneration(ctx, s, "Deployment", dp.ObjectMeta, dp.Status.ObservedGeneration)
+		s.checkSelector(ctx, dp, dd)
+		s.checkRollout(ctx, dp.Spec.Strategy, dp.Spec.Replicas)
+		checkRevisionHistory(ctx, s, dp.Spec.RevisionHistoryLimit)
+		checkHostPorts(ctx, s, dp.Spec.Template.Spec, dp.Spec.Replicas)
+		checkImageSkew(ctx, s, dp.Spec.Template.Spec)
+		checkSharedClaims(ctx, s, s.db, dp.Namespace, dp.Spec.Template.Spec, dp.Spec.Replicas)
+		if peers, ok := dups[fqn]; ok {
+			s.AddCode(ctx, 511, strings.Join(peers, ", "))
+		}
 		s.checkContainers(ctx, fqn, dp.Spec.Template.Spec)
 		s.checkUtilization(ctx, over, dp)
+		runChecks(ctx, internal.DP, s, dp)
 	}
 
 	return nil
 }
 
+func (s *Deployment) listDeployments() []*appsv1.Deployment {
+	txn, it := s.db.MustITFor(s.db.GVR(internal.DP))
+	defer txn.Abort()
+	var dd []*appsv1.Deployment
+	for o := it.Next(); o != nil; o = it.Next() {
+		dd = append(dd, o.(*appsv1.Deployment))
+	}
+
+	return dd
+}
+
+// duplicateTemplates returns, for each deployment, the deployments in the same
+// namespace sharing an identical pod spec. Template metadata is ignored as it
+// typically only differs by names and labels.
+func duplicateTemplates(dd []*appsv1.Deployment) map[string][]string {
+	groups := make(map[string][]string)
+	for _, dp := range dd {
+		h, err := templateHash(dp.Spec.Template.Spec)
+		if err != nil {
+			continue
+		}
+		key := dp.Namespace + "|" + h
+		groups[key] = append(groups[key], client.FQN(dp.Namespace, dp.Name))
+	}
+
+	dups := make(map[string][]string)
+	for _, group := range groups {
+		for i, a := range group {
+			for j, b := range group {
+				if i != j {
+					dups[a] = append(dups[a], b)
+				}
+			}
+		}
+	}
+	for _, peers := range dups {
+		sort.Strings(peers)
+	}
+
+	return dups
+}
+
+func templateHash(spec v1.PodSpec) (string, error) {
+	raw, err := json.Marshal(spec)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(raw)
+
+	return hex.EncodeToString(sum[:]), nil
+}
+
 // CheckDeployment checks if deployment contract is currently happy or not.
 func (s *Deployment) checkDeployment(ctx context.Context, dp *appsv1.Deployment) {
-	if dp.Spec.Replicas == nil || (dp.Spec.Replicas != nil && *dp.Spec.Replicas == 0) {
+	if dp.Spec.Replicas == nil {
 		s.AddCode(ctx, 500)
 		return
 	}
+	if *dp.Spec.Replicas == 0 {
+		s.checkZeroScale(ctx, dp)
+		return
+	}
 
 	if dp.Spec.Replicas != nil && *dp.Spec.Replicas != dp.Status.AvailableReplicas {
 		s.AddCode(ctx, 501, *dp.Spec.Replicas, dp.Status.AvailableReplicas)
@@ -64,11 +152,130 @@ func (s *Deployment) checkDeployment(ctx context.Context, dp *appsv1.Deployment)
 	}
 
 	saFQN := client.FQN(dp.Namespace, dp.Spec.Template.Spec.ServiceAccountName)
-	if !s.db.Exists(internal.Glossary[internal.SA], saFQN) {
+	if !s.db.Exists(s.db.GVR(internal.SA), saFQN) {
 		s.AddCode(ctx, 507, dp.Spec.Template.Spec.ServiceAccountName)
 	}
 }
 
+// checkSelector flags selectors not matching the pod template labels or
+// broad enough to capture pods from other deployments in the namespace.
+func (s *Deployment) checkSelector(ctx context.Context, dp *appsv1.Deployment, dd []*appsv1.Deployment) {
+	if dp.Spec.Selector == nil {
+		return
+	}
+	sel, err := metav1.LabelSelectorAsSelector(dp.Spec.Selector)
+	if err != nil {
+		s.AddErr(ctx, err)
+		return
+	}
+	tpl := labels.Set(dp.Spec.Template.Labels)
+	if !sel.Matches(tpl) {
+		s.AddCode(ctx, 517, sel.String(), tpl.String())
+		return
+	}
+
+	var foreign []string
+	for _, o := range dd {
+		if o.Namespace != dp.Namespace || o.Name == dp.Name {
+			continue
+		}
+		if sel.Matches(labels.Set(o.Spec.Template.Labels)) {
+			foreign = append(foreign, client.FQN(o.Namespace, o.Name))
+		}
+	}
+	if len(foreign) > 0 {
+		sort.Strings(foreign)
+		s.AddCode(ctx, 518, sel.String(), strings.Join(foreign, ", "))
+	}
+}
+
+// checkRollout flags rolling updates taking down all replicas or running at
+// reduced capacity. Unset surge and unavailability default to 25%.
+func (s *Deployment) checkRollout(ctx context.Context, st appsv1.DeploymentStrategy, replicas *int32) {
+	if st.Type == appsv1.RecreateDeploymentStrategyType {
+		return
+	}
+	count := 1
+	if replicas != nil {
+		count = int(*replicas)
+	}
+	if count == 0 {
+		return
+	}
+	unavailable, surge := intstr.FromString("25%"), intstr.FromString("25%")
+	if ru := st.RollingUpdate; ru != nil {
+		if ru.MaxUnavailable != nil {
+			unavailable = *ru.MaxUnavailable
+		}
+		if ru.MaxSurge != nil {
+			surge = *ru.MaxSurge
+		}
+	}
+	u, err := intstr.GetScaledValueFromIntOrPercent(&unavailable, count, false)
+	if err != nil {
+		s.AddErr(ctx, err)
+		return
+	}
+	g, err := intstr.GetScaledValueFromIntOrPercent(&surge, count, true)
+	if err != nil {
+		s.AddErr(ctx, err)
+		return
+	}
+	switch {
+	case u >= count:
+		s.AddCode(ctx, 515, unavailable.String(), count)
+	case g == 0 && u > 0:
+		s.AddCode(ctx, 516, unavailable.String())
+	}
+}
+
+// checkZeroScale flags deployments scaled to zero unless annotated as
+// intentional or targeted by an autoscaler. KEDA scaled objects are resolved
+// through the hpa they manage.
+func (s *Deployment) checkZeroScale(ctx context.Context, dp *appsv1.Deployment) {
+	if dp.Annotations[ScaleToZeroAnnotation] == "true" || s.isAutoscaled(dp) {
+		return
+	}
+	var since string
+	if t := lastScaled(dp.Status.Conditions); !t.IsZero() {
+		since = " since " + t.Format(time.RFC3339)
+	}
+	s.AddCode(ctx, 509, since)
+}
+
+// isAutoscaled checks if an hpa targets the given deployment.
+func (s *Deployment) isAutoscaled(dp *appsv1.Deployment) bool {
+	gvr := s.db.GVR(internal.HPA)
+	if gvr == types.BlankGVR {
+		return false
+	}
+	txn, it := s.db.MustITForNS(gvr, dp.Namespace)
+	defer txn.Abort()
+	for o := it.Next(); o != nil; o = it.Next() {
+		hpa, ok := o.(*autoscalingv1.HorizontalPodAutoscaler)
+		if !ok {
+			continue
+		}
+		if ref := hpa.Spec.ScaleTargetRef; ref.Kind == "Deployment" && ref.Name == dp.Name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// lastScaled returns the most recent deployment condition update if any.
+func lastScaled(cc []appsv1.DeploymentCondition) time.Time {
+	var t time.Time
+	for _, c := range cc {
+		if c.LastUpdateTime.Time.After(t) {
+			t = c.LastUpdateTime.Time
+		}
+	}
+
+	return t
+}
+
 // CheckContainers runs thru deployment template and checks pod configuration.
 func (s *Deployment) checkContainers(ctx context.Context, fqn string, spec v1.PodSpec) {
 	c := NewContainer(fqn, s)
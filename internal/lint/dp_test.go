@@ -5,6 +5,7 @@ package lint
 
 import (
 	"testing"
+	"time"
 
 	"github.com/derailed/popeye/internal"
 	"github.com/derailed/popeye/internal/db"
@@ -12,7 +13,10 @@ import (
 	"github.com/derailed/popeye/internal/test"
 	"github.com/stretchr/testify/assert"
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
 	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	mv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
 )
 
@@ -22,37 +26,292 @@ func TestDPLint(t *testing.T) {
 	l := db.NewLoader(dba)
 
 	ctx := test.MakeCtx(t)
-	assert.NoError(t, test.LoadDB[*appsv1.Deployment](ctx, l.DB, "apps/dp/1.yaml", internal.Glossary[internal.DP]))
-	assert.NoError(t, test.LoadDB[*v1.ServiceAccount](ctx, l.DB, "core/sa/1.yaml", internal.Glossary[internal.SA]))
-	assert.NoError(t, test.LoadDB[*v1.Pod](ctx, l.DB, "core/pod/1.yaml", internal.Glossary[internal.PO]))
-	assert.NoError(t, test.LoadDB[*mv1beta1.PodMetrics](ctx, l.DB, "mx/pod/1.yaml", internal.Glossary[internal.PMX]))
+	assert.NoError(t, test.LoadDB[*appsv1.Deployment](ctx, l.DB, "apps/dp/1.yaml", test.Glossary[internal.DP]))
+	assert.NoError(t, test.LoadDB[*v1.ServiceAccount](ctx, l.DB, "core/sa/1.yaml", test.Glossary[internal.SA]))
+	assert.NoError(t, test.LoadDB[*v1.Pod](ctx, l.DB, "core/pod/1.yaml", test.Glossary[internal.PO]))
+	assert.NoError(t, test.LoadDB[*mv1beta1.PodMetrics](ctx, l.DB, "mx/pod/1.yaml", test.Glossary[internal.PMX]))
 
 	dp := NewDeployment(test.MakeCollector(t), dba)
 	assert.Nil(t, dp.Lint(test.MakeContext("apps/v1/deployments", "deployments")))
 	assert.Equal(t, 3, len(dp.Outcome()))
 
 	ii := dp.Outcome()["default/dp1"]
-	assert.Equal(t, 2, len(ii))
-	assert.Equal(t, `[POP-503] At current load, CPU under allocated. Current:20000m vs Requested:1000m (2000.00%)`, ii[0].Message)
-	assert.Equal(t, `[POP-505] At current load, Memory under allocated. Current:20Mi vs Requested:1Mi (2000.00%)`, ii[1].Message)
+	assert.Equal(t, 5, len(ii))
+	assert.Equal(t, `[POP-513] Missing recommended label(s): app.kubernetes.io/name, app.kubernetes.io/instance, app.kubernetes.io/version, app.kubernetes.io/component, app.kubernetes.io/part-of, app.kubernetes.io/managed-by`, ii[0].Message)
+	assert.Equal(t, rules.InfoLevel, ii[0].Level)
+	assert.Equal(t, `[POP-119] Container "ic1" root filesystem is writable. Consider setting readOnlyRootFilesystem`, ii[1].Message)
+	assert.Equal(t, `[POP-119] Container "c1" root filesystem is writable. Consider setting readOnlyRootFilesystem`, ii[2].Message)
+	assert.Equal(t, `[POP-503] At current load, CPU under allocated. Current:20000m vs Requested:1000m (2000.00%)`, ii[3].Message)
+	assert.Equal(t, `[POP-505] At current load, Memory under allocated. Current:20Mi vs Requested:1Mi (2000.00%)`, ii[4].Message)
 
 	ii = dp.Outcome()["default/dp2"]
-	assert.Equal(t, 5, len(ii))
-	assert.Equal(t, `[POP-501] Unhealthy 1 desired but have 0 available`, ii[0].Message)
-	assert.Equal(t, rules.ErrorLevel, ii[0].Level)
-	assert.Equal(t, `[POP-507] Deployment references ServiceAccount "sa-bozo" which does not exist`, ii[1].Message)
+	assert.Equal(t, 7, len(ii))
+	assert.Equal(t, `[POP-513] Missing recommended label(s): app.kubernetes.io/name, app.kubernetes.io/instance, app.kubernetes.io/version, app.kubernetes.io/component, app.kubernetes.io/part-of, app.kubernetes.io/managed-by`, ii[0].Message)
+	assert.Equal(t, rules.InfoLevel, ii[0].Level)
+	assert.Equal(t, `[POP-501] Unhealthy 1 desired but have 0 available`, ii[1].Message)
 	assert.Equal(t, rules.ErrorLevel, ii[1].Level)
-	assert.Equal(t, `[POP-106] No resources requests/limits defined`, ii[2].Message)
-	assert.Equal(t, rules.WarnLevel, ii[2].Level)
-	assert.Equal(t, `[POP-108] Unnamed port 3000`, ii[3].Message)
-	assert.Equal(t, rules.InfoLevel, ii[3].Level)
-	assert.Equal(t, `[POP-508] No pods match controller selector: app=pod-bozo`, ii[4].Message)
-	assert.Equal(t, rules.ErrorLevel, ii[4].Level)
+	assert.Equal(t, `[POP-507] Deployment references ServiceAccount "sa-bozo" which does not exist`, ii[2].Message)
+	assert.Equal(t, rules.ErrorLevel, ii[2].Level)
+	assert.Equal(t, `[POP-106] No resources requests/limits defined`, ii[3].Message)
+	assert.Equal(t, rules.WarnLevel, ii[3].Level)
+	assert.Equal(t, `[POP-108] Unnamed port 3000`, ii[4].Message)
+	assert.Equal(t, rules.InfoLevel, ii[4].Level)
+	assert.Equal(t, `[POP-119] Container "grafana" root filesystem is writable. Consider setting readOnlyRootFilesystem`, ii[5].Message)
+	assert.Equal(t, rules.InfoLevel, ii[5].Level)
+	assert.Equal(t, `[POP-508] No pods match controller selector: app=pod-bozo`, ii[6].Message)
+	assert.Equal(t, rules.ErrorLevel, ii[6].Level)
 
 	ii = dp.Outcome()["default/dp3"]
-	assert.Equal(t, 2, len(ii))
-	assert.Equal(t, `[POP-500] Zero scale detected`, ii[0].Message)
-	assert.Equal(t, rules.WarnLevel, ii[0].Level)
-	assert.Equal(t, `[POP-666] Lint internal error: no pod selector given`, ii[1].Message)
-	assert.Equal(t, rules.ErrorLevel, ii[1].Level)
+	assert.Equal(t, 3, len(ii))
+	assert.Equal(t, `[POP-513] Missing recommended label(s): app.kubernetes.io/name, app.kubernetes.io/instance, app.kubernetes.io/version, app.kubernetes.io/component, app.kubernetes.io/part-of, app.kubernetes.io/managed-by`, ii[0].Message)
+	assert.Equal(t, rules.InfoLevel, ii[0].Level)
+	assert.Equal(t, `[POP-509] Scaled to zero. Forgotten disabled deployment?`, ii[1].Message)
+	assert.Equal(t, rules.InfoLevel, ii[1].Level)
+	assert.Equal(t, `[POP-666] Lint internal error: no pod selector given`, ii[2].Message)
+	assert.Equal(t, rules.ErrorLevel, ii[2].Level)
+}
+
+func TestDPCheckZeroScale(t *testing.T) {
+	scaled := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	uu := map[string]struct {
+		annotations map[string]string
+		conditions  []appsv1.DeploymentCondition
+		hpa         string
+		issues      []string
+	}{
+		"plain": {
+			issues: []string{`[POP-509] Scaled to zero. Forgotten disabled deployment?`},
+		},
+		"last-scaled": {
+			conditions: []appsv1.DeploymentCondition{
+				{Type: appsv1.DeploymentAvailable, LastUpdateTime: metav1.NewTime(scaled.Add(-time.Hour))},
+				{Type: appsv1.DeploymentProgressing, LastUpdateTime: metav1.NewTime(scaled)},
+			},
+			issues: []string{
+				`[POP-509] Scaled to zero since 2024-03-01T10:00:00Z. Forgotten disabled deployment?`,
+			},
+		},
+		"intentional": {
+			annotations: map[string]string{ScaleToZeroAnnotation: "true"},
+		},
+		"autoscaled": {
+			hpa: "autoscaling/hpa/1.yaml",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			dba, err := test.NewTestDB()
+			assert.NoError(t, err)
+			if u.hpa != "" {
+				l := db.NewLoader(dba)
+				assert.NoError(t, test.LoadDB[*autoscalingv1.HorizontalPodAutoscaler](test.MakeCtx(t), l.DB, u.hpa, test.Glossary[internal.HPA]))
+			}
+
+			s := NewDeployment(test.MakeCollector(t), dba)
+			fqn := "default/dp1"
+			s.InitOutcome(fqn)
+			ctx := internal.WithSpec(test.MakeContext("apps/v1/deployments", "deployments"), SpecFor(fqn, nil))
+
+			dp := appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "dp1", Annotations: u.annotations},
+				Status:     appsv1.DeploymentStatus{Conditions: u.conditions},
+			}
+			s.checkZeroScale(ctx, &dp)
+
+			ii := s.Outcome()[fqn]
+			assert.Equal(t, len(u.issues), len(ii))
+			for i, m := range u.issues {
+				assert.Equal(t, m, ii[i].Message)
+			}
+		})
+	}
+}
+
+func TestDPCheckRollout(t *testing.T) {
+	pct := func(s string) *intstr.IntOrString {
+		v := intstr.FromString(s)
+		return &v
+	}
+	num := func(i int) *intstr.IntOrString {
+		v := intstr.FromInt32(int32(i))
+		return &v
+	}
+	uu := map[string]struct {
+		replicas int32
+		strategy appsv1.DeploymentStrategy
+		issue    string
+		level    rules.Level
+	}{
+		"default": {
+			replicas: 3,
+		},
+		"safe": {
+			replicas: 4,
+			strategy: appsv1.DeploymentStrategy{RollingUpdate: &appsv1.RollingUpdateDeployment{
+				MaxUnavailable: num(1),
+				MaxSurge:       num(1),
+			}},
+		},
+		"all-unavailable-percent": {
+			replicas: 3,
+			strategy: appsv1.DeploymentStrategy{RollingUpdate: &appsv1.RollingUpdateDeployment{
+				MaxUnavailable: pct("100%"),
+			}},
+			issue: `[POP-515] Rolling update maxUnavailable 100% takes down all 3 replicas during rollouts`,
+			level: rules.WarnLevel,
+		},
+		"all-unavailable-int": {
+			replicas: 2,
+			strategy: appsv1.DeploymentStrategy{RollingUpdate: &appsv1.RollingUpdateDeployment{
+				MaxUnavailable: num(5),
+			}},
+			issue: `[POP-515] Rolling update maxUnavailable 5 takes down all 2 replicas during rollouts`,
+			level: rules.WarnLevel,
+		},
+		"surge-zero": {
+			replicas: 4,
+			strategy: appsv1.DeploymentStrategy{RollingUpdate: &appsv1.RollingUpdateDeployment{
+				MaxUnavailable: pct("50%"),
+				MaxSurge:       num(0),
+			}},
+			issue: `[POP-516] Rolling update maxSurge is 0 with maxUnavailable 50%. Capacity drops during rollouts`,
+			level: rules.InfoLevel,
+		},
+		"recreate": {
+			replicas: 3,
+			strategy: appsv1.DeploymentStrategy{Type: appsv1.RecreateDeploymentStrategyType},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			s := NewDeployment(test.MakeCollector(t), nil)
+			fqn := "default/dp1"
+			s.InitOutcome(fqn)
+			ctx := internal.WithSpec(test.MakeContext("apps/v1/deployments", "deployments"), SpecFor(fqn, nil))
+			s.checkRollout(ctx, u.strategy, &u.replicas)
+
+			ii := s.Outcome()[fqn]
+			if u.issue == "" {
+				assert.Equal(t, 0, len(ii))
+				return
+			}
+			assert.Equal(t, 1, len(ii))
+			assert.Equal(t, u.issue, ii[0].Message)
+			assert.Equal(t, u.level, ii[0].Level)
+		})
+	}
+}
+
+func Test_dpDuplicateTemplates(t *testing.T) {
+	nginx := []v1.Container{{Name: "c1", Image: "nginx:1.25"}}
+	uu := map[string]struct {
+		dd []*appsv1.Deployment
+		e  map[string][]string
+	}{
+		"duplicate": {
+			dd: []*appsv1.Deployment{
+				makeDPWith("default", "dp1", map[string]string{"app": "dp1"}, nginx),
+				makeDPWith("default", "dp2", map[string]string{"app": "dp2"}, nginx),
+			},
+			e: map[string][]string{
+				"default/dp1": {"default/dp2"},
+				"default/dp2": {"default/dp1"},
+			},
+		},
+		"distinct": {
+			dd: []*appsv1.Deployment{
+				makeDPWith("default", "dp1", map[string]string{"app": "dp1"}, nginx),
+				makeDPWith("default", "dp2", map[string]string{"app": "dp2"}, []v1.Container{{Name: "c1", Image: "nginx:1.26"}}),
+			},
+			e: map[string][]string{},
+		},
+		"distinct-namespaces": {
+			dd: []*appsv1.Deployment{
+				makeDPWith("ns1", "dp1", nil, nginx),
+				makeDPWith("ns2", "dp1", nil, nginx),
+			},
+			e: map[string][]string{},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, duplicateTemplates(u.dd))
+		})
+	}
+}
+
+func makeDPWith(ns, n string, labels map[string]string, cc []v1.Container) *appsv1.Deployment {
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Namespace: ns, Name: n},
+		Spec: appsv1.DeploymentSpec{
+			Template: v1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: labels},
+				Spec:       v1.PodSpec{Containers: cc},
+			},
+		},
+	}
+}
+
+func TestDPCheckSelector(t *testing.T) {
+	mkDP := func(name string, sel, tpl map[string]string) *appsv1.Deployment {
+		return &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name},
+			Spec: appsv1.DeploymentSpec{
+				Selector: &metav1.LabelSelector{MatchLabels: sel},
+				Template: v1.PodTemplateSpec{ObjectMeta: metav1.ObjectMeta{Labels: tpl}},
+			},
+		}
+	}
+	uu := map[string]struct {
+		dp    *appsv1.Deployment
+		peers []*appsv1.Deployment
+		issue string
+		level rules.Level
+	}{
+		"match": {
+			dp: mkDP("dp1", map[string]string{"app": "web"}, map[string]string{"app": "web", "tier": "fe"}),
+			peers: []*appsv1.Deployment{
+				mkDP("dp2", map[string]string{"app": "db"}, map[string]string{"app": "db"}),
+			},
+		},
+		"mismatch": {
+			dp:    mkDP("dp1", map[string]string{"app": "web"}, map[string]string{"app": "api"}),
+			issue: `[POP-517] Selector "app=web" does not match pod template labels "app=api". Updates will be rejected by the API server`,
+			level: rules.ErrorLevel,
+		},
+		"over-broad": {
+			dp: mkDP("dp1", map[string]string{"team": "a"}, map[string]string{"team": "a", "app": "web"}),
+			peers: []*appsv1.Deployment{
+				mkDP("dp2", map[string]string{"app": "api"}, map[string]string{"team": "a", "app": "api"}),
+			},
+			issue: `[POP-518] Selector "team=a" is broader than pod template labels and also captures pods from default/dp2`,
+			level: rules.WarnLevel,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			s := NewDeployment(test.MakeCollector(t), nil)
+			fqn := "default/dp1"
+			s.InitOutcome(fqn)
+			ctx := internal.WithSpec(test.MakeContext("apps/v1/deployments", "deployments"), SpecFor(fqn, nil))
+			s.checkSelector(ctx, u.dp, append([]*appsv1.Deployment{u.dp}, u.peers...))
+
+			ii := s.Outcome()[fqn]
+			if u.issue == "" {
+				assert.Equal(t, 0, len(ii))
+				return
+			}
+			assert.Equal(t, 1, len(ii))
+			assert.Equal(t, u.issue, ii[0].Message)
+			assert.Equal(t, u.level, ii[0].Level)
+		})
+	}
 }
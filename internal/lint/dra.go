@@ -0,0 +1,50 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Popeye
+
+package lint
+
+import (
+	"context"
+
+	"github.com/derailed/popeye/internal"
+	"github.com/derailed/popeye/internal/client"
+	"github.com/derailed/popeye/types"
+	v1 "k8s.io/api/core/v1"
+)
+
+// checkResourceClaims flags dynamic resource allocation claims that are either
+// not declared on the pod or reference missing claims or claim templates.
+func (s *Pod) checkResourceClaims(ctx context.Context, po *v1.Pod) {
+	declared := make(map[string]struct{}, len(po.Spec.ResourceClaims))
+	for _, rc := range po.Spec.ResourceClaims {
+		declared[rc.Name] = struct{}{}
+	}
+	for _, cc := range [][]v1.Container{po.Spec.InitContainers, po.Spec.Containers} {
+		for _, co := range cc {
+			for _, c := range co.Resources.Claims {
+				if _, ok := declared[c.Name]; !ok {
+					s.AddSubCode(internal.WithGroup(ctx, types.NewGVR("containers"), co.Name), 235, co.Name, c.Name)
+				}
+			}
+		}
+	}
+
+	for _, rc := range po.Spec.ResourceClaims {
+		switch src := rc.Source; {
+		case src.ResourceClaimName != nil:
+			s.checkClaimRef(ctx, internal.RCL, "ResourceClaim", po.Namespace, rc.Name, *src.ResourceClaimName)
+		case src.ResourceClaimTemplateName != nil:
+			s.checkClaimRef(ctx, internal.RCT, "ResourceClaimTemplate", po.Namespace, rc.Name, *src.ResourceClaimTemplateName)
+		}
+	}
+}
+
+func (s *Pod) checkClaimRef(ctx context.Context, r internal.R, kind, ns, claim, name string) {
+	gvr := s.db.GVR(r)
+	if gvr == types.BlankGVR {
+		return
+	}
+	if !s.db.Exists(gvr, client.FQN(ns, name)) {
+		s.AddCode(ctx, 236, claim, kind, name)
+	}
+}
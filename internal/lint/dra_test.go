@@ -0,0 +1,97 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Popeye
+
+package lint
+
+import (
+	"testing"
+
+	"github.com/derailed/popeye/internal"
+	"github.com/derailed/popeye/internal/rules"
+	"github.com/derailed/popeye/internal/test"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	rav1alpha2 "k8s.io/api/resource/v1alpha2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestPodCheckResourceClaims(t *testing.T) {
+	claim := func(name, claim, tpl string) v1.PodResourceClaim {
+		rc := v1.PodResourceClaim{Name: name}
+		if claim != "" {
+			rc.Source.ResourceClaimName = &claim
+		}
+		if tpl != "" {
+			rc.Source.ResourceClaimTemplateName = &tpl
+		}
+		return rc
+	}
+	uses := func(name string, cc ...string) v1.Container {
+		co := v1.Container{Name: name}
+		for _, c := range cc {
+			co.Resources.Claims = append(co.Resources.Claims, v1.ResourceClaim{Name: c})
+		}
+		return co
+	}
+	uu := map[string]struct {
+		claims []v1.PodResourceClaim
+		cos    []v1.Container
+		issues []string
+	}{
+		"none": {
+			cos: []v1.Container{uses("c1")},
+		},
+		"valid": {
+			claims: []v1.PodResourceClaim{
+				claim("gpu", "gpu-claim", ""),
+				claim("tpl", "", "gpu-template"),
+			},
+			cos: []v1.Container{uses("c1", "gpu", "tpl")},
+		},
+		"undeclared": {
+			cos: []v1.Container{uses("c1", "gpu")},
+			issues: []string{
+				`[POP-235] Container "c1" references resource claim "gpu" which is not declared in the pod resourceClaims`,
+			},
+		},
+		"dangling": {
+			claims: []v1.PodResourceClaim{
+				claim("gpu", "bozo-claim", ""),
+				claim("tpl", "", "bozo-template"),
+			},
+			cos: []v1.Container{uses("c1", "gpu", "tpl")},
+			issues: []string{
+				`[POP-236] Resource claim "gpu" references a missing ResourceClaim "bozo-claim"`,
+				`[POP-236] Resource claim "tpl" references a missing ResourceClaimTemplate "bozo-template"`,
+			},
+		},
+	}
+
+	dba, err := test.NewTestDB()
+	assert.NoError(t, err)
+	ctx := test.MakeCtx(t)
+	assert.NoError(t, test.LoadDB[*rav1alpha2.ResourceClaim](ctx, dba, "resource/rcl/1.yaml", test.Glossary[internal.RCL]))
+	assert.NoError(t, test.LoadDB[*rav1alpha2.ResourceClaimTemplate](ctx, dba, "resource/rct/1.yaml", test.Glossary[internal.RCT]))
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			p := NewPod(test.MakeCollector(t), dba)
+			fqn := "default/p1"
+			p.InitOutcome(fqn)
+			ctx := internal.WithSpec(test.MakeContext("v1/pods", "pods"), SpecFor(fqn, nil))
+			po := v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "p1"},
+				Spec:       v1.PodSpec{ResourceClaims: u.claims, Containers: u.cos},
+			}
+			p.checkResourceClaims(ctx, &po)
+
+			ii := p.Outcome()[fqn]
+			assert.Equal(t, len(u.issues), len(ii))
+			for i, m := range u.issues {
+				assert.Equal(t, m, ii[i].Message)
+				assert.Equal(t, rules.ErrorLevel, ii[i].Level)
+			}
+		})
+	}
+}
@@ -32,7 +32,8 @@ func NewDaemonSet(co *issues.Collector, db *db.DB) *DaemonSet {
 // Lint cleanse the resource.
 func (s *DaemonSet) Lint(ctx context.Context) error {
 	over := pullOverAllocs(ctx)
-	txn, it := s.db.MustITFor(internal.Glossary[internal.DS])
+	ctx = withAllocatable(ctx, s.db)
+	txn, it := s.db.MustITFor(s.db.GVR(internal.DS))
 	defer txn.Abort()
 	for o := it.Next(); o != nil; o = it.Next() {
 		ds := o.(*appsv1.DaemonSet)
@@ -40,9 +41,14 @@ func (s *DaemonSet) Lint(ctx context.Context) error {
 		s.InitOutcome(fqn)
 		ctx = internal.WithSpec(ctx, SpecFor(fqn, ds))
 
+		checkHelmOwnership(ctx, s, ds.ObjectMeta)
+		checkConfigHash(ctx, s, s.db, s.ConfigHashAnnotation(), ds.Namespace, ds.Spec.Template)
+		checkRecommendedLabels(ctx, s, s.RecommendedLabels(), ds.ObjectMeta, ds.Spec.Template.ObjectMeta)
 		s.checkDaemonSet(ctx, ds)
+		checkGeneration(ctx, s, "DaemonSet", ds.ObjectMeta, ds.Status.ObservedGeneration)
 		s.checkContainers(ctx, fqn, ds.Spec.Template.Spec)
 		s.checkUtilization(ctx, over, ds)
+		runChecks(ctx, internal.DS, s, ds)
 	}
 
 	return nil
@@ -52,7 +58,7 @@ func (s *DaemonSet) checkDaemonSet(ctx context.Context, ds *appsv1.DaemonSet) {
 	if ds.Spec.Template.Spec.ServiceAccountName == "" {
 		return
 	}
-	_, err := s.db.Find(internal.Glossary[internal.SA], client.FQN(ds.Namespace, ds.Spec.Template.Spec.ServiceAccountName))
+	_, err := s.db.Find(s.db.GVR(internal.SA), client.FQN(ds.Namespace, ds.Spec.Template.Spec.ServiceAccountName))
 	if err != nil {
 		s.AddCode(ctx, 507, ds.Spec.Template.Spec.ServiceAccountName)
 	}
@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Popeye
+
+package lint
+
+import (
+	"context"
+
+	"github.com/derailed/popeye/internal"
+	"github.com/derailed/popeye/internal/client"
+	"github.com/derailed/popeye/internal/db"
+	"github.com/derailed/popeye/internal/issues"
+	v1 "k8s.io/api/core/v1"
+)
+
+// leaderAnnotation tracks legacy endpoints based leader election locks.
+const leaderAnnotation = "control-plane.alpha.kubernetes.io/leader"
+
+type (
+	// Endpoints tracks Endpoints sanitization.
+	Endpoints struct {
+		*issues.Collector
+
+		db *db.DB
+	}
+)
+
+// NewEndpoints returns a new instance.
+func NewEndpoints(co *issues.Collector, db *db.DB) *Endpoints {
+	return &Endpoints{
+		Collector: co,
+		db:        db,
+	}
+}
+
+// Lint cleanse the resource.
+func (s *Endpoints) Lint(ctx context.Context) error {
+	txn, it := s.db.MustITFor(s.db.GVR(internal.EP))
+	defer txn.Abort()
+	for o := it.Next(); o != nil; o = it.Next() {
+		ep := o.(*v1.Endpoints)
+		fqn := client.FQN(ep.Namespace, ep.Name)
+		s.InitOutcome(fqn)
+		ctx = internal.WithSpec(ctx, SpecFor(fqn, ep))
+
+		if _, ok := ep.Annotations[leaderAnnotation]; !ok {
+			checkOwningService(ctx, s, s.db, ep.Namespace, ep.Name)
+		}
+		runChecks(ctx, internal.EP, s, ep)
+	}
+
+	return nil
+}
+
+// checkOwningService flags endpoints whose service is gone.
+func checkOwningService(ctx context.Context, c Collector, dba *db.DB, ns, svc string) {
+	fqn := client.FQN(ns, svc)
+	if !dba.Exists(dba.GVR(internal.SVC), fqn) {
+		c.AddCode(ctx, 1117, fqn)
+	}
+}
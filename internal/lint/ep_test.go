@@ -0,0 +1,37 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Popeye
+
+package lint
+
+import (
+	"testing"
+
+	"github.com/derailed/popeye/internal"
+	"github.com/derailed/popeye/internal/db"
+	"github.com/derailed/popeye/internal/rules"
+	"github.com/derailed/popeye/internal/test"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+)
+
+func TestEndpointsLint(t *testing.T) {
+	dba, err := test.NewTestDB()
+	assert.NoError(t, err)
+	l := db.NewLoader(dba)
+
+	ctx := test.MakeCtx(t)
+	assert.NoError(t, test.LoadDB[*v1.Endpoints](ctx, l.DB, "core/ep/1.yaml", test.Glossary[internal.EP]))
+	assert.NoError(t, test.LoadDB[*v1.Service](ctx, l.DB, "core/svc/1.yaml", test.Glossary[internal.SVC]))
+
+	ep := NewEndpoints(test.MakeCollector(t), dba)
+	assert.Nil(t, ep.Lint(test.MakeContext("v1/endpoints", "endpoints")))
+	assert.Equal(t, 4, len(ep.Outcome()))
+
+	ii := ep.Outcome()["default/svc1"]
+	assert.Equal(t, 0, len(ii))
+
+	ii = ep.Outcome()["default/svc-none"]
+	assert.Equal(t, 1, len(ii))
+	assert.Equal(t, `[POP-1117] Owning service "default/svc-none" no longer exists. Likely a leftover after service deletion`, ii[0].Message)
+	assert.Equal(t, rules.InfoLevel, ii[0].Level)
+}
@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Popeye
+
+package lint
+
+import (
+	"context"
+
+	"github.com/derailed/popeye/internal"
+	"github.com/derailed/popeye/internal/client"
+	"github.com/derailed/popeye/internal/db"
+	"github.com/derailed/popeye/internal/issues"
+	dv1 "k8s.io/api/discovery/v1"
+)
+
+type (
+	// EndpointSlice tracks EndpointSlice sanitization.
+	EndpointSlice struct {
+		*issues.Collector
+
+		db *db.DB
+	}
+)
+
+// NewEndpointSlice returns a new instance.
+func NewEndpointSlice(co *issues.Collector, db *db.DB) *EndpointSlice {
+	return &EndpointSlice{
+		Collector: co,
+		db:        db,
+	}
+}
+
+// Lint cleanse the resource.
+func (s *EndpointSlice) Lint(ctx context.Context) error {
+	txn, it := s.db.MustITFor(s.db.GVR(internal.EPS))
+	defer txn.Abort()
+	for o := it.Next(); o != nil; o = it.Next() {
+		eps := o.(*dv1.EndpointSlice)
+		fqn := client.FQN(eps.Namespace, eps.Name)
+		s.InitOutcome(fqn)
+		ctx = internal.WithSpec(ctx, SpecFor(fqn, eps))
+
+		if svc, ok := sliceService(eps); ok {
+			checkOwningService(ctx, s, s.db, eps.Namespace, svc)
+		}
+		runChecks(ctx, internal.EPS, s, eps)
+	}
+
+	return nil
+}
+
+// sliceService returns the name of the service owning the slice if any.
+func sliceService(eps *dv1.EndpointSlice) (string, bool) {
+	if n, ok := eps.Labels[dv1.LabelServiceName]; ok && n != "" {
+		return n, true
+	}
+	for _, r := range eps.OwnerReferences {
+		if r.Kind == "Service" {
+			return r.Name, true
+		}
+	}
+
+	return "", false
+}
@@ -0,0 +1,41 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Popeye
+
+package lint
+
+import (
+	"testing"
+
+	"github.com/derailed/popeye/internal"
+	"github.com/derailed/popeye/internal/db"
+	"github.com/derailed/popeye/internal/rules"
+	"github.com/derailed/popeye/internal/test"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	dv1 "k8s.io/api/discovery/v1"
+)
+
+func TestEndpointSliceLint(t *testing.T) {
+	dba, err := test.NewTestDB()
+	assert.NoError(t, err)
+	l := db.NewLoader(dba)
+
+	ctx := test.MakeCtx(t)
+	assert.NoError(t, test.LoadDB[*dv1.EndpointSlice](ctx, l.DB, "net/eps/1.yaml", test.Glossary[internal.EPS]))
+	assert.NoError(t, test.LoadDB[*v1.Service](ctx, l.DB, "core/svc/1.yaml", test.Glossary[internal.SVC]))
+
+	eps := NewEndpointSlice(test.MakeCollector(t), dba)
+	assert.Nil(t, eps.Lint(test.MakeContext("discovery.k8s.io/v1/endpointslices", "endpointslices")))
+	assert.Equal(t, 3, len(eps.Outcome()))
+
+	ii := eps.Outcome()["default/svc1-abcde"]
+	assert.Equal(t, 0, len(ii))
+
+	ii = eps.Outcome()["default/svc-gone-fghij"]
+	assert.Equal(t, 1, len(ii))
+	assert.Equal(t, `[POP-1117] Owning service "default/svc-gone" no longer exists. Likely a leftover after service deletion`, ii[0].Message)
+	assert.Equal(t, rules.InfoLevel, ii[0].Level)
+
+	ii = eps.Outcome()["default/manual"]
+	assert.Equal(t, 0, len(ii))
+}
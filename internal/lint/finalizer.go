@@ -0,0 +1,37 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Popeye
+
+package lint
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/duration"
+)
+
+// finalizerGracer tracks how long resources may remain terminating.
+type finalizerGracer interface {
+	FinalizerGrace() time.Duration
+}
+
+// checkFinalizers flags resources stuck terminating on pending finalizers.
+func checkFinalizers(ctx context.Context, c Collector, o any) {
+	m, ok := o.(metav1.Object)
+	if !ok {
+		return
+	}
+	ts, ff := m.GetDeletionTimestamp(), m.GetFinalizers()
+	if ts == nil || len(ff) == 0 {
+		return
+	}
+	g, ok := c.(finalizerGracer)
+	if !ok {
+		return
+	}
+	if age := time.Since(ts.Time); age > g.FinalizerGrace() {
+		c.AddCode(ctx, 414, duration.HumanDuration(age), strings.Join(ff, ", "))
+	}
+}
@@ -0,0 +1,94 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Popeye
+
+package lint
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/popeye/internal"
+	"github.com/derailed/popeye/internal/db"
+	"github.com/derailed/popeye/internal/rules"
+	"github.com/derailed/popeye/internal/test"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestCheckFinalizers(t *testing.T) {
+	uu := map[string]struct {
+		deleted    time.Duration
+		finalizers []string
+		issue      string
+	}{
+		"normal": {
+			finalizers: []string{"kubernetes.io/pvc-protection"},
+		},
+		"no-finalizers": {
+			deleted: 2 * time.Hour,
+		},
+		"terminating": {
+			deleted:    5 * time.Minute,
+			finalizers: []string{"kubernetes.io/pvc-protection"},
+		},
+		"stuck": {
+			deleted:    3 * time.Hour,
+			finalizers: []string{"fred.io/cleanup", "kubernetes"},
+			issue:      "[POP-414] Stuck terminating for 3h. Blocked by finalizer(s): fred.io/cleanup, kubernetes",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			cm := v1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "cm1",
+					Namespace:  "default",
+					Finalizers: u.finalizers,
+				},
+			}
+			if u.deleted != 0 {
+				ts := metav1.NewTime(time.Now().Add(-u.deleted))
+				cm.DeletionTimestamp = &ts
+			}
+			co := test.MakeCollector(t)
+			co.InitOutcome("default/cm1")
+			ctx := test.MakeContext("v1/configmaps", "configmaps")
+			ctx = internal.WithSpec(ctx, SpecFor("default/cm1", nil))
+			runChecks(ctx, internal.CM, co, &cm)
+
+			ii := co.Outcome()["default/cm1"]
+			if u.issue == "" {
+				assert.Equal(t, 0, len(ii))
+				return
+			}
+			assert.Equal(t, 1, len(ii))
+			assert.Equal(t, u.issue, ii[0].Message)
+			assert.Equal(t, rules.WarnLevel, ii[0].Level)
+		})
+	}
+}
+
+func TestCheckFinalizersUnusedConfigMap(t *testing.T) {
+	dba, err := test.NewTestDB()
+	assert.NoError(t, err)
+	l := db.NewLoader(dba)
+
+	ctx := test.MakeCtx(t)
+	assert.NoError(t, test.LoadDB[*v1.ConfigMap](ctx, l.DB, "core/cm/3.yaml", test.Glossary[internal.CM]))
+	assert.NoError(t, test.LoadDB[*v1.Pod](ctx, l.DB, "core/pod/1.yaml", test.Glossary[internal.PO]))
+
+	cm := NewConfigMap(test.MakeCollector(t), dba)
+	assert.Nil(t, cm.Lint(test.MakeContext("v1/configmaps", "configmaps")))
+
+	ii := cm.Outcome()["default/cm-stale"]
+	assert.Equal(t, 2, len(ii))
+	codes := make([]string, 0, len(ii))
+	for _, i := range ii {
+		c, _ := i.Code()
+		codes = append(codes, c)
+	}
+	assert.ElementsMatch(t, []string{"400", "414"}, codes)
+}
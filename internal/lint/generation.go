@@ -0,0 +1,36 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Popeye
+
+package lint
+
+import (
+	"context"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/duration"
+)
+
+// generationLagGrace tracks how long a controller may take to observe a spec change.
+const generationLagGrace = 5 * time.Minute
+
+// checkGeneration flags controllers whose status has not caught up with their
+// spec past a grace period. The latest managed fields update dates the last
+// spec change, falling back to the creation time.
+func checkGeneration(ctx context.Context, c Collector, kind string, m metav1.ObjectMeta, observed int64) {
+	if m.Generation <= observed {
+		return
+	}
+	since := m.CreationTimestamp.Time
+	for _, f := range m.ManagedFields {
+		if f.Subresource == "" && f.Time != nil && f.Time.After(since) {
+			since = f.Time.Time
+		}
+	}
+	if since.IsZero() {
+		return
+	}
+	if lag := time.Since(since); lag > generationLagGrace {
+		c.AddCode(ctx, 522, kind, m.Generation-observed, observed, m.Generation, duration.HumanDuration(lag))
+	}
+}
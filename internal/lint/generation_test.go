@@ -0,0 +1,96 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Popeye
+
+package lint
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/popeye/internal"
+	"github.com/derailed/popeye/internal/rules"
+	"github.com/derailed/popeye/internal/test"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestCheckGeneration(t *testing.T) {
+	old, recent := metav1.NewTime(time.Now().Add(-2*time.Hour)), metav1.NewTime(time.Now().Add(-time.Minute))
+	uu := map[string]struct {
+		gvr, kind            string
+		generation, observed int64
+		created              metav1.Time
+		fields               []metav1.ManagedFieldsEntry
+		issue                string
+	}{
+		"dp-reconciled": {
+			gvr:        "apps/v1/deployments",
+			kind:       "Deployment",
+			generation: 3,
+			observed:   3,
+			created:    old,
+		},
+		"dp-lagging": {
+			gvr:        "apps/v1/deployments",
+			kind:       "Deployment",
+			generation: 5,
+			observed:   3,
+			created:    old,
+			issue:      `[POP-522] Deployment status lags spec by 2 generation(s) (observed 3 of 5) for 120m. Controller may not be reconciling`,
+		},
+		"dp-recent-update": {
+			gvr:        "apps/v1/deployments",
+			kind:       "Deployment",
+			generation: 5,
+			observed:   4,
+			created:    old,
+			fields:     []metav1.ManagedFieldsEntry{{Manager: "kubectl", Operation: metav1.ManagedFieldsOperationUpdate, Time: &recent}},
+		},
+		"ds-reconciled": {
+			gvr:        "apps/v1/daemonsets",
+			kind:       "DaemonSet",
+			generation: 1,
+			observed:   1,
+			created:    old,
+		},
+		"ds-lagging": {
+			gvr:        "apps/v1/daemonsets",
+			kind:       "DaemonSet",
+			generation: 2,
+			observed:   1,
+			created:    old,
+			fields: []metav1.ManagedFieldsEntry{
+				{Manager: "kubectl", Operation: metav1.ManagedFieldsOperationUpdate, Time: &old},
+				{Manager: "kube-controller-manager", Operation: metav1.ManagedFieldsOperationUpdate, Time: &recent, Subresource: "status"},
+			},
+			issue: `[POP-522] DaemonSet status lags spec by 1 generation(s) (observed 1 of 2) for 120m. Controller may not be reconciling`,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			c := test.MakeCollector(t)
+			fqn := "default/w1"
+			c.InitOutcome(fqn)
+			ctx := internal.WithSpec(test.MakeContext(u.gvr, "workloads"), SpecFor(fqn, nil))
+			m := metav1.ObjectMeta{
+				Namespace:         "default",
+				Name:              "w1",
+				Generation:        u.generation,
+				CreationTimestamp: u.created,
+				ManagedFields:     u.fields,
+			}
+			checkGeneration(ctx, c, u.kind, m, u.observed)
+
+			ii := c.Outcome()[fqn]
+			if u.issue == "" {
+				assert.Equal(t, 0, len(ii))
+				return
+			}
+			assert.Equal(t, 1, len(ii))
+			assert.Equal(t, u.issue, ii[0].Message)
+			assert.Equal(t, rules.WarnLevel, ii[0].Level)
+		})
+	}
+}
@@ -0,0 +1,71 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Popeye
+
+package lint
+
+import (
+	"context"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/popeye/internal"
+	"github.com/derailed/popeye/types"
+	v1 "k8s.io/api/core/v1"
+)
+
+// sleepRX matches sleep commands commonly used by exec preStop hooks.
+var sleepRX = regexp.MustCompile(`\bsleep\s+(\d+)`)
+
+// checkGracePeriod flags containers needing more time to run their preStop
+// hook and fail readiness probes than the termination grace period allows.
+func (s *Pod) checkGracePeriod(ctx context.Context, spec v1.PodSpec) {
+	grace := int64(v1.DefaultTerminationGracePeriodSeconds)
+	if spec.TerminationGracePeriodSeconds != nil {
+		grace = *spec.TerminationGracePeriodSeconds
+	}
+	for _, co := range spec.Containers {
+		need := preStopSeconds(co.Lifecycle) + readinessDrainSeconds(co.ReadinessProbe)
+		if need <= grace {
+			continue
+		}
+		s.AddSubCode(internal.WithGroup(ctx, types.NewGVR("containers"), co.Name), 131, need, grace, need-grace)
+	}
+}
+
+// preStopSeconds returns how long a preStop hook is expected to run.
+func preStopSeconds(l *v1.Lifecycle) int64 {
+	if l == nil || l.PreStop == nil {
+		return 0
+	}
+	switch h := l.PreStop; {
+	case h.Sleep != nil:
+		return h.Sleep.Seconds
+	case h.Exec != nil:
+		mm := sleepRX.FindStringSubmatch(strings.Join(h.Exec.Command, " "))
+		if len(mm) < 2 {
+			return 0
+		}
+		n, _ := strconv.ParseInt(mm[1], 10, 64)
+		return n
+	default:
+		return 0
+	}
+}
+
+// readinessDrainSeconds returns how long a readiness probe takes to report
+// a terminating container as not ready.
+func readinessDrainSeconds(p *v1.Probe) int64 {
+	if p == nil {
+		return 0
+	}
+	period, failures := int64(defaultProbePeriod), int64(defaultProbeFailureThreshold)
+	if p.PeriodSeconds > 0 {
+		period = int64(p.PeriodSeconds)
+	}
+	if p.FailureThreshold > 0 {
+		failures = int64(p.FailureThreshold)
+	}
+
+	return period * failures
+}
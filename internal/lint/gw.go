@@ -11,7 +11,6 @@ import (
 	"github.com/derailed/popeye/internal/client"
 	"github.com/derailed/popeye/internal/db"
 	"github.com/derailed/popeye/internal/issues"
-	"github.com/rs/zerolog/log"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 )
 
@@ -34,7 +33,7 @@ func NewGateway(co *issues.Collector, db *db.DB) *Gateway {
 
 // Lint cleanse the resource.
 func (s *Gateway) Lint(ctx context.Context) error {
-	txn, it := s.db.MustITFor(internal.Glossary[internal.GW])
+	txn, it := s.db.MustITFor(s.db.GVR(internal.GW))
 	defer txn.Abort()
 	for o := it.Next(); o != nil; o = it.Next() {
 		gw := o.(*gwv1.Gateway)
@@ -42,15 +41,16 @@ func (s *Gateway) Lint(ctx context.Context) error {
 		s.InitOutcome(fqn)
 		ctx = internal.WithSpec(ctx, SpecFor(fqn, gw))
 		s.checkRefs(ctx, gw)
+		runChecks(ctx, internal.GW, s, gw)
 	}
 
 	return nil
 }
 
 func (s *Gateway) checkRefs(ctx context.Context, gw *gwv1.Gateway) {
-	txn, it, err := s.db.ITFor(internal.Glossary[internal.GWC])
+	txn, it, err := s.db.ITFor(s.db.GVR(internal.GWC))
 	if err != nil {
-		log.Warn().Err(err).Msg("no gateway class located. Skipping gw ref check")
+		internal.ExtractLogger(ctx).Log(internal.WarnLog, "no gateway class located. Skipping gw ref check", "error", err)
 		return
 	}
 	defer txn.Abort()
@@ -20,8 +20,8 @@ func TestGatewayLint(t *testing.T) {
 	l := db.NewLoader(dba)
 
 	ctx := test.MakeCtx(t)
-	assert.NoError(t, test.LoadDB[*gwv1.GatewayClass](ctx, l.DB, "net/gwc/1.yaml", internal.Glossary[internal.GWC]))
-	assert.NoError(t, test.LoadDB[*gwv1.Gateway](ctx, l.DB, "net/gw/1.yaml", internal.Glossary[internal.GW]))
+	assert.NoError(t, test.LoadDB[*gwv1.GatewayClass](ctx, l.DB, "net/gwc/1.yaml", test.Glossary[internal.GWC]))
+	assert.NoError(t, test.LoadDB[*gwv1.Gateway](ctx, l.DB, "net/gw/1.yaml", test.Glossary[internal.GW]))
 
 	gw := NewGateway(test.MakeCollector(t), dba)
 	assert.Nil(t, gw.Lint(test.MakeContext("gateway.networking.k8s.io/v1/gateways", "gateways")))
@@ -33,7 +33,7 @@ func NewGatewayClass(co *issues.Collector, db *db.DB) *GatewayClass {
 
 // Lint cleanse the resource.
 func (s *GatewayClass) Lint(ctx context.Context) error {
-	txn, it := s.db.MustITFor(internal.Glossary[internal.GWC])
+	txn, it := s.db.MustITFor(s.db.GVR(internal.GWC))
 	defer txn.Abort()
 	for o := it.Next(); o != nil; o = it.Next() {
 		gwc := o.(*gwv1.GatewayClass)
@@ -41,6 +41,7 @@ func (s *GatewayClass) Lint(ctx context.Context) error {
 		s.InitOutcome(fqn)
 		ctx = internal.WithSpec(ctx, SpecFor(fqn, gwc))
 		s.checkRefs(ctx, gwc.Name)
+		runChecks(ctx, internal.GWC, s, gwc)
 	}
 
 	return nil
@@ -49,7 +50,7 @@ func (s *GatewayClass) Lint(ctx context.Context) error {
 func (s *GatewayClass) checkRefs(ctx context.Context, n string) {
 	txn := s.db.Txn(false)
 	defer txn.Abort()
-	txn, it := s.db.MustITFor(internal.Glossary[internal.GW])
+	txn, it := s.db.MustITFor(s.db.GVR(internal.GW))
 	defer txn.Abort()
 
 	for o := it.Next(); o != nil; o = it.Next() {
@@ -35,7 +35,7 @@ func NewHTTPRoute(co *issues.Collector, db *db.DB) *HTTPRoute {
 
 // Lint cleanse the resource.
 func (s *HTTPRoute) Lint(ctx context.Context) error {
-	txn, it := s.db.MustITFor(internal.Glossary[internal.GWR])
+	txn, it := s.db.MustITFor(s.db.GVR(internal.GWR))
 	defer txn.Abort()
 	for o := it.Next(); o != nil; o = it.Next() {
 		gwr := o.(*gwv1.HTTPRoute)
@@ -43,6 +43,7 @@ func (s *HTTPRoute) Lint(ctx context.Context) error {
 		s.InitOutcome(fqn)
 		ctx = internal.WithSpec(ctx, SpecFor(fqn, gwr))
 		s.checkRoute(ctx, fqn, gwr)
+		runChecks(ctx, internal.GWR, s, gwr)
 	}
 
 	return nil
@@ -91,7 +92,7 @@ func (s *HTTPRoute) checkSvcBE(ctx context.Context, ns string, be *gwv1.BackendR
 			ns = string(*be.Namespace)
 		}
 		fqn := client.FQN(ns, string(be.Name))
-		o, err := s.db.Find(internal.Glossary[internal.SVC], fqn)
+		o, err := s.db.Find(s.db.GVR(internal.SVC), fqn)
 		if err != nil {
 			s.AddCode(ctx, 407, "Route", "Service", fqn)
 			return
@@ -118,7 +119,7 @@ func (s *HTTPRoute) checkGWRef(ctx context.Context, ns string, ref *gwv1.ParentR
 		ns = string(*ref.Namespace)
 	}
 	fqn := client.FQN(ns, string(ref.Name))
-	_, err := s.db.Find(internal.Glossary[internal.GW], fqn)
+	_, err := s.db.Find(s.db.GVR(internal.GW), fqn)
 	if err != nil {
 		s.AddCode(ctx, 407, "HTTPRoute", "Gateway", fqn)
 	}
@@ -129,7 +130,7 @@ func (s *HTTPRoute) checkSvcRef(ctx context.Context, ns string, ref *gwv1.Parent
 		ns = string(*ref.Namespace)
 	}
 	fqn := client.FQN(ns, string(ref.Name))
-	_, err := s.db.Find(internal.Glossary[internal.SVC], fqn)
+	_, err := s.db.Find(s.db.GVR(internal.SVC), fqn)
 	if err != nil {
 		s.AddCode(ctx, 407, "HTTPRoute", "Service", fqn)
 	}
@@ -21,10 +21,10 @@ func TestHttpRouteTestLint(t *testing.T) {
 	l := db.NewLoader(dba)
 
 	ctx := test.MakeCtx(t)
-	assert.NoError(t, test.LoadDB[*gwv1.HTTPRoute](ctx, l.DB, "net/gwr/1.yaml", internal.Glossary[internal.GWR]))
-	assert.NoError(t, test.LoadDB[*gwv1.GatewayClass](ctx, l.DB, "net/gwc/1.yaml", internal.Glossary[internal.GWC]))
-	assert.NoError(t, test.LoadDB[*gwv1.Gateway](ctx, l.DB, "net/gw/1.yaml", internal.Glossary[internal.GW]))
-	assert.NoError(t, test.LoadDB[*v1.Service](ctx, l.DB, "core/svc/1.yaml", internal.Glossary[internal.SVC]))
+	assert.NoError(t, test.LoadDB[*gwv1.HTTPRoute](ctx, l.DB, "net/gwr/1.yaml", test.Glossary[internal.GWR]))
+	assert.NoError(t, test.LoadDB[*gwv1.GatewayClass](ctx, l.DB, "net/gwc/1.yaml", test.Glossary[internal.GWC]))
+	assert.NoError(t, test.LoadDB[*gwv1.Gateway](ctx, l.DB, "net/gw/1.yaml", test.Glossary[internal.GW]))
+	assert.NoError(t, test.LoadDB[*v1.Service](ctx, l.DB, "core/svc/1.yaml", test.Glossary[internal.SVC]))
 
 	hr := NewHTTPRoute(test.MakeCollector(t), dba)
 	assert.Nil(t, hr.Lint(test.MakeContext("gateway.networking.k8s.io/v1/httproutes", "httproutes")))
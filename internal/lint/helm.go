@@ -0,0 +1,35 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Popeye
+
+package lint
+
+import (
+	"context"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	managedByLabel       = "app.kubernetes.io/managed-by"
+	helmManager          = "Helm"
+	helmReleaseName      = "meta.helm.sh/release-name"
+	helmReleaseNamespace = "meta.helm.sh/release-namespace"
+)
+
+// checkHelmOwnership flags Helm managed resources with partial release ownership.
+func checkHelmOwnership(ctx context.Context, c Collector, m metav1.ObjectMeta) {
+	if m.Labels[managedByLabel] != helmManager {
+		return
+	}
+
+	var missing []string
+	for _, a := range []string{helmReleaseName, helmReleaseNamespace} {
+		if _, ok := m.Annotations[a]; !ok {
+			missing = append(missing, a)
+		}
+	}
+	if len(missing) > 0 {
+		c.AddCode(ctx, 408, strings.Join(missing, ", "))
+	}
+}
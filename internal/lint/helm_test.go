@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Popeye
+
+package lint
+
+import (
+	"testing"
+
+	"github.com/derailed/popeye/internal"
+	"github.com/derailed/popeye/internal/rules"
+	"github.com/derailed/popeye/internal/test"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestCheckHelmOwnership(t *testing.T) {
+	uu := map[string]struct {
+		labels, annotations map[string]string
+		issue               string
+	}{
+		"not-helm": {
+			labels: map[string]string{"app": "fred"},
+		},
+		"happy": {
+			labels: map[string]string{managedByLabel: "Helm"},
+			annotations: map[string]string{
+				helmReleaseName:      "fred",
+				helmReleaseNamespace: "default",
+			},
+		},
+		"missing-ns": {
+			labels:      map[string]string{managedByLabel: "Helm"},
+			annotations: map[string]string{helmReleaseName: "fred"},
+			issue:       "[POP-408] Helm managed resource is missing release ownership annotation(s): meta.helm.sh/release-namespace",
+		},
+		"missing-all": {
+			labels: map[string]string{managedByLabel: "Helm"},
+			issue:  "[POP-408] Helm managed resource is missing release ownership annotation(s): meta.helm.sh/release-name, meta.helm.sh/release-namespace",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			co := test.MakeCollector(t)
+			co.InitOutcome("default/cm1")
+			ctx := test.MakeContext("v1/configmaps", "configmaps")
+			ctx = internal.WithSpec(ctx, SpecFor("default/cm1", nil))
+			checkHelmOwnership(ctx, co, metav1.ObjectMeta{
+				Labels:      u.labels,
+				Annotations: u.annotations,
+			})
+
+			ii := co.Outcome()["default/cm1"]
+			if u.issue == "" {
+				assert.Equal(t, 0, len(ii))
+				return
+			}
+			assert.Equal(t, 1, len(ii))
+			assert.Equal(t, u.issue, ii[0].Message)
+			assert.Equal(t, rules.InfoLevel, ii[0].Level)
+		})
+	}
+}
@@ -191,6 +191,15 @@ func containerResources(co v1.Container) (cpu, mem *resource.Quantity, qos qos)
 	return
 }
 
+// ReplicasOrDefault returns the replicas count. An unset count defaults to a single replica.
+func replicasOrDefault(replicas *int32) int32 {
+	if replicas == nil {
+		return 1
+	}
+
+	return *replicas
+}
+
 // PortAsString prints service port name or number.
 func portAsStr(p v1.ServicePort) string {
 	if p.Name != "" {
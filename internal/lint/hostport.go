@@ -0,0 +1,31 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Popeye
+
+package lint
+
+import (
+	"context"
+
+	"github.com/derailed/popeye/internal"
+	"github.com/derailed/popeye/types"
+	v1 "k8s.io/api/core/v1"
+)
+
+// checkHostPorts flags containers binding host ports as these pin each pod
+// to a distinct node. Replicated workloads requesting a host port are escalated.
+func checkHostPorts(ctx context.Context, c Collector, spec v1.PodSpec, replicas *int32) {
+	count := replicasOrDefault(replicas)
+	for _, co := range spec.Containers {
+		for _, p := range co.Ports {
+			if p.HostPort == 0 {
+				continue
+			}
+			cctx := internal.WithGroup(ctx, types.NewGVR("containers"), co.Name)
+			if count > 1 {
+				c.AddSubCode(cctx, 129, p.HostPort, count)
+				continue
+			}
+			c.AddSubCode(cctx, 128, p.HostPort)
+		}
+	}
+}
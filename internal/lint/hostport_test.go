@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Popeye
+
+package lint
+
+import (
+	"testing"
+
+	"github.com/derailed/popeye/internal"
+	"github.com/derailed/popeye/internal/rules"
+	"github.com/derailed/popeye/internal/test"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+)
+
+func TestCheckHostPorts(t *testing.T) {
+	uu := map[string]struct {
+		ports    []v1.ContainerPort
+		replicas int32
+		unset    bool
+		issue    string
+		level    rules.Level
+	}{
+		"none": {
+			ports:    []v1.ContainerPort{{ContainerPort: 8080}},
+			replicas: 3,
+		},
+		"single": {
+			ports:    []v1.ContainerPort{{ContainerPort: 8080, HostPort: 80}},
+			replicas: 1,
+			issue:    "[POP-128] Container binds hostPort 80. Pods are limited to one per node",
+			level:    rules.WarnLevel,
+		},
+		"unset-replicas": {
+			ports: []v1.ContainerPort{{ContainerPort: 8080, HostPort: 80}},
+			unset: true,
+			issue: "[POP-128] Container binds hostPort 80. Pods are limited to one per node",
+			level: rules.WarnLevel,
+		},
+		"conflict": {
+			ports:    []v1.ContainerPort{{ContainerPort: 8080, HostPort: 80}},
+			replicas: 3,
+			issue:    "[POP-129] hostPort 80 is requested by 3 replicas. Replicas cannot be co-scheduled on the same node",
+			level:    rules.ErrorLevel,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			co := test.MakeCollector(t)
+			co.InitOutcome("default/dp1")
+			ctx := test.MakeContext("apps/v1/deployments", "deployments")
+			ctx = internal.WithSpec(ctx, SpecFor("default/dp1", nil))
+			spec := v1.PodSpec{
+				Containers: []v1.Container{{Name: "c1", Ports: u.ports}},
+			}
+			replicas := &u.replicas
+			if u.unset {
+				replicas = nil
+			}
+			checkHostPorts(ctx, co, spec, replicas)
+
+			ii := co.Outcome()["default/dp1"]
+			if u.issue == "" {
+				assert.Equal(t, 0, len(ii))
+				return
+			}
+			assert.Equal(t, 1, len(ii))
+			assert.Equal(t, "c1", ii[0].Group)
+			assert.Equal(t, u.issue, ii[0].Message)
+			assert.Equal(t, u.level, ii[0].Level)
+		})
+	}
+}
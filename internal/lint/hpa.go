@@ -5,6 +5,8 @@ package lint
 
 import (
 	"context"
+	"encoding/json"
+	"slices"
 	"strings"
 
 	"github.com/derailed/popeye/internal"
@@ -16,6 +18,15 @@ import (
 	autoscalingv1 "k8s.io/api/autoscaling/v1"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// defaultHPATargetCPU represents the hpa default cpu utilization target.
+	defaultHPATargetCPU = 80
+
+	// hpaMetricsAnnotation carries autoscaling/v2 metrics on autoscaling/v1 hpas.
+	hpaMetricsAnnotation = "autoscaling.alpha.kubernetes.io/metrics"
 )
 
 // HorizontalPodAutoscaler represents a HorizontalPodAutoscaler linter.
@@ -35,66 +46,173 @@ func NewHorizontalPodAutoscaler(co *issues.Collector, db *db.DB) *HorizontalPodA
 
 // Lint sanitizes an hpa.
 func (h *HorizontalPodAutoscaler) Lint(ctx context.Context) error {
-	var (
-		tcpu, tmem resource.Quantity
-		current    int32
-	)
+	var tcpu, tmem resource.Quantity
 	res, err := cache.ListAvailableMetrics(h.db)
 	if err != nil {
 		return err
 	}
-	txn, it := h.db.MustITFor(internal.Glossary[internal.HPA])
+	txn, it := h.db.MustITFor(h.db.GVR(internal.HPA))
 	defer txn.Abort()
 	for o := it.Next(); o != nil; o = it.Next() {
 		hpa := o.(*autoscalingv1.HorizontalPodAutoscaler)
 		fqn := client.FQN(hpa.Namespace, hpa.Name)
 		h.InitOutcome(fqn)
 		ctx = internal.WithSpec(ctx, SpecFor(fqn, hpa))
-		var rcpu, rmem resource.Quantity
-		ns, _ := namespaced(fqn)
-		switch hpa.Spec.ScaleTargetRef.Kind {
-		case "Deployment":
-			rfqn := cache.FQN(ns, hpa.Spec.ScaleTargetRef.Name)
-			if o, err := h.db.Find(internal.Glossary[internal.DP], rfqn); err == nil {
-				dp := o.(*appsv1.Deployment)
-				rcpu, rmem = podResources(dp.Spec.Template.Spec)
-				current = dp.Status.AvailableReplicas
-			} else {
-				h.AddCode(ctx, 600, fqn, strings.ToLower(hpa.Spec.ScaleTargetRef.Kind), rfqn)
-				continue
-			}
+		list := h.checkTarget(ctx, fqn, hpa, res)
+		tcpu.Add(*list.Cpu())
+		tmem.Add(*list.Memory())
+		runChecks(ctx, internal.HPA, h, hpa)
+	}
+	h.checkUtilization(ctx, tcpu, tmem, res)
 
-		case "ReplicaSet":
-			rfqn := cache.FQN(ns, hpa.Spec.ScaleTargetRef.Name)
-			if o, err := h.db.Find(internal.Glossary[internal.RS], rfqn); err == nil {
-				rs := o.(*appsv1.ReplicaSet)
-				rcpu, rmem = podResources(rs.Spec.Template.Spec)
-				current = rs.Status.AvailableReplicas
-			} else {
-				h.AddCode(ctx, 600, fqn, strings.ToLower(hpa.Spec.ScaleTargetRef.Kind), rfqn)
-				continue
-			}
+	return nil
+}
 
-		case "StatefulSet":
-			rfqn := cache.FQN(ns, hpa.Spec.ScaleTargetRef.Name)
-			if o, err := h.db.Find(internal.Glossary[internal.STS], rfqn); err == nil {
-				sts := o.(*appsv1.StatefulSet)
-				rcpu, rmem = podResources(sts.Spec.Template.Spec)
-				current = sts.Status.CurrentReplicas
-			} else {
-				h.AddCode(ctx, 600, fqn, strings.ToLower(hpa.Spec.ScaleTargetRef.Kind), rfqn)
-				continue
+// checkTarget lints the hpa scale target and returns the resources it may claim at max scale.
+func (h *HorizontalPodAutoscaler) checkTarget(ctx context.Context, fqn string, hpa *autoscalingv1.HorizontalPodAutoscaler, res v1.ResourceList) v1.ResourceList {
+	var (
+		rcpu, rmem resource.Quantity
+		current    int32
+		sel        *metav1.LabelSelector
+		spec       v1.PodSpec
+	)
+	ns, _ := namespaced(fqn)
+	switch hpa.Spec.ScaleTargetRef.Kind {
+	case "Deployment":
+		rfqn := cache.FQN(ns, hpa.Spec.ScaleTargetRef.Name)
+		if o, err := h.db.Find(h.db.GVR(internal.DP), rfqn); err == nil {
+			dp := o.(*appsv1.Deployment)
+			spec = dp.Spec.Template.Spec
+			current, sel = dp.Status.AvailableReplicas, dp.Spec.Selector
+		} else {
+			h.AddCode(ctx, 600, fqn, strings.ToLower(hpa.Spec.ScaleTargetRef.Kind), rfqn)
+			return nil
+		}
+
+	case "ReplicaSet":
+		rfqn := cache.FQN(ns, hpa.Spec.ScaleTargetRef.Name)
+		if o, err := h.db.Find(h.db.GVR(internal.RS), rfqn); err == nil {
+			rs := o.(*appsv1.ReplicaSet)
+			spec = rs.Spec.Template.Spec
+			current, sel = rs.Status.AvailableReplicas, rs.Spec.Selector
+		} else {
+			h.AddCode(ctx, 600, fqn, strings.ToLower(hpa.Spec.ScaleTargetRef.Kind), rfqn)
+			return nil
+		}
+
+	case "StatefulSet":
+		rfqn := cache.FQN(ns, hpa.Spec.ScaleTargetRef.Name)
+		if o, err := h.db.Find(h.db.GVR(internal.STS), rfqn); err == nil {
+			sts := o.(*appsv1.StatefulSet)
+			spec = sts.Spec.Template.Spec
+			current, sel = sts.Status.CurrentReplicas, sts.Spec.Selector
+		} else {
+			h.AddCode(ctx, 600, fqn, strings.ToLower(hpa.Spec.ScaleTargetRef.Kind), rfqn)
+			return nil
+		}
+	}
+	rcpu, rmem = podResources(spec)
+	h.checkRequests(ctx, hpa, spec)
+
+	rList := v1.ResourceList{v1.ResourceCPU: rcpu, v1.ResourceMemory: rmem}
+	list := h.checkResources(ctx, hpa.Spec.MaxReplicas, current, rList, res)
+	h.checkMinReplicas(ctx, hpa, sel)
+
+	return list
+}
+
+// checkRequests flags hpas scaling on resource utilization while the target
+// pods lack the matching requests.
+func (h *HorizontalPodAutoscaler) checkRequests(ctx context.Context, hpa *autoscalingv1.HorizontalPodAutoscaler, spec v1.PodSpec) {
+	ref := hpa.Spec.ScaleTargetRef
+	for _, r := range utilizationResources(hpa) {
+		nn := make([]string, 0, len(spec.Containers))
+		for _, co := range spec.Containers {
+			if !hasRequest(co, r) {
+				nn = append(nn, co.Name)
 			}
 		}
+		if len(nn) > 0 {
+			h.AddCode(ctx, 607, r, strings.Join(nn, ", "), strings.ToLower(ref.Kind), ref.Name, r)
+		}
+	}
+}
 
-		rList := v1.ResourceList{v1.ResourceCPU: rcpu, v1.ResourceMemory: rmem}
-		list := h.checkResources(ctx, hpa.Spec.MaxReplicas, current, rList, res)
-		tcpu.Add(*list.Cpu())
-		tmem.Add(*list.Memory())
+// utilizationResources returns the resources an hpa scales on by utilization.
+// Hpas with no metrics at all default to cpu utilization.
+func utilizationResources(hpa *autoscalingv1.HorizontalPodAutoscaler) []v1.ResourceName {
+	rr := make([]v1.ResourceName, 0, 2)
+	if hpa.Spec.TargetCPUUtilizationPercentage != nil {
+		rr = append(rr, v1.ResourceCPU)
+	}
+	raw, ok := hpa.Annotations[hpaMetricsAnnotation]
+	if !ok {
+		if len(rr) == 0 {
+			rr = append(rr, v1.ResourceCPU)
+		}
+		return rr
+	}
+	var mm []autoscalingv1.MetricSpec
+	if err := json.Unmarshal([]byte(raw), &mm); err != nil {
+		return rr
+	}
+	for _, m := range mm {
+		if m.Resource == nil || m.Resource.TargetAverageUtilization == nil {
+			continue
+		}
+		if !slices.Contains(rr, m.Resource.Name) {
+			rr = append(rr, m.Resource.Name)
+		}
 	}
-	h.checkUtilization(ctx, tcpu, tmem, res)
 
-	return nil
+	return rr
+}
+
+// hasRequest checks if a container requests a resource. Limits stand in for
+// missing requests.
+func hasRequest(co v1.Container, r v1.ResourceName) bool {
+	if _, ok := co.Resources.Requests[r]; ok {
+		return true
+	}
+	_, ok := co.Resources.Limits[r]
+
+	return ok
+}
+
+// checkMinReplicas flags hpas idling at min replicas with a load well below target.
+func (h *HorizontalPodAutoscaler) checkMinReplicas(ctx context.Context, hpa *autoscalingv1.HorizontalPodAutoscaler, sel *metav1.LabelSelector) {
+	floor := replicasOrDefault(hpa.Spec.MinReplicas)
+	if floor <= 1 || hpa.Status.CurrentReplicas != floor {
+		return
+	}
+	target := int32(defaultHPATargetCPU)
+	if hpa.Spec.TargetCPUUtilizationPercentage != nil {
+		target = *hpa.Spec.TargetCPUUtilizationPercentage
+	}
+
+	pods, err := h.db.FindPodsBySel(hpa.Namespace, sel)
+	if err != nil {
+		return
+	}
+	var usage, requests resource.Quantity
+	for _, po := range pods {
+		pmx, err := h.db.FindPMX(cache.FQN(po.Namespace, po.Name))
+		if err != nil || pmx == nil {
+			continue
+		}
+		for _, co := range pmx.Containers {
+			usage.Add(*co.Usage.Cpu())
+		}
+		cpu, _ := podResources(po.Spec)
+		requests.Add(cpu)
+	}
+	// No metrics or requests to compare against -> bail!
+	if usage.IsZero() || requests.IsZero() {
+		return
+	}
+	if util := toMCRatio(usage, requests); util < float64(target)/2 {
+		h.AddCode(ctx, 606, floor, asPerc(util), target)
+	}
 }
 
 func (h *HorizontalPodAutoscaler) checkResources(ctx context.Context, max, current int32, rList, res v1.ResourceList) v1.ResourceList {
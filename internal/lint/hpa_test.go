@@ -14,6 +14,8 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	autoscalingv1 "k8s.io/api/autoscaling/v1"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	mv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
 )
 
@@ -23,15 +25,15 @@ func TestHPALint(t *testing.T) {
 	l := db.NewLoader(dba)
 
 	ctx := test.MakeCtx(t)
-	assert.NoError(t, test.LoadDB[*autoscalingv1.HorizontalPodAutoscaler](ctx, l.DB, "autoscaling/hpa/1.yaml", internal.Glossary[internal.HPA]))
-	assert.NoError(t, test.LoadDB[*appsv1.Deployment](ctx, l.DB, "apps/dp/1.yaml", internal.Glossary[internal.DP]))
-	assert.NoError(t, test.LoadDB[*appsv1.ReplicaSet](ctx, l.DB, "apps/rs/1.yaml", internal.Glossary[internal.RS]))
-	assert.NoError(t, test.LoadDB[*appsv1.StatefulSet](ctx, l.DB, "apps/sts/1.yaml", internal.Glossary[internal.STS]))
-	assert.NoError(t, test.LoadDB[*v1.Node](ctx, l.DB, "core/node/1.yaml", internal.Glossary[internal.NO]))
-	assert.NoError(t, test.LoadDB[*v1.Pod](ctx, l.DB, "core/pod/2.yaml", internal.Glossary[internal.PO]))
-	assert.NoError(t, test.LoadDB[*v1.ServiceAccount](ctx, l.DB, "core/sa/1.yaml", internal.Glossary[internal.SA]))
-	assert.NoError(t, test.LoadDB[*mv1beta1.PodMetrics](ctx, l.DB, "mx/pod/1.yaml", internal.Glossary[internal.PMX]))
-	assert.NoError(t, test.LoadDB[*mv1beta1.NodeMetrics](ctx, l.DB, "mx/node/1.yaml", internal.Glossary[internal.NMX]))
+	assert.NoError(t, test.LoadDB[*autoscalingv1.HorizontalPodAutoscaler](ctx, l.DB, "autoscaling/hpa/1.yaml", test.Glossary[internal.HPA]))
+	assert.NoError(t, test.LoadDB[*appsv1.Deployment](ctx, l.DB, "apps/dp/1.yaml", test.Glossary[internal.DP]))
+	assert.NoError(t, test.LoadDB[*appsv1.ReplicaSet](ctx, l.DB, "apps/rs/1.yaml", test.Glossary[internal.RS]))
+	assert.NoError(t, test.LoadDB[*appsv1.StatefulSet](ctx, l.DB, "apps/sts/1.yaml", test.Glossary[internal.STS]))
+	assert.NoError(t, test.LoadDB[*v1.Node](ctx, l.DB, "core/node/1.yaml", test.Glossary[internal.NO]))
+	assert.NoError(t, test.LoadDB[*v1.Pod](ctx, l.DB, "core/pod/2.yaml", test.Glossary[internal.PO]))
+	assert.NoError(t, test.LoadDB[*v1.ServiceAccount](ctx, l.DB, "core/sa/1.yaml", test.Glossary[internal.SA]))
+	assert.NoError(t, test.LoadDB[*mv1beta1.PodMetrics](ctx, l.DB, "mx/pod/1.yaml", test.Glossary[internal.PMX]))
+	assert.NoError(t, test.LoadDB[*mv1beta1.NodeMetrics](ctx, l.DB, "mx/node/1.yaml", test.Glossary[internal.NMX]))
 
 	hpa := NewHorizontalPodAutoscaler(test.MakeCollector(t), dba)
 	assert.Nil(t, hpa.Lint(test.MakeContext("autoscaling/v1/horizontalpodautoscalers", "horizontalpodautoscalers")))
@@ -62,3 +64,145 @@ func TestHPALint(t *testing.T) {
 	assert.Equal(t, 1, len(ii))
 
 }
+
+func TestHPACheckMinReplicas(t *testing.T) {
+	uu := map[string]struct {
+		mx           string
+		min, current int32
+		issue        string
+	}{
+		"low-load": {
+			mx:      "mx/pod/2.yaml",
+			min:     3,
+			current: 3,
+			issue:   `[POP-606] minReplicas 3 may be too high. CPU utilization 10.00% is well below target 80%`,
+		},
+		"high-load": {
+			mx:      "mx/pod/1.yaml",
+			min:     3,
+			current: 3,
+		},
+		"scaled-up": {
+			mx:      "mx/pod/2.yaml",
+			min:     3,
+			current: 5,
+		},
+		"single-min": {
+			mx:      "mx/pod/2.yaml",
+			min:     1,
+			current: 1,
+		},
+		"no-metrics": {
+			min:     3,
+			current: 3,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			dba, err := test.NewTestDB()
+			assert.NoError(t, err)
+			ctx := test.MakeCtx(t)
+			assert.NoError(t, test.LoadDB[*v1.Pod](ctx, dba, "core/pod/1.yaml", test.Glossary[internal.PO]))
+			if u.mx != "" {
+				assert.NoError(t, test.LoadDB[*mv1beta1.PodMetrics](ctx, dba, u.mx, test.Glossary[internal.PMX]))
+			}
+
+			h := NewHorizontalPodAutoscaler(test.MakeCollector(t), dba)
+			fqn := "default/hpa1"
+			h.InitOutcome(fqn)
+			ctx = internal.WithSpec(test.MakeContext("autoscaling/v1/horizontalpodautoscalers", "horizontalpodautoscalers"), SpecFor(fqn, nil))
+
+			hpa := autoscalingv1.HorizontalPodAutoscaler{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "hpa1"},
+				Spec:       autoscalingv1.HorizontalPodAutoscalerSpec{MinReplicas: &u.min},
+				Status:     autoscalingv1.HorizontalPodAutoscalerStatus{CurrentReplicas: u.current},
+			}
+			h.checkMinReplicas(ctx, &hpa, &metav1.LabelSelector{MatchLabels: map[string]string{"app": "p1"}})
+
+			ii := h.Outcome()[fqn]
+			if u.issue == "" {
+				assert.Equal(t, 0, len(ii))
+				return
+			}
+			assert.Equal(t, 1, len(ii))
+			assert.Equal(t, u.issue, ii[0].Message)
+			assert.Equal(t, rules.InfoLevel, ii[0].Level)
+		})
+	}
+}
+
+func TestHPACheckRequests(t *testing.T) {
+	requested := v1.ResourceRequirements{
+		Requests: v1.ResourceList{
+			v1.ResourceCPU:    resource.MustParse("100m"),
+			v1.ResourceMemory: resource.MustParse("64Mi"),
+		},
+	}
+	cpuOnly := v1.ResourceRequirements{
+		Limits: v1.ResourceList{v1.ResourceCPU: resource.MustParse("100m")},
+	}
+	memMetrics := `[{"type":"Resource","resource":{"name":"memory","targetAverageUtilization":70}}]`
+	podMetrics := `[{"type":"Pods","pods":{"metricName":"qps","targetAverageValue":"10"}}]`
+	uu := map[string]struct {
+		target      int32
+		annotations map[string]string
+		cc          []v1.Container
+		issues      []string
+	}{
+		"requested": {
+			target:      50,
+			annotations: map[string]string{hpaMetricsAnnotation: memMetrics},
+			cc:          []v1.Container{{Name: "c1", Resources: requested}},
+		},
+		"default-cpu": {
+			cc: []v1.Container{{Name: "c1", Resources: requested}, {Name: "c2"}},
+			issues: []string{
+				`[POP-607] HPA scales on cpu utilization but containers c2 of deployment "dp1" request no cpu. Utilization cannot be computed`,
+			},
+		},
+		"unrequested": {
+			target:      50,
+			annotations: map[string]string{hpaMetricsAnnotation: memMetrics},
+			cc:          []v1.Container{{Name: "c1", Resources: cpuOnly}, {Name: "c2"}},
+			issues: []string{
+				`[POP-607] HPA scales on cpu utilization but containers c2 of deployment "dp1" request no cpu. Utilization cannot be computed`,
+				`[POP-607] HPA scales on memory utilization but containers c1, c2 of deployment "dp1" request no memory. Utilization cannot be computed`,
+			},
+		},
+		"custom-metrics": {
+			annotations: map[string]string{hpaMetricsAnnotation: podMetrics},
+			cc:          []v1.Container{{Name: "c1"}},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			h := NewHorizontalPodAutoscaler(test.MakeCollector(t), nil)
+			fqn := "default/hpa1"
+			h.InitOutcome(fqn)
+			ctx := internal.WithSpec(test.MakeContext("autoscaling/v1/horizontalpodautoscalers", "horizontalpodautoscalers"), SpecFor(fqn, nil))
+			var target *int32
+			if u.target > 0 {
+				target = &u.target
+			}
+			hpa := autoscalingv1.HorizontalPodAutoscaler{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "hpa1", Annotations: u.annotations},
+				Spec: autoscalingv1.HorizontalPodAutoscalerSpec{
+					ScaleTargetRef:                 autoscalingv1.CrossVersionObjectReference{Kind: "Deployment", Name: "dp1"},
+					TargetCPUUtilizationPercentage: target,
+				},
+			}
+			h.checkRequests(ctx, &hpa, v1.PodSpec{Containers: u.cc})
+
+			ii := h.Outcome()[fqn]
+			assert.Equal(t, len(u.issues), len(ii))
+			for i, m := range u.issues {
+				assert.Equal(t, m, ii[i].Message)
+				assert.Equal(t, rules.ErrorLevel, ii[i].Level)
+			}
+		})
+	}
+}
@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Popeye
+
+package lint
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/derailed/popeye/internal"
+	"github.com/derailed/popeye/types"
+	v1 "k8s.io/api/core/v1"
+)
+
+// inconclusiveVerdicts tracks scan verdicts that neither pass nor fail.
+var inconclusiveVerdicts = []string{"", "unknown", "pending", "error"}
+
+// checkImageScans flags container images whose scan verdict, as recorded by an
+// admission scanner annotation, is failing or inconclusive.
+func (s *Pod) checkImageScans(ctx context.Context, po *v1.Pod) {
+	scan := s.PodImageScan()
+	if !scan.IsSet() {
+		return
+	}
+	for _, cc := range [][]v1.Container{po.Spec.InitContainers, po.Spec.Containers} {
+		for _, co := range cc {
+			verdict, ok := po.Annotations[scan.Annotation+"."+co.Name]
+			if !ok {
+				verdict = po.Annotations[scan.Annotation]
+			}
+			verdict = strings.TrimSpace(verdict)
+			v := strings.ToLower(verdict)
+			cctx := internal.WithGroup(ctx, types.NewGVR("containers"), co.Name)
+			switch {
+			case slices.ContainsFunc(scan.Accepted, func(a string) bool { return strings.EqualFold(a, v) }):
+			case slices.Contains(inconclusiveVerdicts, v):
+				if verdict == "" {
+					verdict = "missing"
+				}
+				s.AddSubCode(cctx, 136, co.Image, verdict)
+			default:
+				s.AddSubCode(cctx, 135, co.Image, verdict)
+			}
+		}
+	}
+}
@@ -0,0 +1,86 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Popeye
+
+package lint
+
+import (
+	"testing"
+
+	"github.com/derailed/popeye/internal"
+	"github.com/derailed/popeye/internal/rules"
+	"github.com/derailed/popeye/internal/test"
+	"github.com/derailed/popeye/pkg/config"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestPodCheckImageScans(t *testing.T) {
+	const key = "scanner.example.com/verdict"
+	uu := map[string]struct {
+		annotations map[string]string
+		issue       string
+		level       rules.Level
+	}{
+		"passing": {
+			annotations: map[string]string{key: "Passed"},
+		},
+		"per-container": {
+			annotations: map[string]string{key: "failed", key + ".c1": "passed"},
+		},
+		"failing": {
+			annotations: map[string]string{key + ".c1": "critical"},
+			issue:       `[POP-135] Image "nginx:1.25" failed its vulnerability scan with verdict "critical"`,
+			level:       rules.WarnLevel,
+		},
+		"unknown": {
+			annotations: map[string]string{key: "unknown"},
+			issue:       `[POP-136] Image "nginx:1.25" has no conclusive vulnerability scan verdict (unknown)`,
+			level:       rules.InfoLevel,
+		},
+		"missing": {
+			issue: `[POP-136] Image "nginx:1.25" has no conclusive vulnerability scan verdict (missing)`,
+			level: rules.InfoLevel,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			co := test.MakeCollector(t)
+			co.Config.Resources.Pod.ImageScan = config.ImageScan{
+				Annotation: key,
+				Accepted:   []string{"passed"},
+			}
+			p := NewPod(co, nil)
+			fqn := "default/p1"
+			p.InitOutcome(fqn)
+			ctx := internal.WithSpec(test.MakeContext("v1/pods", "pods"), SpecFor(fqn, nil))
+			po := v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "p1", Annotations: u.annotations},
+				Spec:       v1.PodSpec{Containers: []v1.Container{{Name: "c1", Image: "nginx:1.25"}}},
+			}
+			p.checkImageScans(ctx, &po)
+
+			ii := p.Outcome()[fqn]
+			if u.issue == "" {
+				assert.Equal(t, 0, len(ii))
+				return
+			}
+			assert.Equal(t, 1, len(ii))
+			assert.Equal(t, u.issue, ii[0].Message)
+			assert.Equal(t, u.level, ii[0].Level)
+		})
+	}
+}
+
+func TestPodCheckImageScansUnset(t *testing.T) {
+	p := NewPod(test.MakeCollector(t), nil)
+	fqn := "default/p1"
+	p.InitOutcome(fqn)
+	ctx := internal.WithSpec(test.MakeContext("v1/pods", "pods"), SpecFor(fqn, nil))
+	po := v1.Pod{Spec: v1.PodSpec{Containers: []v1.Container{{Name: "c1", Image: "nginx:1.25"}}}}
+	p.checkImageScans(ctx, &po)
+
+	assert.Equal(t, 0, len(p.Outcome()[fqn]))
+}
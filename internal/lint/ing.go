@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/derailed/popeye/internal"
 	"github.com/derailed/popeye/internal/cache"
@@ -36,13 +37,18 @@ func NewIngress(co *issues.Collector, db *db.DB) *Ingress {
 
 // Lint cleanse the resource.
 func (s *Ingress) Lint(ctx context.Context) error {
-	txn, it := s.db.MustITFor(internal.Glossary[internal.ING])
+	classes, err := s.db.ListIngressClasses()
+	if err != nil {
+		return err
+	}
+	txn, it := s.db.MustITFor(s.db.GVR(internal.ING))
 	defer txn.Abort()
 	for o := it.Next(); o != nil; o = it.Next() {
 		ing := o.(*netv1.Ingress)
 		fqn := client.FQN(ing.Namespace, ing.Name)
 		s.InitOutcome(fqn)
 		ctx = internal.WithSpec(ctx, SpecFor(fqn, ing))
+		checkHelmOwnership(ctx, s, ing.ObjectMeta)
 
 		for _, ing := range ing.Status.LoadBalancer.Ingress {
 			for _, p := range ing.Ports {
@@ -61,11 +67,69 @@ func (s *Ingress) Lint(ctx context.Context) error {
 				s.checkBackendRef(ctx, ing.Namespace, h.Backend.Resource)
 			}
 		}
+		s.checkPathTypes(ctx, ing.Spec.Rules)
+		s.checkClassAnnotation(ctx, ing)
+		if classes != nil {
+			s.checkIngressClass(ctx, ing, classes)
+		}
+		runChecks(ctx, internal.ING, s, ing)
 	}
 
 	return nil
 }
 
+// checkPathTypes flags Prefix paths overlapping Exact paths on the same host
+// and paths relying on controller specific matching.
+func (s *Ingress) checkPathTypes(ctx context.Context, rr []netv1.IngressRule) {
+	prefixes, exacts := make(map[string][]string), make(map[string][]string)
+	hosts := make([]string, 0, len(rr))
+	for _, r := range rr {
+		if r.HTTP == nil {
+			continue
+		}
+		if _, ok := prefixes[r.Host]; !ok {
+			hosts = append(hosts, r.Host)
+			prefixes[r.Host] = nil
+		}
+		for _, p := range r.HTTP.Paths {
+			if p.PathType == nil {
+				continue
+			}
+			switch *p.PathType {
+			case netv1.PathTypePrefix:
+				prefixes[r.Host] = append(prefixes[r.Host], p.Path)
+			case netv1.PathTypeExact:
+				exacts[r.Host] = append(exacts[r.Host], p.Path)
+			case netv1.PathTypeImplementationSpecific:
+				s.AddCode(ctx, 1406, p.Path)
+			}
+		}
+	}
+	for _, h := range hosts {
+		host := h
+		if host == "" {
+			host = "*"
+		}
+		for _, prefix := range prefixes[h] {
+			for _, exact := range exacts[h] {
+				if prefixMatches(prefix, exact) {
+					s.AddCode(ctx, 1405, prefix, host, exact)
+				}
+			}
+		}
+	}
+}
+
+// prefixMatches checks if a Prefix path matches a given path, element wise.
+func prefixMatches(prefix, path string) bool {
+	prefix = strings.TrimSuffix(prefix, "/")
+	if prefix == "" {
+		return true
+	}
+
+	return path == prefix || strings.HasPrefix(path, prefix+"/")
+}
+
 func (s *Ingress) checkBackendRef(ctx context.Context, ns string, be *v1.TypedLocalObjectReference) {
 	if be == nil {
 		return
@@ -77,7 +141,7 @@ func (s *Ingress) checkBackendSvc(ctx context.Context, ns string, be *netv1.Ingr
 	if be == nil {
 		return
 	}
-	o, err := s.db.Find(internal.Glossary[internal.SVC], cache.FQN(ns, be.Name))
+	o, err := s.db.Find(s.db.GVR(internal.SVC), cache.FQN(ns, be.Name))
 	if err != nil {
 		s.AddCode(ctx, 1401, be.Name)
 		return
@@ -111,3 +175,39 @@ func (s *Ingress) findPortByNumberOrName(ctx context.Context, pp []v1.ServicePor
 
 	return false
 }
+
+// legacyIngressClass tracks the deprecated ingress class annotation.
+const legacyIngressClass = "kubernetes.io/ingress.class"
+
+// checkIngressClass flags ingresses no controller is expected to serve.
+func (s *Ingress) checkIngressClass(ctx context.Context, ing *netv1.Ingress, classes map[string]*netv1.IngressClass) {
+	if n := ing.Spec.IngressClassName; n != nil && *n != "" {
+		if _, ok := classes[*n]; !ok {
+			s.AddCode(ctx, 1407, *n)
+		}
+		return
+	}
+	if _, ok := ing.Annotations[legacyIngressClass]; ok {
+		return
+	}
+	if len(defaultIngressClasses(classes)) > 0 {
+		return
+	}
+	s.AddCode(ctx, 1408)
+}
+
+// checkClassAnnotation flags ingresses still relying on the legacy ingress
+// class annotation, or disagreeing with their spec ingress class.
+func (s *Ingress) checkClassAnnotation(ctx context.Context, ing *netv1.Ingress) {
+	a, ok := ing.Annotations[legacyIngressClass]
+	if !ok {
+		return
+	}
+	if n := ing.Spec.IngressClassName; n != nil && *n != "" {
+		if *n != a {
+			s.AddCode(ctx, 1409, legacyIngressClass, a, *n)
+		}
+		return
+	}
+	s.AddCode(ctx, 1410, legacyIngressClass, a)
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	v1 "k8s.io/api/core/v1"
 	netv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func TestIngLint(t *testing.T) {
@@ -21,8 +22,9 @@ func TestIngLint(t *testing.T) {
 	l := db.NewLoader(dba)
 
 	ctx := test.MakeCtx(t)
-	assert.NoError(t, test.LoadDB[*netv1.Ingress](ctx, l.DB, "net/ingress/1.yaml", internal.Glossary[internal.ING]))
-	assert.NoError(t, test.LoadDB[*v1.Service](ctx, l.DB, "core/svc/1.yaml", internal.Glossary[internal.SVC]))
+	assert.NoError(t, test.LoadDB[*netv1.Ingress](ctx, l.DB, "net/ingress/1.yaml", test.Glossary[internal.ING]))
+	assert.NoError(t, test.LoadDB[*v1.Service](ctx, l.DB, "core/svc/1.yaml", test.Glossary[internal.SVC]))
+	assert.NoError(t, test.LoadDB[*netv1.IngressClass](ctx, l.DB, "net/ingc/1.yaml", test.Glossary[internal.INGC]))
 
 	ing := NewIngress(test.MakeCollector(t), dba)
 	assert.Nil(t, ing.Lint(test.MakeContext("networking.k8s.io/v1/ingresses", "ingresses")))
@@ -62,3 +64,197 @@ func TestIngLint(t *testing.T) {
 	assert.Equal(t, `[POP-1403] Ingress backend uses a port#, prefer a named port: 9091`, ii[1].Message)
 	assert.Equal(t, rules.InfoLevel, ii[1].Level)
 }
+
+func TestIngCheckPathTypes(t *testing.T) {
+	uu := map[string]struct {
+		rules []netv1.IngressRule
+		ee    []string
+	}{
+		"clean": {
+			rules: []netv1.IngressRule{
+				makeIngRule("a.io", makeIngPath("/api", netv1.PathTypePrefix), makeIngPath("/health", netv1.PathTypeExact)),
+			},
+		},
+		"prefix-shadows-exact": {
+			rules: []netv1.IngressRule{
+				makeIngRule("a.io", makeIngPath("/api/", netv1.PathTypePrefix), makeIngPath("/api/v1", netv1.PathTypeExact)),
+			},
+			ee: []string{`[POP-1405] Prefix path "/api/" on host "a.io" overlaps Exact path "/api/v1". Exact match takes precedence`},
+		},
+		"root-prefix": {
+			rules: []netv1.IngressRule{
+				makeIngRule("", makeIngPath("/", netv1.PathTypePrefix)),
+				makeIngRule("", makeIngPath("/login", netv1.PathTypeExact)),
+			},
+			ee: []string{`[POP-1405] Prefix path "/" on host "*" overlaps Exact path "/login". Exact match takes precedence`},
+		},
+		"element-wise": {
+			rules: []netv1.IngressRule{
+				makeIngRule("a.io", makeIngPath("/api", netv1.PathTypePrefix), makeIngPath("/apis", netv1.PathTypeExact)),
+			},
+		},
+		"other-host": {
+			rules: []netv1.IngressRule{
+				makeIngRule("a.io", makeIngPath("/api", netv1.PathTypePrefix)),
+				makeIngRule("b.io", makeIngPath("/api/v1", netv1.PathTypeExact)),
+			},
+		},
+		"implementation-specific": {
+			rules: []netv1.IngressRule{
+				makeIngRule("a.io", makeIngPath("/app(/|$)(.*)", netv1.PathTypeImplementationSpecific)),
+			},
+			ee: []string{`[POP-1406] Path "/app(/|$)(.*)" uses ImplementationSpecific pathType. Prefer Prefix or Exact for portable matching`},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ing := NewIngress(test.MakeCollector(t), nil)
+			ctx := test.MakeContext("networking.k8s.io/v1/ingresses", "ingresses")
+			ctx = internal.WithSpec(ctx, SpecFor("default/ing1", nil))
+			ing.checkPathTypes(ctx, u.rules)
+
+			ii := ing.Outcome()["default/ing1"]
+			assert.Equal(t, len(u.ee), len(ii))
+			for i, e := range u.ee {
+				assert.Equal(t, e, ii[i].Message)
+				assert.Equal(t, rules.InfoLevel, ii[i].Level)
+			}
+		})
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func makeIngRule(host string, pp ...netv1.HTTPIngressPath) netv1.IngressRule {
+	return netv1.IngressRule{
+		Host: host,
+		IngressRuleValue: netv1.IngressRuleValue{
+			HTTP: &netv1.HTTPIngressRuleValue{Paths: pp},
+		},
+	}
+}
+
+func makeIngPath(path string, pt netv1.PathType) netv1.HTTPIngressPath {
+	return netv1.HTTPIngressPath{Path: path, PathType: &pt}
+}
+
+func TestIngCheckIngressClass(t *testing.T) {
+	uu := map[string]struct {
+		class       string
+		annotations map[string]string
+		noDefault   bool
+		issue       string
+		level       rules.Level
+	}{
+		"valid": {
+			class: "traefik",
+		},
+		"dangling": {
+			class: "haproxy",
+			issue: `[POP-1407] Ingress references IngressClass "haproxy" which does not exist. No controller will serve it`,
+			level: rules.ErrorLevel,
+		},
+		"no-class-default": {},
+		"no-class-no-default": {
+			noDefault: true,
+			issue:     "[POP-1408] No IngressClass specified and no default IngressClass is defined",
+			level:     rules.InfoLevel,
+		},
+		"legacy-annotation": {
+			annotations: map[string]string{legacyIngressClass: "nginx"},
+			noDefault:   true,
+		},
+	}
+
+	dba, err := test.NewTestDB()
+	assert.NoError(t, err)
+	l := db.NewLoader(dba)
+	assert.NoError(t, test.LoadDB[*netv1.IngressClass](test.MakeCtx(t), l.DB, "net/ingc/1.yaml", test.Glossary[internal.INGC]))
+	classes, err := dba.ListIngressClasses()
+	assert.NoError(t, err)
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			cc := classes
+			if u.noDefault {
+				cc = map[string]*netv1.IngressClass{"traefik": classes["traefik"]}
+			}
+			ing := NewIngress(test.MakeCollector(t), dba)
+			ctx := test.MakeContext("networking.k8s.io/v1/ingresses", "ingresses")
+			ctx = internal.WithSpec(ctx, SpecFor("default/ing1", nil))
+			o := netv1.Ingress{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "ing1", Annotations: u.annotations},
+			}
+			if u.class != "" {
+				o.Spec.IngressClassName = &u.class
+			}
+			ing.checkIngressClass(ctx, &o, cc)
+
+			ii := ing.Outcome()["default/ing1"]
+			if u.issue == "" {
+				assert.Equal(t, 0, len(ii))
+				return
+			}
+			assert.Equal(t, 1, len(ii))
+			assert.Equal(t, u.issue, ii[0].Message)
+			assert.Equal(t, u.level, ii[0].Level)
+		})
+	}
+}
+
+func TestIngCheckClassAnnotation(t *testing.T) {
+	uu := map[string]struct {
+		class       string
+		annotations map[string]string
+		issue       string
+		level       rules.Level
+	}{
+		"spec-only": {
+			class: "traefik",
+		},
+		"agreeing": {
+			class:       "traefik",
+			annotations: map[string]string{legacyIngressClass: "traefik"},
+		},
+		"conflicting": {
+			class:       "traefik",
+			annotations: map[string]string{legacyIngressClass: "nginx"},
+			issue:       `[POP-1409] Annotation kubernetes.io/ingress.class "nginx" conflicts with spec.ingressClassName "traefik". Controllers may disagree on who serves it`,
+			level:       rules.ErrorLevel,
+		},
+		"annotation-only": {
+			annotations: map[string]string{legacyIngressClass: "nginx"},
+			issue:       `[POP-1410] Uses deprecated annotation kubernetes.io/ingress.class "nginx". Set spec.ingressClassName instead`,
+			level:       rules.InfoLevel,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ing := NewIngress(test.MakeCollector(t), nil)
+			ctx := test.MakeContext("networking.k8s.io/v1/ingresses", "ingresses")
+			ctx = internal.WithSpec(ctx, SpecFor("default/ing1", nil))
+			o := netv1.Ingress{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "ing1", Annotations: u.annotations},
+			}
+			if u.class != "" {
+				o.Spec.IngressClassName = &u.class
+			}
+			ing.checkClassAnnotation(ctx, &o)
+
+			ii := ing.Outcome()["default/ing1"]
+			if u.issue == "" {
+				assert.Equal(t, 0, len(ii))
+				return
+			}
+			assert.Equal(t, 1, len(ii))
+			assert.Equal(t, u.issue, ii[0].Message)
+			assert.Equal(t, u.level, ii[0].Level)
+		})
+	}
+}
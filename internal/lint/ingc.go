@@ -0,0 +1,82 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Popeye
+
+package lint
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/derailed/popeye/internal"
+	"github.com/derailed/popeye/internal/client"
+	"github.com/derailed/popeye/internal/db"
+	"github.com/derailed/popeye/internal/issues"
+	netv1 "k8s.io/api/networking/v1"
+)
+
+type (
+	// IngressClass tracks IngressClass sanitization.
+	IngressClass struct {
+		*issues.Collector
+
+		db *db.DB
+	}
+)
+
+// NewIngressClass returns a new instance.
+func NewIngressClass(co *issues.Collector, db *db.DB) *IngressClass {
+	return &IngressClass{
+		Collector: co,
+		db:        db,
+	}
+}
+
+// Lint cleanse the resource.
+func (s *IngressClass) Lint(ctx context.Context) error {
+	ics, err := s.db.ListIngressClasses()
+	if err != nil {
+		return err
+	}
+	defaults := defaultIngressClasses(ics)
+
+	kk := make([]string, 0, len(ics))
+	for k := range ics {
+		kk = append(kk, k)
+	}
+	sort.Strings(kk)
+	for _, k := range kk {
+		ic := ics[k]
+		fqn := client.FQN(ic.Namespace, ic.Name)
+		s.InitOutcome(fqn)
+		ctx = internal.WithSpec(ctx, SpecFor(fqn, ic))
+		s.checkDefault(ctx, ic, defaults)
+		runChecks(ctx, internal.INGC, s, ic)
+	}
+
+	return nil
+}
+
+// checkDefault flags default classes competing for unclassified ingresses.
+// Ingresses left without any default class are reported by the ingress linter.
+func (s *IngressClass) checkDefault(ctx context.Context, ic *netv1.IngressClass, defaults []string) {
+	if len(defaults) > 1 && isDefaultIngressClass(ic) {
+		s.AddCode(ctx, 1900, strings.Join(defaults, ", "))
+	}
+}
+
+func defaultIngressClasses(ics map[string]*netv1.IngressClass) []string {
+	dd := make([]string, 0, 1)
+	for _, ic := range ics {
+		if isDefaultIngressClass(ic) {
+			dd = append(dd, ic.Name)
+		}
+	}
+	sort.Strings(dd)
+
+	return dd
+}
+
+func isDefaultIngressClass(ic *netv1.IngressClass) bool {
+	return ic.Annotations[netv1.AnnotationIsDefaultIngressClass] == "true"
+}
@@ -0,0 +1,70 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Popeye
+
+package lint
+
+import (
+	"testing"
+
+	"github.com/derailed/popeye/internal"
+	"github.com/derailed/popeye/internal/db"
+	"github.com/derailed/popeye/internal/test"
+	"github.com/stretchr/testify/assert"
+	netv1 "k8s.io/api/networking/v1"
+)
+
+func TestIngressClassLint(t *testing.T) {
+	uu := map[string]struct {
+		ingc   string
+		issues map[string][]string
+	}{
+		"no-default": {
+			ingc: "net/ingc/2.yaml",
+			issues: map[string][]string{
+				"nginx":   {},
+				"traefik": {},
+			},
+		},
+		"single-default": {
+			ingc: "net/ingc/1.yaml",
+			issues: map[string][]string{
+				"nginx":   {},
+				"traefik": {},
+			},
+		},
+		"multiple-defaults": {
+			ingc: "net/ingc/3.yaml",
+			issues: map[string][]string{
+				"haproxy": {},
+				"nginx": {
+					`[POP-1900] Multiple default IngressClasses defined: nginx, traefik`,
+				},
+				"traefik": {
+					`[POP-1900] Multiple default IngressClasses defined: nginx, traefik`,
+				},
+			},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			dba, err := test.NewTestDB()
+			assert.NoError(t, err)
+			l := db.NewLoader(dba)
+			ctx := test.MakeCtx(t)
+			assert.NoError(t, test.LoadDB[*netv1.IngressClass](ctx, l.DB, u.ingc, test.Glossary[internal.INGC]))
+
+			ic := NewIngressClass(test.MakeCollector(t), dba)
+			assert.Nil(t, ic.Lint(test.MakeContext("networking.k8s.io/v1/ingressclasses", "ingressclasses")))
+			assert.Equal(t, len(u.issues), len(ic.Outcome()))
+			for fqn, ee := range u.issues {
+				ii := ic.Outcome()[fqn]
+				assert.Equal(t, len(ee), len(ii), fqn)
+				for i, m := range ee {
+					assert.Equal(t, m, ii[i].Message)
+				}
+			}
+		})
+	}
+}
@@ -33,7 +33,8 @@ func NewJob(co *issues.Collector, db *db.DB) *Job {
 // Lint cleanse the resource.
 func (s *Job) Lint(ctx context.Context) error {
 	over := pullOverAllocs(ctx)
-	txn, it := s.db.MustITFor(internal.Glossary[internal.JOB])
+	ctx = withAllocatable(ctx, s.db)
+	txn, it := s.db.MustITFor(s.db.GVR(internal.JOB))
 	defer txn.Abort()
 	for o := it.Next(); o != nil; o = it.Next() {
 		j := o.(*batchv1.Job)
@@ -41,8 +42,12 @@ func (s *Job) Lint(ctx context.Context) error {
 		s.InitOutcome(fqn)
 		ctx = internal.WithSpec(ctx, SpecFor(fqn, j))
 		s.checkJob(ctx, fqn, j)
+		if !ownedByCronJob(j) {
+			checkActiveDeadline(ctx, s, j.Spec)
+		}
 		s.checkContainers(ctx, fqn, j.Spec.Template.Spec)
 		s.checkUtilization(ctx, over, fqn)
+		runChecks(ctx, internal.JOB, s, j)
 	}
 
 	return nil
@@ -50,7 +55,7 @@ func (s *Job) Lint(ctx context.Context) error {
 
 // CheckJob checks if Job contract is currently happy or not.
 func (s *Job) checkJob(ctx context.Context, fqn string, j *batchv1.Job) {
-	checkEvents(ctx, s.Collector, internal.JOB, dao.WarnEvt, "Job", fqn)
+	checkEvents(ctx, s.Collector, s.db.GVR(internal.JOB), dao.WarnEvt, "Job", fqn)
 
 	if j.Spec.Suspend != nil && *j.Spec.Suspend {
 		s.AddCode(ctx, 1500, j.Kind)
@@ -58,12 +63,30 @@ func (s *Job) checkJob(ctx context.Context, fqn string, j *batchv1.Job) {
 
 	if sa := j.Spec.Template.Spec.ServiceAccountName; sa != "" {
 		saFQN := client.FQN(j.Namespace, sa)
-		if !s.db.Exists(internal.Glossary[internal.SA], saFQN) {
+		if !s.db.Exists(s.db.GVR(internal.SA), saFQN) {
 			s.AddCode(ctx, 307, j.Kind, sa)
 		}
 	}
 }
 
+// checkActiveDeadline flags jobs that may run forever.
+func checkActiveDeadline(ctx context.Context, c Collector, spec batchv1.JobSpec) {
+	if spec.ActiveDeadlineSeconds == nil {
+		c.AddCode(ctx, 1504)
+	}
+}
+
+// ownedByCronJob checks if a job is spawned by a cronjob.
+func ownedByCronJob(j *batchv1.Job) bool {
+	for _, o := range j.OwnerReferences {
+		if o.Kind == "CronJob" {
+			return true
+		}
+	}
+
+	return false
+}
+
 // CheckContainers runs thru Job template and checks pod configuration.
 func (s *Job) checkContainers(ctx context.Context, fqn string, spec v1.PodSpec) {
 	c := NewContainer(fqn, s)
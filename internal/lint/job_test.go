@@ -14,6 +14,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	batchv1 "k8s.io/api/batch/v1"
 	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	mv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
 )
 
@@ -27,21 +28,82 @@ func TestJobLint(t *testing.T) {
 	l := db.NewLoader(dba)
 
 	ctx := test.MakeCtx(t)
-	assert.NoError(t, test.LoadDB[*batchv1.Job](ctx, l.DB, "batch/job/1.yaml", internal.Glossary[internal.JOB]))
-	assert.NoError(t, test.LoadDB[*v1.ServiceAccount](ctx, l.DB, "core/sa/1.yaml", internal.Glossary[internal.SA]))
-	assert.NoError(t, test.LoadDB[*v1.Pod](ctx, l.DB, "core/pod/1.yaml", internal.Glossary[internal.PO]))
-	assert.NoError(t, test.LoadDB[*mv1beta1.PodMetrics](ctx, l.DB, "mx/pod/1.yaml", internal.Glossary[internal.PMX]))
+	assert.NoError(t, test.LoadDB[*batchv1.Job](ctx, l.DB, "batch/job/1.yaml", test.Glossary[internal.JOB]))
+	assert.NoError(t, test.LoadDB[*v1.ServiceAccount](ctx, l.DB, "core/sa/1.yaml", test.Glossary[internal.SA]))
+	assert.NoError(t, test.LoadDB[*v1.Pod](ctx, l.DB, "core/pod/1.yaml", test.Glossary[internal.PO]))
+	assert.NoError(t, test.LoadDB[*mv1beta1.PodMetrics](ctx, l.DB, "mx/pod/1.yaml", test.Glossary[internal.PMX]))
 
 	j := NewJob(test.MakeCollector(t), dba)
 	assert.Nil(t, j.Lint(test.MakeContext("batch/v1/jobs", "jobs")))
 	assert.Equal(t, 3, len(j.Outcome()))
 
 	ii := j.Outcome()["default/j1"]
-	assert.Equal(t, 0, len(ii))
+	assert.Equal(t, 1, len(ii))
+	assert.Equal(t, `[POP-119] Container "c1" root filesystem is writable. Consider setting readOnlyRootFilesystem`, ii[0].Message)
+	assert.Equal(t, rules.InfoLevel, ii[0].Level)
 
 	ii = j.Outcome()["default/j2"]
-	assert.Equal(t, 2, len(ii))
+	assert.Equal(t, 3, len(ii))
 	assert.Equal(t, `[POP-100] Untagged docker image in use`, ii[0].Message)
 	assert.Equal(t, `[POP-106] No resources requests/limits defined`, ii[1].Message)
 	assert.Equal(t, rules.WarnLevel, ii[1].Level)
+	assert.Equal(t, `[POP-119] Container "c1" root filesystem is writable. Consider setting readOnlyRootFilesystem`, ii[2].Message)
+}
+
+func TestJobCheckActiveDeadline(t *testing.T) {
+	deadline := func(n int64) *int64 { return &n }
+	uu := map[string]struct {
+		spec   batchv1.JobSpec
+		issues []string
+	}{
+		"deadline": {
+			spec: batchv1.JobSpec{ActiveDeadlineSeconds: deadline(600)},
+		},
+		"no-deadline": {
+			issues: []string{
+				`[POP-1504] No activeDeadlineSeconds set. Runaway jobs may run forever`,
+			},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			co := test.MakeCollector(t)
+			co.InitOutcome("default/j1")
+			ctx := test.MakeContext("batch/v1/jobs", "jobs")
+			ctx = internal.WithSpec(ctx, SpecFor("default/j1", nil))
+			checkActiveDeadline(ctx, co, u.spec)
+
+			ii := co.Outcome()["default/j1"]
+			assert.Equal(t, len(u.issues), len(ii))
+			for i, m := range u.issues {
+				assert.Equal(t, m, ii[i].Message)
+			}
+		})
+	}
+}
+
+func TestJobOwnedByCronJob(t *testing.T) {
+	uu := map[string]struct {
+		owners []metav1.OwnerReference
+		e      bool
+	}{
+		"none": {},
+		"cronjob": {
+			owners: []metav1.OwnerReference{{Kind: "CronJob", Name: "cj1"}},
+			e:      true,
+		},
+		"other": {
+			owners: []metav1.OwnerReference{{Kind: "Workflow", Name: "w1"}},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			j := batchv1.Job{ObjectMeta: metav1.ObjectMeta{OwnerReferences: u.owners}}
+			assert.Equal(t, u.e, ownedByCronJob(&j))
+		})
+	}
 }
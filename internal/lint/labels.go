@@ -0,0 +1,30 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Popeye
+
+package lint
+
+import (
+	"context"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// checkRecommendedLabels flags workloads missing recommended labels or whose
+// pod template disagrees with the workload on a recommended label value.
+func checkRecommendedLabels(ctx context.Context, c Collector, keys []string, meta, tpl metav1.ObjectMeta) {
+	var missing []string
+	for _, k := range keys {
+		v, ok := meta.Labels[k]
+		if !ok {
+			missing = append(missing, k)
+			continue
+		}
+		if tv, ok := tpl.Labels[k]; ok && tv != v {
+			c.AddCode(ctx, 514, k, v, tv)
+		}
+	}
+	if len(missing) > 0 {
+		c.AddCode(ctx, 513, strings.Join(missing, ", "))
+	}
+}
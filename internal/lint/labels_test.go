@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Popeye
+
+package lint
+
+import (
+	"testing"
+
+	"github.com/derailed/popeye/internal"
+	"github.com/derailed/popeye/internal/rules"
+	"github.com/derailed/popeye/internal/test"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestCheckRecommendedLabels(t *testing.T) {
+	keys := []string{"app.kubernetes.io/name", "app.kubernetes.io/instance", "app.kubernetes.io/version"}
+	full := map[string]string{
+		"app.kubernetes.io/name":     "blee",
+		"app.kubernetes.io/instance": "blee-1",
+		"app.kubernetes.io/version":  "1.0.0",
+	}
+	uu := map[string]struct {
+		keys   []string
+		labels map[string]string
+		tpl    map[string]string
+		ee     []string
+		ll     []rules.Level
+	}{
+		"fully-labeled": {
+			keys:   keys,
+			labels: full,
+			tpl:    full,
+		},
+		"partially-labeled": {
+			keys:   keys,
+			labels: map[string]string{"app.kubernetes.io/name": "blee"},
+			ee:     []string{"[POP-513] Missing recommended label(s): app.kubernetes.io/instance, app.kubernetes.io/version"},
+			ll:     []rules.Level{rules.InfoLevel},
+		},
+		"inconsistent": {
+			keys:   keys,
+			labels: full,
+			tpl: map[string]string{
+				"app.kubernetes.io/name":    "blee",
+				"app.kubernetes.io/version": "1.1.0",
+			},
+			ee: []string{`[POP-514] Label "app.kubernetes.io/version" differs between workload ("1.0.0") and pod template ("1.1.0")`},
+			ll: []rules.Level{rules.WarnLevel},
+		},
+		"custom-subset": {
+			keys:   []string{"app.kubernetes.io/name"},
+			labels: map[string]string{"app.kubernetes.io/name": "blee"},
+		},
+		"disabled": {},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			co := test.MakeCollector(t)
+			co.InitOutcome("default/dp1")
+			ctx := test.MakeContext("apps/v1/deployments", "deployments")
+			ctx = internal.WithSpec(ctx, SpecFor("default/dp1", nil))
+			checkRecommendedLabels(ctx, co, u.keys, metav1.ObjectMeta{Labels: u.labels}, metav1.ObjectMeta{Labels: u.tpl})
+
+			ii := co.Outcome()["default/dp1"]
+			assert.Equal(t, len(u.ee), len(ii))
+			for i, e := range u.ee {
+				assert.Equal(t, e, ii[i].Message)
+				assert.Equal(t, u.ll[i], ii[i].Level)
+			}
+		})
+	}
+}
@@ -0,0 +1,50 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Popeye
+
+package lint
+
+import (
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// namedPorts tracks declared container port names and their containers.
+type namedPorts map[string]string
+
+// namedPortsFor indexes the named ports declared by the given containers.
+func namedPortsFor(cc ...v1.Container) namedPorts {
+	nn := make(namedPorts)
+	for _, co := range cc {
+		for _, p := range co.Ports {
+			if p.Name != "" {
+				nn[p.Name] = co.Name
+			}
+		}
+	}
+
+	return nn
+}
+
+// dangling checks if a port references a name that is not declared.
+func (nn namedPorts) dangling(port intstr.IntOrString) bool {
+	if port.Type != intstr.String || port.StrVal == "" {
+		return false
+	}
+	_, ok := nn[port.StrVal]
+
+	return !ok
+}
+
+// probePort returns the port targeted by a probe if any.
+func probePort(p *v1.Probe) (intstr.IntOrString, bool) {
+	switch {
+	case p == nil:
+		return intstr.IntOrString{}, false
+	case p.ProbeHandler.HTTPGet != nil:
+		return p.ProbeHandler.HTTPGet.Port, true
+	case p.ProbeHandler.TCPSocket != nil:
+		return p.ProbeHandler.TCPSocket.Port, true
+	default:
+		return intstr.IntOrString{}, false
+	}
+}
@@ -42,7 +42,7 @@ func (n *Node) Lint(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
-	txn, it := n.db.MustITFor(internal.Glossary[internal.NO])
+	txn, it := n.db.MustITFor(n.db.GVR(internal.NO))
 	defer txn.Abort()
 	for o := it.Next(); o != nil; o = it.Next() {
 		no := o.(*v1.Node)
@@ -55,6 +55,7 @@ func (n *Node) Lint(ctx context.Context) error {
 			n.AddErr(ctx, err)
 		}
 		n.checkUtilization(ctx, nmx[fqn])
+		runChecks(ctx, internal.NO, n, no)
 	}
 
 	return nil
@@ -72,7 +73,7 @@ func (n *Node) checkTaints(ctx context.Context, taints []v1.Taint, tt toleration
 
 func (n *Node) fetchPodTolerations() (tolerations, error) {
 	tt := make(tolerations)
-	txn, it := n.db.MustITFor(internal.Glossary[internal.PO])
+	txn, it := n.db.MustITFor(n.db.GVR(internal.PO))
 	defer txn.Abort()
 
 	for o := it.Next(); o != nil; o = it.Next() {
@@ -149,7 +150,7 @@ func (n *Node) nodesMetrics(nmx client.NodesMetrics) {
 		return
 	}
 
-	txn, it := n.db.MustITFor(internal.Glossary[internal.NO])
+	txn, it := n.db.MustITFor(n.db.GVR(internal.NO))
 	defer txn.Abort()
 	for o := it.Next(); o != nil; o = it.Next() {
 		no := o.(*v1.Node)
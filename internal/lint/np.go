@@ -46,7 +46,7 @@ func NewNetworkPolicy(co *issues.Collector, db *db.DB) *NetworkPolicy {
 
 // Lint cleanse the resource.
 func (s *NetworkPolicy) Lint(ctx context.Context) error {
-	txn, it := s.db.MustITFor(internal.Glossary[internal.NP])
+	txn, it := s.db.MustITFor(s.db.GVR(internal.NP))
 	defer txn.Abort()
 	for o := it.Next(); o != nil; o = it.Next() {
 		np := o.(*netv1.NetworkPolicy)
@@ -58,6 +58,7 @@ func (s *NetworkPolicy) Lint(ctx context.Context) error {
 		s.checkIngresses(ctx, fqn, np.Spec.Ingress)
 		s.checkEgresses(ctx, fqn, np.Spec.Egress)
 		s.checkRuleType(ctx, fqn, &np.Spec)
+		runChecks(ctx, internal.NP, s, np)
 	}
 
 	return nil
@@ -175,7 +176,7 @@ func (s *NetworkPolicy) matchPips(ns string, ipnet *net.IPNet) bool {
 	if ipnet == nil {
 		return false
 	}
-	txn, it := s.db.MustITForNS(internal.Glossary[internal.PO], ns)
+	txn, it := s.db.MustITForNS(s.db.GVR(internal.PO), ns)
 	defer txn.Abort()
 	for o := it.Next(); o != nil; o = it.Next() {
 		po := o.(*v1.Pod)
@@ -37,6 +37,16 @@ func isDenyAllIngress(spec *netv1.NetworkPolicySpec) bool {
 	return noPodSel(spec) && spec.Ingress == nil && polInclude(spec.PolicyTypes, dirIn)
 }
 
+// isDefaultDenyIngress checks if a policy denies all ingress traffic to all pods
+// in its namespace. Policies without types default to ingress.
+func isDefaultDenyIngress(spec *netv1.NetworkPolicySpec) bool {
+	if !noPodSel(spec) || len(spec.Ingress) > 0 {
+		return false
+	}
+
+	return len(spec.PolicyTypes) == 0 || polInclude(spec.PolicyTypes, dirIn)
+}
+
 func isDenyAllEgress(spec *netv1.NetworkPolicySpec) bool {
 	return noPodSel(spec) && spec.Egress == nil && polInclude(spec.PolicyTypes, dirOut)
 }
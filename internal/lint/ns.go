@@ -14,6 +14,7 @@ import (
 	"github.com/derailed/popeye/internal/db"
 	"github.com/derailed/popeye/internal/issues"
 	v1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
 )
 
 // Namespace represents a Namespace linter.
@@ -37,7 +38,7 @@ func (s *Namespace) Lint(ctx context.Context) error {
 	if err := s.ReferencedNamespaces(used); err != nil {
 		s.AddErr(ctx, err)
 	}
-	txn, it := s.db.MustITFor(internal.Glossary[internal.NS])
+	txn, it := s.db.MustITFor(s.db.GVR(internal.NS))
 	defer txn.Abort()
 
 	cns, ok := ctx.Value(internal.KeyNamespace).(string)
@@ -61,7 +62,9 @@ func (s *Namespace) Lint(ctx context.Context) error {
 			if _, ok := used[fqn]; !ok {
 				s.AddCode(ctx, 400)
 			}
+			s.checkDefaultDeny(ctx, fqn)
 		}
+		runChecks(ctx, internal.NS, s, ns)
 	}
 
 	return nil
@@ -96,6 +99,28 @@ func (s *Namespace) checkActive(ctx context.Context, p v1.NamespacePhase) bool {
 	return true
 }
 
+// checkDefaultDeny flags namespaces running pods without a default deny ingress policy.
+func (s *Namespace) checkDefaultDeny(ctx context.Context, ns string) {
+	if !s.hasPods(ns) {
+		return
+	}
+	txn, it := s.db.MustITForNS(s.db.GVR(internal.NP), ns)
+	defer txn.Abort()
+	for o := it.Next(); o != nil; o = it.Next() {
+		if np, ok := o.(*netv1.NetworkPolicy); ok && isDefaultDenyIngress(&np.Spec) {
+			return
+		}
+	}
+	s.AddCode(ctx, 801)
+}
+
+func (s *Namespace) hasPods(ns string) bool {
+	txn, it := s.db.MustITForNS(s.db.GVR(internal.PO), ns)
+	defer txn.Abort()
+
+	return it.Next() != nil
+}
+
 // ----------------------------------------------------------------------------
 // Helpers...
 
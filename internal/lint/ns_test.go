@@ -12,6 +12,8 @@ import (
 	"github.com/derailed/popeye/internal/test"
 	"github.com/stretchr/testify/assert"
 	v1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func TestNSSanitizer(t *testing.T) {
@@ -19,15 +21,17 @@ func TestNSSanitizer(t *testing.T) {
 	assert.NoError(t, err)
 	l := db.NewLoader(dba)
 	ctx := test.MakeCtx(t)
-	assert.NoError(t, test.LoadDB[*v1.Namespace](ctx, l.DB, "core/ns/1.yaml", internal.Glossary[internal.NS]))
-	assert.NoError(t, test.LoadDB[*v1.Pod](ctx, l.DB, "core/pod/1.yaml", internal.Glossary[internal.PO]))
+	assert.NoError(t, test.LoadDB[*v1.Namespace](ctx, l.DB, "core/ns/1.yaml", test.Glossary[internal.NS]))
+	assert.NoError(t, test.LoadDB[*v1.Pod](ctx, l.DB, "core/pod/1.yaml", test.Glossary[internal.PO]))
 
 	ns := NewNamespace(test.MakeCollector(t), dba)
 	assert.Nil(t, ns.Lint(test.MakeContext("v1/namespaces", "ns")))
 	assert.Equal(t, 3, len(ns.Outcome()))
 
 	ii := ns.Outcome()["default"]
-	assert.Equal(t, 0, len(ii))
+	assert.Equal(t, 1, len(ii))
+	assert.Equal(t, "[POP-801] No default deny ingress NetworkPolicy. Pods accept traffic from anywhere", ii[0].Message)
+	assert.Equal(t, rules.WarnLevel, ii[0].Level)
 
 	ii = ns.Outcome()["ns1"]
 	assert.Equal(t, 1, len(ii))
@@ -39,3 +43,80 @@ func TestNSSanitizer(t *testing.T) {
 	assert.Equal(t, "[POP-800] Namespace is inactive", ii[0].Message)
 	assert.Equal(t, rules.ErrorLevel, ii[0].Level)
 }
+
+func TestNSCheckDefaultDeny(t *testing.T) {
+	dba, err := test.NewTestDB()
+	assert.NoError(t, err)
+	l := db.NewLoader(dba)
+	ctx := test.MakeCtx(t)
+	assert.NoError(t, test.LoadDB[*v1.Namespace](ctx, l.DB, "core/ns/1.yaml", test.Glossary[internal.NS]))
+	assert.NoError(t, test.LoadDB[*v1.Pod](ctx, l.DB, "core/pod/1.yaml", test.Glossary[internal.PO]))
+	assert.NoError(t, test.LoadDB[*v1.Pod](ctx, l.DB, "core/pod/3.yaml", test.Glossary[internal.PO]))
+	assert.NoError(t, test.LoadDB[*netv1.NetworkPolicy](ctx, l.DB, "net/np/3.yaml", test.Glossary[internal.NP]))
+
+	uu := map[string]struct {
+		ns     string
+		issues int
+	}{
+		"no-deny": {
+			ns:     "default",
+			issues: 1,
+		},
+		"deny": {
+			ns: "ns1",
+		},
+		"no-pods": {
+			ns: "ns3",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ns := NewNamespace(test.MakeCollector(t), dba)
+			ctx := test.MakeContext("v1/namespaces", "ns")
+			ctx = internal.WithSpec(ctx, SpecFor(u.ns, nil))
+			ns.checkDefaultDeny(ctx, u.ns)
+
+			ii := ns.Outcome()[u.ns]
+			assert.Equal(t, u.issues, len(ii))
+			if u.issues > 0 {
+				assert.Equal(t, "[POP-801] No default deny ingress NetworkPolicy. Pods accept traffic from anywhere", ii[0].Message)
+				assert.Equal(t, rules.WarnLevel, ii[0].Level)
+			}
+		})
+	}
+}
+
+func TestIsDefaultDenyIngress(t *testing.T) {
+	uu := map[string]struct {
+		spec netv1.NetworkPolicySpec
+		e    bool
+	}{
+		"no-types": {
+			e: true,
+		},
+		"ingress": {
+			spec: netv1.NetworkPolicySpec{PolicyTypes: []netv1.PolicyType{netv1.PolicyTypeIngress}},
+			e:    true,
+		},
+		"egress-only": {
+			spec: netv1.NetworkPolicySpec{PolicyTypes: []netv1.PolicyType{netv1.PolicyTypeEgress}},
+		},
+		"allow-all": {
+			spec: netv1.NetworkPolicySpec{Ingress: []netv1.NetworkPolicyIngressRule{{}}},
+		},
+		"pod-selector": {
+			spec: netv1.NetworkPolicySpec{
+				PodSelector: metav1.LabelSelector{MatchLabels: map[string]string{"app": "p1"}},
+			},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, isDefaultDenyIngress(&u.spec))
+		})
+	}
+}
@@ -30,7 +30,7 @@ func NewPodDisruptionBudget(c *issues.Collector, db *db.DB) *PodDisruptionBudget
 
 // Lint cleanse the resource.
 func (p *PodDisruptionBudget) Lint(ctx context.Context) error {
-	txn, it := p.db.MustITFor(internal.Glossary[internal.PDB])
+	txn, it := p.db.MustITFor(p.db.GVR(internal.PDB))
 	defer txn.Abort()
 	for o := it.Next(); o != nil; o = it.Next() {
 		pdb := o.(*polv1.PodDisruptionBudget)
@@ -39,6 +39,7 @@ func (p *PodDisruptionBudget) Lint(ctx context.Context) error {
 		ctx = internal.WithSpec(ctx, SpecFor(fqn, pdb))
 
 		p.checkInUse(ctx, pdb)
+		runChecks(ctx, internal.PDB, p, pdb)
 	}
 
 	return nil
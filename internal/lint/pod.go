@@ -7,8 +7,10 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"slices"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/derailed/popeye/internal"
 	"github.com/derailed/popeye/internal/cache"
@@ -16,21 +18,28 @@ import (
 	"github.com/derailed/popeye/internal/db"
 	"github.com/derailed/popeye/internal/issues"
 	"github.com/derailed/popeye/types"
-	"github.com/rs/zerolog/log"
 	v1 "k8s.io/api/core/v1"
 	netv1 "k8s.io/api/networking/v1"
 	policyv1 "k8s.io/api/policy/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/duration"
 	mv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
 )
 
+const (
+	mirrorPodAnnotation       = "kubernetes.io/config.mirror"
+	staticPodSourceAnnotation = "kubernetes.io/config.source"
+)
+
 type (
 	// Pod represents a Pod linter.
 	Pod struct {
 		*issues.Collector
 
-		db *db.DB
+		db    *db.DB
+		nodes map[string]*v1.Node
+		alloc v1.ResourceList
 	}
 
 	// PodMetric tracks pod metrics available and current range.
@@ -51,10 +60,15 @@ func NewPod(co *issues.Collector, db *db.DB) *Pod {
 
 // Lint cleanse the resource..
 func (s *Pod) Lint(ctx context.Context) error {
-	txn, it := s.db.MustITFor(internal.Glossary[internal.PO])
+	s.nodes = listNodes(s.db)
+	s.alloc = allocatableOf(s.nodes)
+	txn, it := s.db.MustITFor(s.db.GVR(internal.PO))
 	defer txn.Abort()
 	for o := it.Next(); o != nil; o = it.Next() {
 		po := o.(*v1.Pod)
+		if s.Exclusions.MatchOwnerKind(ownerKinds(po.OwnerReferences)...) {
+			continue
+		}
 		fqn := client.FQN(po.Namespace, po.Name)
 		s.InitOutcome(fqn)
 		defer s.CloseOutcome(ctx, fqn, nil)
@@ -64,25 +78,313 @@ func (s *Pod) Lint(ctx context.Context) error {
 		s.checkContainerStatus(ctx, fqn, po)
 		s.checkContainers(ctx, fqn, po)
 		s.checkOwnedByAnything(ctx, po.OwnerReferences)
+		s.checkOrphanAge(ctx, po)
+		s.checkSchedulingGates(ctx, po)
 		s.checkNPs(ctx, po)
 		if !ownedByDaemonSet(po) {
 			s.checkPdb(ctx, po.ObjectMeta.Labels)
 		}
 		s.checkForMultiplePdbMatches(ctx, po.Namespace, po.ObjectMeta.Labels)
 		s.checkSecure(ctx, fqn, po.Spec)
+		s.checkSidecar(ctx, po)
+		s.checkContainersCount(ctx, po.Spec)
+		s.checkVolumesCount(ctx, po.Spec)
+		s.checkInitChain(ctx, po.Spec)
+		s.checkPriorityClass(ctx, po)
+		s.checkRuntimeClass(ctx, po.Spec)
+		s.checkSchedulable(ctx, po.Spec, s.alloc)
+		s.checkPullSecrets(ctx, po)
+		s.checkVolumeRefs(ctx, po)
+		s.checkKeyRefs(ctx, po)
+		s.checkDownwardAPI(ctx, po.Spec)
+		s.checkQoS(ctx, po)
+		s.checkPreStop(ctx, po)
+		s.checkServiceReadiness(ctx, po)
+		s.checkGracePeriod(ctx, po.Spec)
+		s.checkEphemeralStorage(ctx, po.Spec)
+		s.checkControlPlane(ctx, po)
+		s.checkTolerations(ctx, po)
+		s.checkContainerNames(ctx, po.Spec)
+		s.checkPortConflicts(ctx, po.Spec)
+		s.checkImageDigests(ctx, po)
+		s.checkImageScans(ctx, po)
+		s.checkSpreadScheduling(ctx, po.Status)
+		s.checkNodeConstraints(ctx, po.Spec, s.nodes)
+		s.checkAffinityLabels(ctx, po.Spec, s.nodes)
+		s.checkArch(ctx, po, s.nodes)
+		s.checkResourceClaims(ctx, po)
+		s.checkDeprecatedFields(ctx, po.Spec)
 
-		pmx, err := s.db.FindPMX(fqn)
-		if err != nil {
-			continue
+		if pmx, err := s.db.FindPMX(fqn); err == nil {
+			cmx := make(client.ContainerMetrics)
+			containerMetrics(pmx, cmx)
+			s.checkUtilization(ctx, fqn, po, cmx)
 		}
-		cmx := make(client.ContainerMetrics)
-		containerMetrics(pmx, cmx)
-		s.checkUtilization(ctx, fqn, po, cmx)
+		runChecks(ctx, internal.PO, s, po)
 	}
 
 	return nil
 }
 
+// checkPreStop flags service backed containers without a preStop hook.
+func (s *Pod) checkPreStop(ctx context.Context, po *v1.Pod) {
+	if ownedByJob(po) {
+		return
+	}
+	svc, ok := s.frontingService(po)
+	if !ok {
+		return
+	}
+	for _, co := range po.Spec.Containers {
+		if co.Lifecycle != nil && co.Lifecycle.PreStop != nil {
+			continue
+		}
+		s.AddSubCode(internal.WithGroup(ctx, types.NewGVR("containers"), co.Name), 124, co.Name, svc)
+	}
+}
+
+// checkServiceReadiness flags service backed containers without a readiness probe.
+func (s *Pod) checkServiceReadiness(ctx context.Context, po *v1.Pod) {
+	if ownedByJob(po) {
+		return
+	}
+	svc, ok := s.frontingService(po)
+	if !ok {
+		return
+	}
+	for _, co := range po.Spec.Containers {
+		if co.ReadinessProbe != nil {
+			continue
+		}
+		s.AddSubCode(internal.WithGroup(ctx, types.NewGVR("containers"), co.Name), 133, co.Name, svc)
+	}
+}
+
+// checkContainerNames flags container names reused within or across
+// init and regular containers.
+func (s *Pod) checkContainerNames(ctx context.Context, spec v1.PodSpec) {
+	inits := make(map[string]struct{}, len(spec.InitContainers))
+	for _, n := range duplicateNames(spec.InitContainers, inits) {
+		s.AddCode(ctx, 224, n)
+	}
+	names := make(map[string]struct{}, len(spec.Containers))
+	for _, n := range duplicateNames(spec.Containers, names) {
+		s.AddCode(ctx, 224, n)
+	}
+	for _, co := range spec.Containers {
+		if _, ok := inits[co.Name]; ok {
+			s.AddCode(ctx, 223, co.Name)
+			delete(inits, co.Name)
+		}
+	}
+}
+
+// checkPortConflicts flags containers declaring the same port and protocol.
+// Regular init containers run to completion first and are skipped.
+func (s *Pod) checkPortConflicts(ctx context.Context, spec v1.PodSpec) {
+	cc := make([]v1.Container, 0, len(spec.InitContainers)+len(spec.Containers))
+	for _, co := range spec.InitContainers {
+		if restartableInitCO(co.RestartPolicy) {
+			cc = append(cc, co)
+		}
+	}
+	cc = append(cc, spec.Containers...)
+
+	owners := make(map[string]string)
+	for _, co := range cc {
+		for _, p := range co.Ports {
+			proto := p.Protocol
+			if proto == "" {
+				proto = v1.ProtocolTCP
+			}
+			key := fmt.Sprintf("%d/%s", p.ContainerPort, proto)
+			first, ok := owners[key]
+			switch {
+			case !ok:
+				owners[key] = co.Name
+			case first != co.Name:
+				s.AddCode(ctx, 231, key, first, co.Name)
+			}
+		}
+	}
+}
+
+// duplicateNames records container names and returns the ones seen more than once.
+func duplicateNames(cc []v1.Container, names map[string]struct{}) []string {
+	var dups []string
+	for _, co := range cc {
+		if _, ok := names[co.Name]; ok {
+			if !slices.Contains(dups, co.Name) {
+				dups = append(dups, co.Name)
+			}
+			continue
+		}
+		names[co.Name] = struct{}{}
+	}
+
+	return dups
+}
+
+// systemNamespaces tracks namespaces expected to run on control plane nodes.
+var systemNamespaces = map[string]struct{}{
+	"kube-system":     {},
+	"kube-public":     {},
+	"kube-node-lease": {},
+}
+
+// controlPlaneRoles tracks node labels identifying control plane nodes.
+var controlPlaneRoles = []string{
+	"node-role.kubernetes.io/control-plane",
+	"node-role.kubernetes.io/master",
+}
+
+// checkControlPlane flags workload pods landing on control plane nodes.
+func (s *Pod) checkControlPlane(ctx context.Context, po *v1.Pod) {
+	if po.Spec.NodeName == "" || ownedByDaemonSet(po) {
+		return
+	}
+	if _, ok := systemNamespaces[po.Namespace]; ok {
+		return
+	}
+	o, err := s.db.Find(s.db.GVR(internal.NO), po.Spec.NodeName)
+	if err != nil {
+		return
+	}
+	no, ok := o.(*v1.Node)
+	if !ok {
+		return
+	}
+	for _, r := range controlPlaneRoles {
+		if _, ok := no.Labels[r]; ok {
+			s.AddCode(ctx, 222, no.Name)
+			return
+		}
+	}
+}
+
+// checkEphemeralStorage flags containers writing to emptyDir volumes without
+// bounding their ephemeral storage usage.
+func (s *Pod) checkEphemeralStorage(ctx context.Context, spec v1.PodSpec) {
+	dirs := make(map[string]struct{})
+	for _, v := range spec.Volumes {
+		if v.EmptyDir == nil || v.EmptyDir.Medium == v1.StorageMediumMemory {
+			continue
+		}
+		dirs[v.Name] = struct{}{}
+	}
+	if len(dirs) == 0 {
+		return
+	}
+
+	for _, cc := range [][]v1.Container{spec.InitContainers, spec.Containers} {
+		for _, co := range cc {
+			if _, ok := co.Resources.Limits[v1.ResourceEphemeralStorage]; ok {
+				continue
+			}
+			if dir, ok := emptyDirMount(co, dirs); ok {
+				s.AddSubCode(internal.WithGroup(ctx, types.NewGVR("containers"), co.Name), 125, co.Name, dir)
+			}
+		}
+	}
+}
+
+// emptyDirMount returns the first writable emptyDir volume mounted by a container.
+func emptyDirMount(co v1.Container, dirs map[string]struct{}) (string, bool) {
+	for _, m := range co.VolumeMounts {
+		if _, ok := dirs[m.Name]; ok && !m.ReadOnly {
+			return m.Name, true
+		}
+	}
+
+	return "", false
+}
+
+// frontingService returns the name of a service routing traffic to the pod if any.
+func (s *Pod) frontingService(po *v1.Pod) (string, bool) {
+	txn, it := s.db.MustITForNS(s.db.GVR(internal.SVC), po.Namespace)
+	defer txn.Abort()
+	for o := it.Next(); o != nil; o = it.Next() {
+		svc := o.(*v1.Service)
+		if svc.Spec.Type == v1.ServiceTypeExternalName {
+			continue
+		}
+		if cache.MatchLabels(po.Labels, svc.Spec.Selector) {
+			return svc.Name, true
+		}
+	}
+
+	return "", false
+}
+
+func ownedByJob(po *v1.Pod) bool {
+	for _, o := range po.OwnerReferences {
+		if o.Kind == "Job" {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s *Pod) checkQoS(ctx context.Context, po *v1.Pod) {
+	if podQoS(po.Spec) == v1.PodQOSBestEffort {
+		s.AddCode(ctx, 220)
+	}
+}
+
+// podQoS computes a pod QoS class based on its containers cpu/mem requests and limits.
+func podQoS(spec v1.PodSpec) v1.PodQOSClass {
+	var (
+		bounded    bool
+		guaranteed = true
+	)
+	cc := make([]v1.Container, 0, len(spec.InitContainers)+len(spec.Containers))
+	cc = append(cc, spec.InitContainers...)
+	for _, co := range append(cc, spec.Containers...) {
+		req, lim := co.Resources.Requests, co.Resources.Limits
+		for _, r := range []v1.ResourceName{v1.ResourceCPU, v1.ResourceMemory} {
+			rq, okr := req[r]
+			l, okl := lim[r]
+			if okr || okl {
+				bounded = true
+			}
+			if !okl || (okr && rq.Cmp(l) != 0) {
+				guaranteed = false
+			}
+		}
+	}
+
+	switch {
+	case !bounded:
+		return v1.PodQOSBestEffort
+	case guaranteed:
+		return v1.PodQOSGuaranteed
+	default:
+		return v1.PodQOSBurstable
+	}
+}
+
+// checkTolerations flags tolerations matching every taint. System daemons
+// and static pods are expected to run on every node and are exempt.
+func (s *Pod) checkTolerations(ctx context.Context, po *v1.Pod) {
+	if _, ok := systemNamespaces[po.Namespace]; ok && (ownedByDaemonSet(po) || isStaticPod(po)) {
+		return
+	}
+	for _, t := range po.Spec.Tolerations {
+		if t.Key != "" || t.Operator != v1.TolerationOpExists {
+			continue
+		}
+		if t.Effect != "" && t.Effect != v1.TaintEffectNoExecute {
+			continue
+		}
+		tol := "*:" + string(t.Operator)
+		if t.Effect != "" {
+			tol += ":" + string(t.Effect)
+		}
+		s.AddCode(ctx, 239, tol)
+	}
+}
+
 func ownedByDaemonSet(po *v1.Pod) bool {
 	for _, o := range po.OwnerReferences {
 		if o.Kind == "DaemonSet" {
@@ -93,7 +395,7 @@ func ownedByDaemonSet(po *v1.Pod) bool {
 }
 
 func (s *Pod) checkNPs(ctx context.Context, pod *v1.Pod) {
-	txn, it := s.db.MustITForNS(internal.Glossary[internal.NP], pod.Namespace)
+	txn, it := s.db.MustITForNS(s.db.GVR(internal.NP), pod.Namespace)
 	defer txn.Abort()
 
 	matches := [2]int{}
@@ -230,6 +532,335 @@ func labelsMatch(sel *metav1.LabelSelector, ll map[string]string) bool {
 	return db.MatchSelector(ll, sel)
 }
 
+func (s *Pod) checkContainersCount(ctx context.Context, spec v1.PodSpec) {
+	if l := s.MaxContainersLimit(); len(spec.Containers) > l {
+		s.AddCode(ctx, 211, len(spec.Containers), l)
+	}
+}
+
+// checkVolumesCount flags pods with volume or volume mount sprawl.
+func (s *Pod) checkVolumesCount(ctx context.Context, spec v1.PodSpec) {
+	if l := s.MaxVolumesLimit(); len(spec.Volumes) > l {
+		s.AddCode(ctx, 233, len(spec.Volumes), l)
+	}
+	l := s.MaxVolumeMountsLimit()
+	for _, cc := range [][]v1.Container{spec.InitContainers, spec.Containers} {
+		for _, co := range cc {
+			if n := len(co.VolumeMounts); n > l {
+				s.AddSubCode(internal.WithGroup(ctx, types.NewGVR("containers"), co.Name), 234, co.Name, n, l)
+			}
+		}
+	}
+}
+
+// checkInitChain flags pods running too many init containers serially.
+// Restartable sidecars do not run to completion and are not counted.
+func (s *Pod) checkInitChain(ctx context.Context, spec v1.PodSpec) {
+	nn := make([]string, 0, len(spec.InitContainers))
+	for _, co := range spec.InitContainers {
+		if !restartableInitCO(co.RestartPolicy) {
+			nn = append(nn, co.Name)
+		}
+	}
+	if l := s.MaxInitContainersLimit(); len(nn) > l {
+		s.AddCode(ctx, 241, len(nn), strings.Join(nn, ", "), l)
+	}
+}
+
+func (s *Pod) checkSidecar(ctx context.Context, po *v1.Pod) {
+	sc := s.PodSidecar()
+	if !sc.IsSet() {
+		return
+	}
+	o, err := s.db.Find(s.db.GVR(internal.NS), po.Namespace)
+	if err != nil {
+		return
+	}
+	ns, ok := o.(*v1.Namespace)
+	if !ok || !cache.MatchLabels(ns.Labels, sc.NamespaceLabels) {
+		return
+	}
+	if !hasContainer(po.Spec, sc.Container) {
+		s.AddCode(ctx, 210, sc.Container)
+	}
+}
+
+func (s *Pod) checkPriorityClass(ctx context.Context, po *v1.Pod) {
+	if po.Spec.PriorityClassName == "" {
+		if isWorkloadPod(po) && s.inProduction(po.Namespace) {
+			s.AddCode(ctx, 214)
+		}
+		return
+	}
+	pcs, err := s.db.ListPriorityClasses()
+	if err != nil || pcs == nil {
+		return
+	}
+	if _, ok := pcs[po.Spec.PriorityClassName]; !ok {
+		s.AddCode(ctx, 213, po.Spec.PriorityClassName)
+	}
+}
+
+func (s *Pod) checkPullSecrets(ctx context.Context, po *v1.Pod) {
+	for _, ref := range po.Spec.ImagePullSecrets {
+		sfqn := cache.FQN(po.Namespace, ref.Name)
+		if !s.db.Exists(s.db.GVR(internal.SEC), sfqn) {
+			s.AddCode(ctx, 216, sfqn)
+		}
+	}
+	if len(po.Spec.ImagePullSecrets) > 0 || s.saHasPullSecrets(po) {
+		return
+	}
+	cc := append(append([]v1.Container{}, po.Spec.InitContainers...), po.Spec.Containers...)
+	for _, co := range cc {
+		if r := imageRegistry(co.Image); r != defaultRegistry {
+			s.AddCode(ctx, 217, co.Image, r)
+		}
+	}
+}
+
+func (s *Pod) checkVolumeRefs(ctx context.Context, po *v1.Pod) {
+	for _, v := range po.Spec.Volumes {
+		switch {
+		case v.ConfigMap != nil:
+			s.checkVolumeRef(ctx, internal.CM, po.Namespace, v.ConfigMap.Name, v.ConfigMap.Optional)
+		case v.Secret != nil:
+			s.checkVolumeRef(ctx, internal.SEC, po.Namespace, v.Secret.SecretName, v.Secret.Optional)
+		case v.Projected != nil:
+			for _, src := range v.Projected.Sources {
+				if src.ConfigMap != nil {
+					s.checkVolumeRef(ctx, internal.CM, po.Namespace, src.ConfigMap.Name, src.ConfigMap.Optional)
+				}
+				if src.Secret != nil {
+					s.checkVolumeRef(ctx, internal.SEC, po.Namespace, src.Secret.Name, src.Secret.Optional)
+				}
+			}
+		}
+	}
+}
+
+func (s *Pod) checkVolumeRef(ctx context.Context, r internal.R, ns, n string, optional *bool) {
+	fqn := cache.FQN(ns, n)
+	if s.db.Exists(s.db.GVR(r), fqn) {
+		return
+	}
+	kind := "ConfigMap"
+	if r == internal.SEC {
+		kind = "Secret"
+	}
+	if optional != nil && *optional {
+		s.AddCode(ctx, 219, kind, fqn)
+		return
+	}
+	s.AddCode(ctx, 218, kind, fqn)
+}
+
+// checkKeyRefs flags env vars sourcing keys missing from existing configmaps
+// or secrets. Missing objects are left to the volume and configmap linters.
+func (s *Pod) checkKeyRefs(ctx context.Context, po *v1.Pod) {
+	cc := make([]v1.Container, 0, len(po.Spec.InitContainers)+len(po.Spec.Containers))
+	cc = append(cc, po.Spec.InitContainers...)
+	cc = append(cc, po.Spec.Containers...)
+	for _, co := range cc {
+		cctx := internal.WithGroup(ctx, types.NewGVR("containers"), co.Name)
+		for _, e := range co.Env {
+			switch {
+			case e.ValueFrom == nil:
+			case e.ValueFrom.ConfigMapKeyRef != nil:
+				ref := e.ValueFrom.ConfigMapKeyRef
+				s.checkKeyRef(cctx, internal.CM, po.Namespace, e.Name, ref.Name, ref.Key, ref.Optional)
+			case e.ValueFrom.SecretKeyRef != nil:
+				ref := e.ValueFrom.SecretKeyRef
+				s.checkKeyRef(cctx, internal.SEC, po.Namespace, e.Name, ref.Name, ref.Key, ref.Optional)
+			}
+		}
+	}
+}
+
+func (s *Pod) checkKeyRef(ctx context.Context, r internal.R, ns, env, n, key string, optional *bool) {
+	fqn := cache.FQN(ns, n)
+	o, err := s.db.Find(s.db.GVR(r), fqn)
+	if err != nil {
+		return
+	}
+	var kind string
+	switch r {
+	case internal.CM:
+		kind = "ConfigMap"
+		cm, ok := o.(*v1.ConfigMap)
+		if !ok || hasKey(cm.Data, key) || hasKey(cm.BinaryData, key) {
+			return
+		}
+	default:
+		kind = "Secret"
+		sec, ok := o.(*v1.Secret)
+		if !ok || hasKey(sec.Data, key) || hasKey(sec.StringData, key) {
+			return
+		}
+	}
+	if optional != nil && *optional {
+		s.AddSubCode(ctx, 227, env, key, kind, fqn)
+		return
+	}
+	s.AddSubCode(ctx, 226, env, key, kind, fqn)
+}
+
+func hasKey[T any](m map[string]T, k string) bool {
+	_, ok := m[k]
+	return ok
+}
+
+func (s *Pod) saHasPullSecrets(po *v1.Pod) bool {
+	sa := po.Spec.ServiceAccountName
+	if sa == "" {
+		sa = "default"
+	}
+	o, err := s.db.Find(s.db.GVR(internal.SA), cache.FQN(po.Namespace, sa))
+	if err != nil {
+		return false
+	}
+	so, ok := o.(*v1.ServiceAccount)
+
+	return ok && len(so.ImagePullSecrets) > 0
+}
+
+// imageRegistry returns the registry host an image is pulled from.
+func imageRegistry(image string) string {
+	tokens := strings.SplitN(image, "/", 2)
+	if len(tokens) == 1 {
+		return defaultRegistry
+	}
+	if h := tokens[0]; strings.ContainsAny(h, ".:") || h == "localhost" {
+		return h
+	}
+
+	return defaultRegistry
+}
+
+// listNodes returns the cluster nodes keyed by name if any.
+func listNodes(dba *db.DB) map[string]*v1.Node {
+	if dba.GVR(internal.NO) == types.BlankGVR {
+		return nil
+	}
+	nn, err := dba.ListNodes()
+	if err != nil {
+		return nil
+	}
+
+	return nn
+}
+
+// maxAllocatable returns the largest cpu and memory allocatable across nodes.
+func maxAllocatable(dba *db.DB) v1.ResourceList {
+	return allocatableOf(listNodes(dba))
+}
+
+// allocatableOf returns the largest cpu and memory allocatable of the given nodes.
+func allocatableOf(nn map[string]*v1.Node) v1.ResourceList {
+	if len(nn) == 0 {
+		return nil
+	}
+	rl := make(v1.ResourceList, 2)
+	for _, no := range nn {
+		for _, r := range []v1.ResourceName{v1.ResourceCPU, v1.ResourceMemory} {
+			q, ok := no.Status.Allocatable[r]
+			if !ok {
+				continue
+			}
+			if m, ok := rl[r]; !ok || q.Cmp(m) > 0 {
+				rl[r] = q
+			}
+		}
+	}
+
+	return rl
+}
+
+func (s *Pod) checkSchedulable(ctx context.Context, spec v1.PodSpec, alloc v1.ResourceList) {
+	if len(alloc) == 0 {
+		return
+	}
+	cc := append(append([]v1.Container{}, spec.InitContainers...), spec.Containers...)
+	for _, co := range cc {
+		for _, r := range []v1.ResourceName{v1.ResourceCPU, v1.ResourceMemory} {
+			req, ok := co.Resources.Requests[r]
+			if !ok {
+				continue
+			}
+			if limit, ok := alloc[r]; ok && req.Cmp(limit) > 0 {
+				ctx := internal.WithGroup(ctx, types.NewGVR("containers"), co.Name)
+				s.AddSubCode(ctx, 117, r, req.String(), limit.String())
+			}
+		}
+	}
+}
+
+func (s *Pod) checkRuntimeClass(ctx context.Context, spec v1.PodSpec) {
+	if spec.RuntimeClassName == nil || *spec.RuntimeClassName == "" {
+		return
+	}
+	rcs, err := s.db.ListRuntimeClasses()
+	if err != nil || rcs == nil {
+		return
+	}
+	if _, ok := rcs[*spec.RuntimeClassName]; !ok {
+		s.AddCode(ctx, 215, *spec.RuntimeClassName)
+	}
+}
+
+func (s *Pod) inProduction(ns string) bool {
+	ll := s.PodProductionLabels()
+	if len(ll) == 0 {
+		return false
+	}
+	o, err := s.db.Find(s.db.GVR(internal.NS), ns)
+	if err != nil {
+		return false
+	}
+	nss, ok := o.(*v1.Namespace)
+
+	return ok && cache.MatchLabels(nss.Labels, ll)
+}
+
+// isWorkloadPod checks if a pod is managed by a long running workload.
+func isWorkloadPod(po *v1.Pod) bool {
+	for _, o := range po.OwnerReferences {
+		switch o.Kind {
+		case "ReplicaSet", "StatefulSet", "DaemonSet":
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s *Pod) checkOrphanAge(ctx context.Context, po *v1.Pod) {
+	if len(po.OwnerReferences) > 0 || po.CreationTimestamp.IsZero() || isStaticPod(po) {
+		return
+	}
+	age := time.Since(po.CreationTimestamp.Time)
+	if age > s.PodOrphanAge() {
+		s.AddCode(ctx, 212, duration.HumanDuration(age))
+	}
+}
+
+// checkSchedulingGates flags pods left pending by scheduling gates that were
+// never removed.
+func (s *Pod) checkSchedulingGates(ctx context.Context, po *v1.Pod) {
+	if len(po.Spec.SchedulingGates) == 0 || po.CreationTimestamp.IsZero() {
+		return
+	}
+	age := time.Since(po.CreationTimestamp.Time)
+	if age <= s.PodSchedulingGateAge() {
+		return
+	}
+	gg := make([]string, 0, len(po.Spec.SchedulingGates))
+	for _, g := range po.Spec.SchedulingGates {
+		gg = append(gg, g.Name)
+	}
+	s.AddCode(ctx, 237, strings.Join(gg, ", "), duration.HumanDuration(age))
+}
+
 func (s *Pod) checkOwnedByAnything(ctx context.Context, ownerRefs []metav1.OwnerReference) {
 	if len(ownerRefs) == 0 {
 		s.AddCode(ctx, 208)
@@ -257,7 +888,7 @@ func (s *Pod) checkPdb(ctx context.Context, labels map[string]string) {
 
 // ForLabels returns a pdb whose selector match the given labels. Returns nil if no match.
 func (s *Pod) ForLabels(labels map[string]string) *policyv1.PodDisruptionBudget {
-	txn, it := s.db.MustITFor(internal.Glossary[internal.PDB])
+	txn, it := s.db.MustITFor(s.db.GVR(internal.PDB))
 	defer txn.Abort()
 	for o := it.Next(); o != nil; o = it.Next() {
 		pdb := o.(*policyv1.PodDisruptionBudget)
@@ -301,7 +932,7 @@ func (s *Pod) checkSA(ctx context.Context, fqn string, spec v1.PodSpec) error {
 	txn := s.db.Txn(false)
 	defer txn.Abort()
 	saFQN := cache.FQN(ns, spec.ServiceAccountName)
-	o, err := txn.First(internal.Glossary[internal.SA].String(), "id", saFQN)
+	o, err := txn.First(s.db.GVR(internal.SA).String(), "id", saFQN)
 	if err != nil || o == nil {
 		s.AddCode(ctx, 307, "Pod", spec.ServiceAccountName)
 		if isBoolSet(spec.AutomountServiceAccountToken) {
@@ -411,10 +1042,43 @@ func (s *Pod) checkStatus(ctx context.Context, po *v1.Pod) {
 	}
 }
 
+// spreadPredicates maps scheduler failure messages to spread constraints.
+var spreadPredicates = []struct {
+	msg, constraint string
+}{
+	{"didn't match pod anti-affinity rules", "pod anti-affinity"},
+	{"didn't satisfy existing pods anti-affinity rules", "pod anti-affinity"},
+	{"didn't match pod affinity rules", "pod affinity"},
+	{"didn't match pod topology spread constraints", "topology spread"},
+}
+
+// checkSpreadScheduling flags pending pods the scheduler cannot place due
+// to their spread constraints as opposed to a shortage of resources.
+func (s *Pod) checkSpreadScheduling(ctx context.Context, st v1.PodStatus) {
+	if st.Phase != v1.PodPending {
+		return
+	}
+	for _, c := range st.Conditions {
+		if c.Type != v1.PodScheduled || c.Status != v1.ConditionFalse || c.Reason != v1.PodReasonUnschedulable {
+			continue
+		}
+		var cc []string
+		for _, p := range spreadPredicates {
+			if strings.Contains(c.Message, p.msg) && !slices.Contains(cc, p.constraint) {
+				cc = append(cc, p.constraint)
+			}
+		}
+		if len(cc) > 0 {
+			s.AddCode(ctx, 225, strings.Join(cc, ", "))
+		}
+		return
+	}
+}
+
 // !!BOZO!! Check
 func (s *Pod) checkForMultiplePdbMatches(ctx context.Context, podNamespace string, podLabels map[string]string) {
 	matchedPdbs := make([]string, 0, 10)
-	txn, it := s.db.MustITFor(internal.Glossary[internal.PDB])
+	txn, it := s.db.MustITFor(s.db.GVR(internal.PDB))
 	defer txn.Abort()
 	for o := it.Next(); o != nil; o = it.Next() {
 		pdb := o.(*policyv1.PodDisruptionBudget)
@@ -423,7 +1087,7 @@ func (s *Pod) checkForMultiplePdbMatches(ctx context.Context, podNamespace strin
 		}
 		selector, err := metav1.LabelSelectorAsSelector(pdb.Spec.Selector)
 		if err != nil {
-			log.Error().Err(err).Msg("No selectors found")
+			internal.ExtractLogger(ctx).Log(internal.ErrorLog, "No selectors found", "error", err)
 			return
 		}
 		if selector.Empty() || !selector.Matches(labels.Set(podLabels)) {
@@ -440,6 +1104,40 @@ func (s *Pod) checkForMultiplePdbMatches(ctx context.Context, podNamespace strin
 // ----------------------------------------------------------------------------
 // Helpers...
 
+func ownerKinds(refs []metav1.OwnerReference) []string {
+	kk := make([]string, 0, len(refs))
+	for _, r := range refs {
+		kk = append(kk, r.Kind)
+	}
+
+	return kk
+}
+
+func isStaticPod(po *v1.Pod) bool {
+	for _, a := range []string{mirrorPodAnnotation, staticPodSourceAnnotation} {
+		if _, ok := po.Annotations[a]; ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+func hasContainer(spec v1.PodSpec, n string) bool {
+	for _, co := range spec.InitContainers {
+		if co.Name == n {
+			return true
+		}
+	}
+	for _, co := range spec.Containers {
+		if co.Name == n {
+			return true
+		}
+	}
+
+	return false
+}
+
 func containerMetrics(pmx *mv1beta1.PodMetrics, mx client.ContainerMetrics) {
 	if pmx == nil {
 		return
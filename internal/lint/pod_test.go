@@ -5,15 +5,23 @@ package lint
 
 import (
 	"context"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/derailed/popeye/internal"
+	"github.com/derailed/popeye/internal/client"
 	"github.com/derailed/popeye/internal/db"
+	"github.com/derailed/popeye/internal/rules"
 	"github.com/derailed/popeye/internal/test"
+	"github.com/derailed/popeye/pkg/config"
 	"github.com/stretchr/testify/assert"
 	v1 "k8s.io/api/core/v1"
 	netv1 "k8s.io/api/networking/v1"
+	nodev1 "k8s.io/api/node/v1"
 	polv1 "k8s.io/api/policy/v1"
+	schedv1 "k8s.io/api/scheduling/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	mv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
 )
@@ -24,23 +32,25 @@ func TestPodNPLint(t *testing.T) {
 	l := db.NewLoader(dba)
 
 	ctx := test.MakeCtx(t)
-	assert.NoError(t, test.LoadDB[*v1.Pod](ctx, l.DB, "core/pod/3.yaml", internal.Glossary[internal.PO]))
-	assert.NoError(t, test.LoadDB[*v1.ServiceAccount](ctx, l.DB, "core/sa/2.yaml", internal.Glossary[internal.SA]))
-	assert.NoError(t, test.LoadDB[*v1.Namespace](ctx, l.DB, "core/ns/1.yaml", internal.Glossary[internal.NS]))
-	assert.NoError(t, test.LoadDB[*polv1.PodDisruptionBudget](ctx, l.DB, "pol/pdb/1.yaml", internal.Glossary[internal.PDB]))
-	assert.NoError(t, test.LoadDB[*netv1.NetworkPolicy](ctx, l.DB, "net/np/3.yaml", internal.Glossary[internal.NP]))
-	assert.NoError(t, test.LoadDB[*mv1beta1.PodMetrics](ctx, l.DB, "mx/pod/1.yaml", internal.Glossary[internal.PMX]))
+	assert.NoError(t, test.LoadDB[*v1.Pod](ctx, l.DB, "core/pod/3.yaml", test.Glossary[internal.PO]))
+	assert.NoError(t, test.LoadDB[*v1.ServiceAccount](ctx, l.DB, "core/sa/2.yaml", test.Glossary[internal.SA]))
+	assert.NoError(t, test.LoadDB[*v1.Namespace](ctx, l.DB, "core/ns/1.yaml", test.Glossary[internal.NS]))
+	assert.NoError(t, test.LoadDB[*polv1.PodDisruptionBudget](ctx, l.DB, "pol/pdb/1.yaml", test.Glossary[internal.PDB]))
+	assert.NoError(t, test.LoadDB[*netv1.NetworkPolicy](ctx, l.DB, "net/np/3.yaml", test.Glossary[internal.NP]))
+	assert.NoError(t, test.LoadDB[*mv1beta1.PodMetrics](ctx, l.DB, "mx/pod/1.yaml", test.Glossary[internal.PMX]))
 
 	po := NewPod(test.MakeCollector(t), dba)
 	assert.Nil(t, po.Lint(test.MakeContext("v1/pods", "pods")))
 	assert.Equal(t, 2, len(po.Outcome()))
 
 	ii := po.Outcome()["ns1/p1"]
-	assert.Equal(t, 1, len(ii))
-	assert.Equal(t, `[POP-1204] Pod Egress is not secured by a network policy`, ii[0].Message)
+	assert.Equal(t, 2, len(ii))
+	assert.Equal(t, `[POP-119] Container "c1" root filesystem is writable. Consider setting readOnlyRootFilesystem`, ii[0].Message)
+	assert.Equal(t, `[POP-1204] Pod Egress is not secured by a network policy`, ii[1].Message)
 
 	ii = po.Outcome()["ns2/p2"]
-	assert.Equal(t, 0, len(ii))
+	assert.Equal(t, 1, len(ii))
+	assert.Equal(t, rules.InfoLevel, ii[0].Level)
 }
 
 func TestPodCheckSecure(t *testing.T) {
@@ -97,10 +107,10 @@ func TestPodCheckSecure(t *testing.T) {
 	assert.NoError(t, err)
 	l := db.NewLoader(dba)
 
-	assert.NoError(t, test.LoadDB[*v1.Pod](ctx, l.DB, "core/pod/2.yaml", internal.Glossary[internal.PO]))
-	assert.NoError(t, test.LoadDB[*v1.ServiceAccount](ctx, l.DB, "core/sa/1.yaml", internal.Glossary[internal.SA]))
-	assert.NoError(t, test.LoadDB[*polv1.PodDisruptionBudget](ctx, l.DB, "pol/pdb/1.yaml", internal.Glossary[internal.PDB]))
-	assert.NoError(t, test.LoadDB[*mv1beta1.PodMetrics](ctx, l.DB, "mx/pod/1.yaml", internal.Glossary[internal.PMX]))
+	assert.NoError(t, test.LoadDB[*v1.Pod](ctx, l.DB, "core/pod/2.yaml", test.Glossary[internal.PO]))
+	assert.NoError(t, test.LoadDB[*v1.ServiceAccount](ctx, l.DB, "core/sa/1.yaml", test.Glossary[internal.SA]))
+	assert.NoError(t, test.LoadDB[*polv1.PodDisruptionBudget](ctx, l.DB, "pol/pdb/1.yaml", test.Glossary[internal.PDB]))
+	assert.NoError(t, test.LoadDB[*mv1beta1.PodMetrics](ctx, l.DB, "mx/pod/1.yaml", test.Glossary[internal.PMX]))
 
 	for k := range uu {
 		u := uu[k]
@@ -118,11 +128,11 @@ func TestPodLint(t *testing.T) {
 	l := db.NewLoader(dba)
 
 	ctx := test.MakeCtx(t)
-	assert.NoError(t, test.LoadDB[*v1.Pod](ctx, l.DB, "core/pod/2.yaml", internal.Glossary[internal.PO]))
-	assert.NoError(t, test.LoadDB[*v1.ServiceAccount](ctx, l.DB, "core/sa/1.yaml", internal.Glossary[internal.SA]))
-	assert.NoError(t, test.LoadDB[*polv1.PodDisruptionBudget](ctx, l.DB, "pol/pdb/1.yaml", internal.Glossary[internal.PDB]))
-	assert.NoError(t, test.LoadDB[*netv1.NetworkPolicy](ctx, l.DB, "net/np/1.yaml", internal.Glossary[internal.NP]))
-	assert.NoError(t, test.LoadDB[*mv1beta1.PodMetrics](ctx, l.DB, "mx/pod/1.yaml", internal.Glossary[internal.PMX]))
+	assert.NoError(t, test.LoadDB[*v1.Pod](ctx, l.DB, "core/pod/2.yaml", test.Glossary[internal.PO]))
+	assert.NoError(t, test.LoadDB[*v1.ServiceAccount](ctx, l.DB, "core/sa/1.yaml", test.Glossary[internal.SA]))
+	assert.NoError(t, test.LoadDB[*polv1.PodDisruptionBudget](ctx, l.DB, "pol/pdb/1.yaml", test.Glossary[internal.PDB]))
+	assert.NoError(t, test.LoadDB[*netv1.NetworkPolicy](ctx, l.DB, "net/np/1.yaml", test.Glossary[internal.NP]))
+	assert.NoError(t, test.LoadDB[*mv1beta1.PodMetrics](ctx, l.DB, "mx/pod/1.yaml", test.Glossary[internal.PMX]))
 
 	po := NewPod(test.MakeCollector(t), dba)
 	po.Collector.Config.Registries = []string{"dorker.io"}
@@ -130,53 +140,278 @@ func TestPodLint(t *testing.T) {
 	assert.Equal(t, 5, len(po.Outcome()))
 
 	ii := po.Outcome()["default/p1"]
-	assert.Equal(t, 0, len(ii))
+	assert.Equal(t, 1, len(ii))
+	assert.Equal(t, `[POP-220] BestEffort QoS class. Pod sets no resource requests or limits and is first in line for eviction`, ii[0].Message)
 
 	ii = po.Outcome()["default/p2"]
-	assert.Equal(t, 6, len(ii))
+	assert.Equal(t, 7, len(ii))
 	assert.Equal(t, `[POP-207] Pod is in an unhappy phase ()`, ii[0].Message)
 	assert.Equal(t, `[POP-208] Unmanaged pod detected. Best to use a controller`, ii[1].Message)
 	assert.Equal(t, `[POP-1204] Pod Ingress is not secured by a network policy`, ii[2].Message)
 	assert.Equal(t, `[POP-1204] Pod Egress is not secured by a network policy`, ii[3].Message)
 	assert.Equal(t, `[POP-206] Pod has no associated PodDisruptionBudget`, ii[4].Message)
 	assert.Equal(t, `[POP-301] Connects to API Server? ServiceAccount token is mounted`, ii[5].Message)
+	assert.Equal(t, `[POP-220] BestEffort QoS class. Pod sets no resource requests or limits and is first in line for eviction`, ii[6].Message)
 
 	ii = po.Outcome()["default/p3"]
-	assert.Equal(t, 6, len(ii))
+	assert.Equal(t, 8, len(ii))
 	assert.Equal(t, `[POP-105] Liveness uses a port#, prefer a named port`, ii[0].Message)
 	assert.Equal(t, `[POP-105] Readiness uses a port#, prefer a named port`, ii[1].Message)
-	assert.Equal(t, `[POP-1204] Pod Ingress is not secured by a network policy`, ii[2].Message)
-	assert.Equal(t, `[POP-1204] Pod Egress is not secured by a network policy`, ii[3].Message)
-	assert.Equal(t, `[POP-301] Connects to API Server? ServiceAccount token is mounted`, ii[4].Message)
-	assert.Equal(t, `[POP-109] CPU Current/Request (2000m/1000m) reached user 80% threshold (200%)`, ii[5].Message)
+	assert.Equal(t, `[POP-119] Container "c1" root filesystem is writable. Consider setting readOnlyRootFilesystem`, ii[2].Message)
+	assert.Equal(t, `[POP-1204] Pod Ingress is not secured by a network policy`, ii[3].Message)
+	assert.Equal(t, `[POP-1204] Pod Egress is not secured by a network policy`, ii[4].Message)
+	assert.Equal(t, `[POP-301] Connects to API Server? ServiceAccount token is mounted`, ii[5].Message)
+	assert.Equal(t, `[POP-217] Image dorker.io/blee:1.0.1 is pulled from registry dorker.io but no pull secrets are set`, ii[6].Message)
+	assert.Equal(t, `[POP-109] CPU Current/Request (2000m/1000m) reached user 80% threshold (200%)`, ii[7].Message)
 
 	ii = po.Outcome()["default/p4"]
-	assert.Equal(t, 15, len(ii))
+	assert.Equal(t, 18, len(ii))
 	assert.Equal(t, `[POP-204] Pod is not ready [0/1]`, ii[0].Message)
 	assert.Equal(t, `[POP-204] Pod is not ready [0/2]`, ii[1].Message)
 	assert.Equal(t, `[POP-100] Untagged docker image in use`, ii[2].Message)
 	assert.Equal(t, `[POP-113] Container image "zorg" is not hosted on an allowed docker registry`, ii[3].Message)
 	assert.Equal(t, `[POP-106] No resources requests/limits defined`, ii[4].Message)
-	assert.Equal(t, `[POP-100] Untagged docker image in use`, ii[5].Message)
-	assert.Equal(t, `[POP-113] Container image "blee" is not hosted on an allowed docker registry`, ii[6].Message)
-	assert.Equal(t, `[POP-101] Image tagged "latest" in use`, ii[7].Message)
-	assert.Equal(t, `[POP-113] Container image "zorg:latest" is not hosted on an allowed docker registry`, ii[8].Message)
-	assert.Equal(t, `[POP-107] No resource limits defined`, ii[9].Message)
-	assert.Equal(t, `[POP-208] Unmanaged pod detected. Best to use a controller`, ii[10].Message)
-	assert.Equal(t, `[POP-1204] Pod Ingress is not secured by a network policy`, ii[11].Message)
-	assert.Equal(t, `[POP-1204] Pod Egress is not secured by a network policy`, ii[12].Message)
-	assert.Equal(t, `[POP-300] Uses "default" ServiceAccount`, ii[13].Message)
-	assert.Equal(t, `[POP-301] Connects to API Server? ServiceAccount token is mounted`, ii[14].Message)
+	assert.Equal(t, `[POP-119] Container "ic1" root filesystem is writable. Consider setting readOnlyRootFilesystem`, ii[5].Message)
+	assert.Equal(t, `[POP-100] Untagged docker image in use`, ii[6].Message)
+	assert.Equal(t, `[POP-113] Container image "blee" is not hosted on an allowed docker registry`, ii[7].Message)
+	assert.Equal(t, `[POP-119] Container "c1" root filesystem is writable. Consider setting readOnlyRootFilesystem`, ii[8].Message)
+	assert.Equal(t, `[POP-101] Image tagged "latest" in use`, ii[9].Message)
+	assert.Equal(t, `[POP-113] Container image "zorg:latest" is not hosted on an allowed docker registry`, ii[10].Message)
+	assert.Equal(t, `[POP-107] No resource limits defined`, ii[11].Message)
+	assert.Equal(t, `[POP-119] Container "c2" root filesystem is writable. Consider setting readOnlyRootFilesystem`, ii[12].Message)
+	assert.Equal(t, `[POP-208] Unmanaged pod detected. Best to use a controller`, ii[13].Message)
+	assert.Equal(t, `[POP-1204] Pod Ingress is not secured by a network policy`, ii[14].Message)
+	assert.Equal(t, `[POP-1204] Pod Egress is not secured by a network policy`, ii[15].Message)
+	assert.Equal(t, `[POP-300] Uses "default" ServiceAccount`, ii[16].Message)
+	assert.Equal(t, `[POP-301] Connects to API Server? ServiceAccount token is mounted`, ii[17].Message)
 
 	ii = po.Outcome()["default/p5"]
-	assert.Equal(t, 7, len(ii))
+	assert.Equal(t, 9, len(ii))
 	assert.Equal(t, `[POP-113] Container image "blee:v1.2" is not hosted on an allowed docker registry`, ii[0].Message)
 	assert.Equal(t, `[POP-106] No resources requests/limits defined`, ii[1].Message)
 	assert.Equal(t, `[POP-102] No probes defined`, ii[2].Message)
-	assert.Equal(t, `[POP-1204] Pod Ingress is not secured by a network policy`, ii[3].Message)
-	assert.Equal(t, `[POP-1204] Pod Egress is not secured by a network policy`, ii[4].Message)
-	assert.Equal(t, `[POP-209] Pod is managed by multiple PodDisruptionBudgets (pdb4, pdb4-1)`, ii[5].Message)
-	assert.Equal(t, `[POP-301] Connects to API Server? ServiceAccount token is mounted`, ii[6].Message)
+	assert.Equal(t, `[POP-119] Container "c1" root filesystem is writable. Consider setting readOnlyRootFilesystem`, ii[3].Message)
+	assert.Equal(t, `[POP-1204] Pod Ingress is not secured by a network policy`, ii[4].Message)
+	assert.Equal(t, `[POP-1204] Pod Egress is not secured by a network policy`, ii[5].Message)
+	assert.Equal(t, `[POP-209] Pod is managed by multiple PodDisruptionBudgets (pdb4, pdb4-1)`, ii[6].Message)
+	assert.Equal(t, `[POP-301] Connects to API Server? ServiceAccount token is mounted`, ii[7].Message)
+	assert.Equal(t, `[POP-220] BestEffort QoS class. Pod sets no resource requests or limits and is first in line for eviction`, ii[8].Message)
+}
+
+func TestPodQoS(t *testing.T) {
+	uu := map[string]struct {
+		init, cos []v1.Container
+		e         v1.PodQOSClass
+	}{
+		"none": {
+			cos: []v1.Container{makeQoSContainer(nil, nil)},
+			e:   v1.PodQOSBestEffort,
+		},
+		"requests-only": {
+			cos: []v1.Container{makeQoSContainer(makeRes("100m", "10Mi"), nil)},
+			e:   v1.PodQOSBurstable,
+		},
+		"limits-only": {
+			cos: []v1.Container{makeQoSContainer(nil, makeRes("100m", "10Mi"))},
+			e:   v1.PodQOSGuaranteed,
+		},
+		"requests-eq-limits": {
+			cos: []v1.Container{makeQoSContainer(makeRes("100m", "10Mi"), makeRes("100m", "10Mi"))},
+			e:   v1.PodQOSGuaranteed,
+		},
+		"requests-lt-limits": {
+			cos: []v1.Container{makeQoSContainer(makeRes("50m", "10Mi"), makeRes("100m", "10Mi"))},
+			e:   v1.PodQOSBurstable,
+		},
+		"cpu-limit-only": {
+			cos: []v1.Container{makeQoSContainer(nil, v1.ResourceList{v1.ResourceCPU: test.ToQty("100m")})},
+			e:   v1.PodQOSBurstable,
+		},
+		"mixed-containers": {
+			cos: []v1.Container{
+				makeQoSContainer(makeRes("100m", "10Mi"), makeRes("100m", "10Mi")),
+				makeQoSContainer(nil, nil),
+			},
+			e: v1.PodQOSBurstable,
+		},
+		"init-best-effort": {
+			init: []v1.Container{makeQoSContainer(nil, nil)},
+			cos:  []v1.Container{makeQoSContainer(makeRes("100m", "10Mi"), makeRes("100m", "10Mi"))},
+			e:    v1.PodQOSBurstable,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, podQoS(v1.PodSpec{InitContainers: u.init, Containers: u.cos}))
+		})
+	}
+}
+
+func TestPodCheckPreStop(t *testing.T) {
+	hook := &v1.Lifecycle{
+		PreStop: &v1.LifecycleHandler{Sleep: &v1.SleepAction{Seconds: 5}},
+	}
+	uu := map[string]struct {
+		app       string
+		lifecycle *v1.Lifecycle
+		owners    []metav1.OwnerReference
+		issues    []string
+	}{
+		"fronted-no-hook": {
+			app: "p1",
+			issues: []string{
+				`[POP-124] Container "c1" is fronted by service svc1 but has no preStop hook. In-flight requests may be dropped on termination`,
+			},
+		},
+		"fronted-hook": {
+			app:       "p1",
+			lifecycle: hook,
+		},
+		"not-fronted": {
+			app: "zorg",
+		},
+		"job": {
+			app:    "p1",
+			owners: []metav1.OwnerReference{{Kind: "Job", Name: "j1"}},
+		},
+	}
+
+	dba, err := test.NewTestDB()
+	assert.NoError(t, err)
+	l := db.NewLoader(dba)
+	assert.NoError(t, test.LoadDB[*v1.Service](test.MakeCtx(t), l.DB, "core/svc/1.yaml", test.Glossary[internal.SVC]))
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			p := NewPod(test.MakeCollector(t), dba)
+			ctx := test.MakeContext("v1/pods", "pods")
+			ctx = internal.WithSpec(ctx, SpecFor("default/p1", nil))
+			po := v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            "p1",
+					Namespace:       "default",
+					Labels:          map[string]string{"app": u.app},
+					OwnerReferences: u.owners,
+				},
+				Spec: v1.PodSpec{
+					Containers: []v1.Container{{Name: "c1", Lifecycle: u.lifecycle}},
+				},
+			}
+			p.checkPreStop(ctx, &po)
+
+			ii := p.Outcome()["default/p1"]
+			assert.Equal(t, len(u.issues), len(ii))
+			for i, m := range u.issues {
+				assert.Equal(t, m, ii[i].Message)
+				assert.Equal(t, rules.InfoLevel, ii[i].Level)
+				assert.Equal(t, "c1", ii[i].Group)
+			}
+		})
+	}
+}
+
+func TestPodCheckServiceReadiness(t *testing.T) {
+	uu := map[string]struct {
+		app    string
+		probe  *v1.Probe
+		owners []metav1.OwnerReference
+		issues []string
+	}{
+		"fronted-no-probe": {
+			app: "p1",
+			issues: []string{
+				`[POP-133] Container "c1" is fronted by service svc1 but has no readiness probe. Traffic is routed before the container is ready`,
+			},
+		},
+		"fronted-probe": {
+			app:   "p1",
+			probe: &v1.Probe{ProbeHandler: v1.ProbeHandler{HTTPGet: &v1.HTTPGetAction{Path: "/ready"}}},
+		},
+		"not-fronted": {
+			app: "zorg",
+		},
+		"job": {
+			app:    "p1",
+			owners: []metav1.OwnerReference{{Kind: "Job", Name: "j1"}},
+		},
+	}
+
+	dba, err := test.NewTestDB()
+	assert.NoError(t, err)
+	l := db.NewLoader(dba)
+	assert.NoError(t, test.LoadDB[*v1.Service](test.MakeCtx(t), l.DB, "core/svc/1.yaml", test.Glossary[internal.SVC]))
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			p := NewPod(test.MakeCollector(t), dba)
+			ctx := test.MakeContext("v1/pods", "pods")
+			ctx = internal.WithSpec(ctx, SpecFor("default/p1", nil))
+			po := v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            "p1",
+					Namespace:       "default",
+					Labels:          map[string]string{"app": u.app},
+					OwnerReferences: u.owners,
+				},
+				Spec: v1.PodSpec{
+					Containers: []v1.Container{{Name: "c1", ReadinessProbe: u.probe}},
+				},
+			}
+			p.checkServiceReadiness(ctx, &po)
+
+			ii := p.Outcome()["default/p1"]
+			assert.Equal(t, len(u.issues), len(ii))
+			for i, m := range u.issues {
+				assert.Equal(t, m, ii[i].Message)
+				assert.Equal(t, rules.WarnLevel, ii[i].Level)
+				assert.Equal(t, "c1", ii[i].Group)
+			}
+		})
+	}
+}
+
+func TestPodCheckQoS(t *testing.T) {
+	uu := map[string]struct {
+		res    v1.ResourceList
+		issues []string
+	}{
+		"best-effort": {
+			issues: []string{
+				`[POP-220] BestEffort QoS class. Pod sets no resource requests or limits and is first in line for eviction`,
+			},
+		},
+		"guaranteed": {
+			res: makeRes("100m", "10Mi"),
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			dba, err := test.NewTestDB()
+			assert.NoError(t, err)
+			p := NewPod(test.MakeCollector(t), dba)
+			ctx := test.MakeContext("v1/pods", "pods")
+			ctx = internal.WithSpec(ctx, SpecFor("default/p1", nil))
+			po := v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: "p1", Namespace: "default"},
+				Spec: v1.PodSpec{
+					Containers: []v1.Container{makeQoSContainer(u.res, u.res)},
+				},
+			}
+			p.checkQoS(ctx, &po)
+
+			ii := p.Outcome()["default/p1"]
+			assert.Equal(t, len(u.issues), len(ii))
+			for i, m := range u.issues {
+				assert.Equal(t, m, ii[i].Message)
+			}
+		})
+	}
 }
 
 // ----------------------------------------------------------------------------
@@ -237,3 +472,1505 @@ func makeSecPod(pod, init, co1, co2 nonRootUser) v1.Pod {
 		},
 	}
 }
+
+func TestPodCheckSidecar(t *testing.T) {
+	uu := map[string]struct {
+		ns     string
+		cos    []string
+		issues int
+	}{
+		"injected": {
+			ns:  "ns1",
+			cos: []string{"c1", "istio-proxy"},
+		},
+		"missing": {
+			ns:     "ns1",
+			cos:    []string{"c1"},
+			issues: 1,
+		},
+		"not-injected": {
+			ns:  "ns2",
+			cos: []string{"c1"},
+		},
+	}
+
+	dba, err := test.NewTestDB()
+	assert.NoError(t, err)
+	ctx := test.MakeCtx(t)
+	assert.NoError(t, test.LoadDB[*v1.Namespace](ctx, dba, "core/ns/1.yaml", test.Glossary[internal.NS]))
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			co := test.MakeCollector(t)
+			co.Config.Resources.Pod.Sidecar = config.Sidecar{
+				Container:       "istio-proxy",
+				NamespaceLabels: map[string]string{"app": "ns1"},
+			}
+			p := NewPod(co, dba)
+			fqn := u.ns + "/p1"
+			p.InitOutcome(fqn)
+			ctx := internal.WithSpec(test.MakeContext("v1/pods", "pods"), SpecFor(fqn, nil))
+
+			po := v1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: u.ns, Name: "p1"}}
+			for _, n := range u.cos {
+				po.Spec.Containers = append(po.Spec.Containers, v1.Container{Name: n})
+			}
+			p.checkSidecar(ctx, &po)
+
+			assert.Equal(t, u.issues, len(p.Outcome()[fqn]))
+			if u.issues > 0 {
+				assert.Equal(t, `[POP-210] Expected sidecar container "istio-proxy" is missing. Was injection successful?`, p.Outcome()[fqn][0].Message)
+			}
+		})
+	}
+}
+
+func TestPodCheckContainersCount(t *testing.T) {
+	uu := map[string]struct {
+		count, inits, issues int
+	}{
+		"below": {count: 3},
+		"at":    {count: 5, inits: 2},
+		"above": {count: 6, issues: 1},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			p := NewPod(test.MakeCollector(t), nil)
+			p.InitOutcome("default/p1")
+			ctx := internal.WithSpec(test.MakeContext("v1/pods", "pods"), SpecFor("default/p1", nil))
+
+			var spec v1.PodSpec
+			for i := 0; i < u.count; i++ {
+				spec.Containers = append(spec.Containers, v1.Container{Name: fmt.Sprintf("c%d", i)})
+			}
+			for i := 0; i < u.inits; i++ {
+				spec.InitContainers = append(spec.InitContainers, v1.Container{Name: fmt.Sprintf("i%d", i)})
+			}
+			p.checkContainersCount(ctx, spec)
+
+			assert.Equal(t, u.issues, len(p.Outcome()["default/p1"]))
+			if u.issues > 0 {
+				assert.Equal(t, `[POP-211] Pod runs 6 containers which exceeds the 5 containers threshold`, p.Outcome()["default/p1"][0].Message)
+			}
+		})
+	}
+}
+
+func TestPodCheckOrphanAge(t *testing.T) {
+	uu := map[string]struct {
+		age         time.Duration
+		owners      []metav1.OwnerReference
+		annotations map[string]string
+		issues      int
+	}{
+		"old-orphan": {
+			age:    10 * 24 * time.Hour,
+			issues: 1,
+		},
+		"young-orphan": {
+			age: time.Hour,
+		},
+		"owned": {
+			age:    10 * 24 * time.Hour,
+			owners: []metav1.OwnerReference{{Kind: "ReplicaSet", Name: "rs1"}},
+		},
+		"mirror": {
+			age:         10 * 24 * time.Hour,
+			annotations: map[string]string{mirrorPodAnnotation: "blee"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			p := NewPod(test.MakeCollector(t), nil)
+			p.InitOutcome("default/p1")
+			ctx := internal.WithSpec(test.MakeContext("v1/pods", "pods"), SpecFor("default/p1", nil))
+
+			po := v1.Pod{ObjectMeta: metav1.ObjectMeta{
+				Namespace:         "default",
+				Name:              "p1",
+				CreationTimestamp: metav1.NewTime(time.Now().Add(-u.age)),
+				OwnerReferences:   u.owners,
+				Annotations:       u.annotations,
+			}}
+			p.checkOrphanAge(ctx, &po)
+
+			assert.Equal(t, u.issues, len(p.Outcome()["default/p1"]))
+			if u.issues > 0 {
+				assert.Equal(t, `[POP-212] Bare pod has been around for 10d. Forgotten debugging artifact?`, p.Outcome()["default/p1"][0].Message)
+			}
+		})
+	}
+}
+
+func TestPodLintOwnerKindExclusions(t *testing.T) {
+	dba, err := test.NewTestDB()
+	assert.NoError(t, err)
+	l := db.NewLoader(dba)
+
+	ctx := test.MakeCtx(t)
+	assert.NoError(t, test.LoadDB[*v1.Pod](ctx, l.DB, "core/pod/2.yaml", test.Glossary[internal.PO]))
+	assert.NoError(t, test.LoadDB[*v1.ServiceAccount](ctx, l.DB, "core/sa/1.yaml", test.Glossary[internal.SA]))
+
+	po := NewPod(test.MakeCollector(t), dba)
+	po.Collector.Config.Exclusions.OwnerKinds = []string{"Job"}
+	assert.Nil(t, po.Lint(test.MakeContext("v1/pods", "pods")))
+	assert.Equal(t, 4, len(po.Outcome()))
+
+	_, ok := po.Outcome()["default/p4"]
+	assert.False(t, ok)
+	_, ok = po.Outcome()["default/p1"]
+	assert.True(t, ok)
+}
+
+func TestPodCheckPriorityClass(t *testing.T) {
+	uu := map[string]struct {
+		pc, owner, ns string
+		issue         string
+	}{
+		"valid": {
+			pc:    "high-priority",
+			owner: "ReplicaSet",
+			ns:    "ns1",
+		},
+		"dangling": {
+			pc:    "blee",
+			owner: "ReplicaSet",
+			ns:    "ns2",
+			issue: `[POP-213] PriorityClass "blee" does not exist`,
+		},
+		"none": {
+			owner: "ReplicaSet",
+			ns:    "ns1",
+			issue: `[POP-214] No priority class set on workload pod. Consider setting one to control eviction order`,
+		},
+		"none-non-prod": {
+			owner: "ReplicaSet",
+			ns:    "ns2",
+		},
+		"none-job": {
+			owner: "Job",
+			ns:    "ns1",
+		},
+	}
+
+	dba, err := test.NewTestDB()
+	assert.NoError(t, err)
+	ctx := test.MakeCtx(t)
+	assert.NoError(t, test.LoadDB[*v1.Namespace](ctx, dba, "core/ns/1.yaml", test.Glossary[internal.NS]))
+	assert.NoError(t, test.LoadDB[*schedv1.PriorityClass](ctx, dba, "sched/pc/1.yaml", test.Glossary[internal.PC]))
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			co := test.MakeCollector(t)
+			co.Config.Resources.Pod.ProductionLabels = map[string]string{"app": "ns1"}
+			p := NewPod(co, dba)
+			fqn := u.ns + "/p1"
+			p.InitOutcome(fqn)
+			ctx := internal.WithSpec(test.MakeContext("v1/pods", "pods"), SpecFor(fqn, nil))
+
+			po := v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:       u.ns,
+					Name:            "p1",
+					OwnerReferences: []metav1.OwnerReference{{Kind: u.owner, Name: "o1"}},
+				},
+				Spec: v1.PodSpec{PriorityClassName: u.pc},
+			}
+			p.checkPriorityClass(ctx, &po)
+
+			ii := p.Outcome()[fqn]
+			if u.issue == "" {
+				assert.Equal(t, 0, len(ii))
+				return
+			}
+			assert.Equal(t, 1, len(ii))
+			assert.Equal(t, u.issue, ii[0].Message)
+		})
+	}
+}
+
+func TestPodCheckRuntimeClass(t *testing.T) {
+	uu := map[string]struct {
+		rc, issue string
+	}{
+		"valid": {
+			rc: "gvisor",
+		},
+		"dangling": {
+			rc:    "kata",
+			issue: `[POP-215] RuntimeClass "kata" does not exist. Pod will remain pending`,
+		},
+		"none": {},
+	}
+
+	dba, err := test.NewTestDB()
+	assert.NoError(t, err)
+	ctx := test.MakeCtx(t)
+	assert.NoError(t, test.LoadDB[*nodev1.RuntimeClass](ctx, dba, "node/rtc/1.yaml", test.Glossary[internal.RTC]))
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			p := NewPod(test.MakeCollector(t), dba)
+			fqn := "default/p1"
+			p.InitOutcome(fqn)
+			ctx := internal.WithSpec(test.MakeContext("v1/pods", "pods"), SpecFor(fqn, nil))
+
+			var spec v1.PodSpec
+			if u.rc != "" {
+				spec.RuntimeClassName = &u.rc
+			}
+			p.checkRuntimeClass(ctx, spec)
+
+			ii := p.Outcome()[fqn]
+			if u.issue == "" {
+				assert.Equal(t, 0, len(ii))
+				return
+			}
+			assert.Equal(t, 1, len(ii))
+			assert.Equal(t, u.issue, ii[0].Message)
+		})
+	}
+}
+
+func TestPodCheckSchedulable(t *testing.T) {
+	uu := map[string]struct {
+		cpu, mem string
+		issue    string
+	}{
+		"schedulable": {
+			cpu: "2",
+			mem: "1Gi",
+		},
+		"no-requests": {},
+		"cpu": {
+			cpu:   "64",
+			issue: `[POP-117] cpu request 64 exceeds the largest node allocatable 10. Pod can never be scheduled`,
+		},
+		"mem": {
+			cpu:   "1",
+			mem:   "64Gi",
+			issue: `[POP-117] memory request 64Gi exceeds the largest node allocatable 8124744Ki. Pod can never be scheduled`,
+		},
+	}
+
+	dba, err := test.NewTestDB()
+	assert.NoError(t, err)
+	ctx := test.MakeCtx(t)
+	assert.NoError(t, test.LoadDB[*v1.Node](ctx, dba, "core/node/1.yaml", test.Glossary[internal.NO]))
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			p := NewPod(test.MakeCollector(t), dba)
+			fqn := "default/p1"
+			p.InitOutcome(fqn)
+			ctx := internal.WithSpec(test.MakeContext("v1/pods", "pods"), SpecFor(fqn, nil))
+
+			co := v1.Container{Name: "c1"}
+			if u.cpu != "" || u.mem != "" {
+				co.Resources.Requests = make(v1.ResourceList)
+			}
+			if u.cpu != "" {
+				co.Resources.Requests[v1.ResourceCPU] = test.ToQty(u.cpu)
+			}
+			if u.mem != "" {
+				co.Resources.Requests[v1.ResourceMemory] = test.ToQty(u.mem)
+			}
+			p.checkSchedulable(ctx, v1.PodSpec{Containers: []v1.Container{co}}, maxAllocatable(dba))
+
+			ii := p.Outcome()[fqn]
+			if u.issue == "" {
+				assert.Equal(t, 0, len(ii))
+				return
+			}
+			assert.Equal(t, 1, len(ii))
+			assert.Equal(t, "c1", ii[0].Group)
+			assert.Equal(t, u.issue, ii[0].Message)
+		})
+	}
+}
+
+func TestPodCheckPullSecrets(t *testing.T) {
+	uu := map[string]struct {
+		image, sa string
+		secrets   []string
+		issues    []string
+	}{
+		"public": {
+			image: "nginx:1.25",
+		},
+		"valid": {
+			image:   "gcr.io/blee/fred:1.0",
+			secrets: []string{"sec1"},
+		},
+		"dangling": {
+			image:   "gcr.io/blee/fred:1.0",
+			secrets: []string{"sec1", "zorg"},
+			issues:  []string{`[POP-216] References a pull secret which does not exist: default/zorg`},
+		},
+		"private-none": {
+			image:  "gcr.io/blee/fred:1.0",
+			issues: []string{`[POP-217] Image gcr.io/blee/fred:1.0 is pulled from registry gcr.io but no pull secrets are set`},
+		},
+		"private-sa": {
+			image: "localhost:5000/fred:1.0",
+			sa:    "sa4",
+		},
+	}
+
+	dba, err := test.NewTestDB()
+	assert.NoError(t, err)
+	ctx := test.MakeCtx(t)
+	assert.NoError(t, test.LoadDB[*v1.Secret](ctx, dba, "core/secret/1.yaml", test.Glossary[internal.SEC]))
+	assert.NoError(t, test.LoadDB[*v1.ServiceAccount](ctx, dba, "core/sa/1.yaml", test.Glossary[internal.SA]))
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			p := NewPod(test.MakeCollector(t), dba)
+			fqn := "default/p1"
+			p.InitOutcome(fqn)
+			ctx := internal.WithSpec(test.MakeContext("v1/pods", "pods"), SpecFor(fqn, nil))
+
+			po := v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "p1"},
+				Spec: v1.PodSpec{
+					ServiceAccountName: u.sa,
+					Containers:         []v1.Container{{Name: "c1", Image: u.image}},
+				},
+			}
+			for _, s := range u.secrets {
+				po.Spec.ImagePullSecrets = append(po.Spec.ImagePullSecrets, v1.LocalObjectReference{Name: s})
+			}
+			p.checkPullSecrets(ctx, &po)
+
+			ii := p.Outcome()[fqn]
+			assert.Equal(t, len(u.issues), len(ii))
+			for i, msg := range u.issues {
+				assert.Equal(t, msg, ii[i].Message)
+			}
+		})
+	}
+}
+
+func TestPodCheckVolumeRefs(t *testing.T) {
+	optional := true
+	uu := map[string]struct {
+		vol   v1.VolumeSource
+		issue string
+	}{
+		"cm-present": {
+			vol: v1.VolumeSource{ConfigMap: &v1.ConfigMapVolumeSource{
+				LocalObjectReference: v1.LocalObjectReference{Name: "cm1"},
+			}},
+		},
+		"sec-present": {
+			vol: v1.VolumeSource{Secret: &v1.SecretVolumeSource{SecretName: "sec1"}},
+		},
+		"cm-missing": {
+			vol: v1.VolumeSource{ConfigMap: &v1.ConfigMapVolumeSource{
+				LocalObjectReference: v1.LocalObjectReference{Name: "zorg"},
+			}},
+			issue: `[POP-218] References a ConfigMap which does not exist: default/zorg`,
+		},
+		"sec-missing": {
+			vol:   v1.VolumeSource{Secret: &v1.SecretVolumeSource{SecretName: "zorg"}},
+			issue: `[POP-218] References a Secret which does not exist: default/zorg`,
+		},
+		"sec-missing-optional": {
+			vol:   v1.VolumeSource{Secret: &v1.SecretVolumeSource{SecretName: "zorg", Optional: &optional}},
+			issue: `[POP-219] References an optional Secret which does not exist: default/zorg`,
+		},
+		"projected-missing": {
+			vol: v1.VolumeSource{Projected: &v1.ProjectedVolumeSource{
+				Sources: []v1.VolumeProjection{
+					{ConfigMap: &v1.ConfigMapProjection{LocalObjectReference: v1.LocalObjectReference{Name: "cm2"}}},
+					{ConfigMap: &v1.ConfigMapProjection{LocalObjectReference: v1.LocalObjectReference{Name: "zorg"}}},
+				},
+			}},
+			issue: `[POP-218] References a ConfigMap which does not exist: default/zorg`,
+		},
+	}
+
+	dba, err := test.NewTestDB()
+	assert.NoError(t, err)
+	ctx := test.MakeCtx(t)
+	assert.NoError(t, test.LoadDB[*v1.ConfigMap](ctx, dba, "core/cm/1.yaml", test.Glossary[internal.CM]))
+	assert.NoError(t, test.LoadDB[*v1.Secret](ctx, dba, "core/secret/1.yaml", test.Glossary[internal.SEC]))
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			p := NewPod(test.MakeCollector(t), dba)
+			fqn := "default/p1"
+			p.InitOutcome(fqn)
+			ctx := internal.WithSpec(test.MakeContext("v1/pods", "pods"), SpecFor(fqn, nil))
+
+			po := v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "p1"},
+				Spec: v1.PodSpec{
+					Volumes: []v1.Volume{{Name: "v1", VolumeSource: u.vol}},
+				},
+			}
+			p.checkVolumeRefs(ctx, &po)
+
+			ii := p.Outcome()[fqn]
+			if u.issue == "" {
+				assert.Equal(t, 0, len(ii))
+				return
+			}
+			assert.Equal(t, 1, len(ii))
+			assert.Equal(t, u.issue, ii[0].Message)
+		})
+	}
+}
+
+func TestPodCheckKeyRefs(t *testing.T) {
+	optional := true
+	uu := map[string]struct {
+		env   v1.EnvVarSource
+		issue string
+		level rules.Level
+	}{
+		"cm-present": {
+			env: v1.EnvVarSource{ConfigMapKeyRef: &v1.ConfigMapKeySelector{
+				LocalObjectReference: v1.LocalObjectReference{Name: "cm2"},
+				Key:                  "k1",
+			}},
+		},
+		"sec-present": {
+			env: v1.EnvVarSource{SecretKeyRef: &v1.SecretKeySelector{
+				LocalObjectReference: v1.LocalObjectReference{Name: "sec2"},
+				Key:                  "admin-user",
+			}},
+		},
+		"cm-missing": {
+			env: v1.EnvVarSource{ConfigMapKeyRef: &v1.ConfigMapKeySelector{
+				LocalObjectReference: v1.LocalObjectReference{Name: "cm2"},
+				Key:                  "zorg",
+			}},
+			issue: `[POP-226] Env "E1" references key "zorg" which is missing from ConfigMap default/cm2`,
+			level: rules.ErrorLevel,
+		},
+		"sec-missing": {
+			env: v1.EnvVarSource{SecretKeyRef: &v1.SecretKeySelector{
+				LocalObjectReference: v1.LocalObjectReference{Name: "sec2"},
+				Key:                  "zorg",
+			}},
+			issue: `[POP-226] Env "E1" references key "zorg" which is missing from Secret default/sec2`,
+			level: rules.ErrorLevel,
+		},
+		"sec-missing-optional": {
+			env: v1.EnvVarSource{SecretKeyRef: &v1.SecretKeySelector{
+				LocalObjectReference: v1.LocalObjectReference{Name: "sec2"},
+				Key:                  "zorg",
+				Optional:             &optional,
+			}},
+			issue: `[POP-227] Env "E1" references key "zorg" which is missing from optional Secret default/sec2`,
+			level: rules.InfoLevel,
+		},
+		"object-missing": {
+			env: v1.EnvVarSource{ConfigMapKeyRef: &v1.ConfigMapKeySelector{
+				LocalObjectReference: v1.LocalObjectReference{Name: "zorg"},
+				Key:                  "k1",
+			}},
+		},
+		"field-ref": {
+			env: v1.EnvVarSource{FieldRef: &v1.ObjectFieldSelector{FieldPath: "metadata.name"}},
+		},
+	}
+
+	dba, err := test.NewTestDB()
+	assert.NoError(t, err)
+	ctx := test.MakeCtx(t)
+	assert.NoError(t, test.LoadDB[*v1.ConfigMap](ctx, dba, "core/cm/1.yaml", test.Glossary[internal.CM]))
+	assert.NoError(t, test.LoadDB[*v1.Secret](ctx, dba, "core/secret/1.yaml", test.Glossary[internal.SEC]))
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			p := NewPod(test.MakeCollector(t), dba)
+			fqn := "default/p1"
+			p.InitOutcome(fqn)
+			ctx := internal.WithSpec(test.MakeContext("v1/pods", "pods"), SpecFor(fqn, nil))
+
+			env := u.env
+			po := v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "p1"},
+				Spec: v1.PodSpec{
+					Containers: []v1.Container{{
+						Name: "c1",
+						Env:  []v1.EnvVar{{Name: "E1", ValueFrom: &env}},
+					}},
+				},
+			}
+			p.checkKeyRefs(ctx, &po)
+
+			ii := p.Outcome()[fqn]
+			if u.issue == "" {
+				assert.Equal(t, 0, len(ii))
+				return
+			}
+			assert.Equal(t, 1, len(ii))
+			assert.Equal(t, "c1", ii[0].Group)
+			assert.Equal(t, u.issue, ii[0].Message)
+			assert.Equal(t, u.level, ii[0].Level)
+		})
+	}
+}
+
+func TestPodCheckDownwardAPI(t *testing.T) {
+	limits := v1.ResourceList{v1.ResourceMemory: resource.MustParse("128Mi")}
+	uu := map[string]struct {
+		env   *v1.EnvVarSource
+		vol   *v1.DownwardAPIVolumeFile
+		issue string
+		group string
+		level rules.Level
+	}{
+		"valid-field": {
+			env: &v1.EnvVarSource{FieldRef: &v1.ObjectFieldSelector{FieldPath: "metadata.name"}},
+		},
+		"valid-label": {
+			env: &v1.EnvVarSource{FieldRef: &v1.ObjectFieldSelector{FieldPath: "metadata.labels['app']"}},
+		},
+		"typo-field": {
+			env:   &v1.EnvVarSource{FieldRef: &v1.ObjectFieldSelector{FieldPath: "metadata.labals"}},
+			issue: `[POP-228] Downward API field path "metadata.labals" is not supported`,
+			group: "c1",
+			level: rules.ErrorLevel,
+		},
+		"valid-resource": {
+			env: &v1.EnvVarSource{ResourceFieldRef: &v1.ResourceFieldSelector{Resource: "limits.memory"}},
+		},
+		"unset-resource": {
+			env:   &v1.EnvVarSource{ResourceFieldRef: &v1.ResourceFieldSelector{Resource: "limits.cpu"}},
+			issue: `[POP-229] Downward API resource "limits.cpu" is not set on container "c1". Node allocatable is used instead`,
+			group: "c1",
+			level: rules.WarnLevel,
+		},
+		"bad-resource": {
+			env:   &v1.EnvVarSource{ResourceFieldRef: &v1.ResourceFieldSelector{Resource: "limit.cpu"}},
+			issue: `[POP-228] Downward API field path "limit.cpu" is not supported`,
+			group: "c1",
+			level: rules.ErrorLevel,
+		},
+		"volume-labels": {
+			vol: &v1.DownwardAPIVolumeFile{Path: "labels", FieldRef: &v1.ObjectFieldSelector{FieldPath: "metadata.labels"}},
+		},
+		"volume-typo": {
+			vol:   &v1.DownwardAPIVolumeFile{Path: "ip", FieldRef: &v1.ObjectFieldSelector{FieldPath: "status.podIP"}},
+			issue: `[POP-228] Downward API field path "status.podIP" is not supported`,
+			group: "__root__",
+			level: rules.ErrorLevel,
+		},
+		"volume-unset-resource": {
+			vol: &v1.DownwardAPIVolumeFile{Path: "cpu", ResourceFieldRef: &v1.ResourceFieldSelector{
+				ContainerName: "c1",
+				Resource:      "requests.cpu",
+			}},
+			issue: `[POP-229] Downward API resource "requests.cpu" is not set on container "c1". Node allocatable is used instead`,
+			group: "__root__",
+			level: rules.WarnLevel,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			p := NewPod(test.MakeCollector(t), nil)
+			fqn := "default/p1"
+			p.InitOutcome(fqn)
+			ctx := internal.WithSpec(test.MakeContext("v1/pods", "pods"), SpecFor(fqn, nil))
+
+			co := v1.Container{
+				Name:      "c1",
+				Resources: v1.ResourceRequirements{Limits: limits},
+			}
+			spec := v1.PodSpec{}
+			if u.env != nil {
+				co.Env = []v1.EnvVar{{Name: "E1", ValueFrom: u.env}}
+			}
+			if u.vol != nil {
+				spec.Volumes = []v1.Volume{{
+					Name:         "v1",
+					VolumeSource: v1.VolumeSource{DownwardAPI: &v1.DownwardAPIVolumeSource{Items: []v1.DownwardAPIVolumeFile{*u.vol}}},
+				}}
+			}
+			spec.Containers = []v1.Container{co}
+			p.checkDownwardAPI(ctx, spec)
+
+			ii := p.Outcome()[fqn]
+			if u.issue == "" {
+				assert.Equal(t, 0, len(ii))
+				return
+			}
+			assert.Equal(t, 1, len(ii))
+			assert.Equal(t, u.group, ii[0].Group)
+			assert.Equal(t, u.issue, ii[0].Message)
+			assert.Equal(t, u.level, ii[0].Level)
+		})
+	}
+}
+
+func makeQoSContainer(req, lim v1.ResourceList) v1.Container {
+	return v1.Container{
+		Name: "c1",
+		Resources: v1.ResourceRequirements{
+			Requests: req,
+			Limits:   lim,
+		},
+	}
+}
+
+func makeRes(cpu, mem string) v1.ResourceList {
+	return v1.ResourceList{
+		v1.ResourceCPU:    test.ToQty(cpu),
+		v1.ResourceMemory: test.ToQty(mem),
+	}
+}
+
+func TestPodCheckEphemeralStorage(t *testing.T) {
+	limits := v1.ResourceList{v1.ResourceEphemeralStorage: resource.MustParse("1Gi")}
+	uu := map[string]struct {
+		medium   v1.StorageMedium
+		limits   v1.ResourceList
+		readOnly bool
+		issues   []string
+	}{
+		"no-limit": {
+			issues: []string{
+				`[POP-125] Container "c1" mounts emptyDir "scratch" without an ephemeral-storage limit. Pod may be evicted under node disk pressure`,
+			},
+		},
+		"limit": {
+			limits: limits,
+		},
+		"memory": {
+			medium: v1.StorageMediumMemory,
+		},
+		"read-only": {
+			readOnly: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			dba, err := test.NewTestDB()
+			assert.NoError(t, err)
+			p := NewPod(test.MakeCollector(t), dba)
+			ctx := test.MakeContext("v1/pods", "pods")
+			ctx = internal.WithSpec(ctx, SpecFor("default/p1", nil))
+			p.checkEphemeralStorage(ctx, v1.PodSpec{
+				Volumes: []v1.Volume{
+					{
+						Name:         "scratch",
+						VolumeSource: v1.VolumeSource{EmptyDir: &v1.EmptyDirVolumeSource{Medium: u.medium}},
+					},
+				},
+				Containers: []v1.Container{
+					{
+						Name:         "c1",
+						Resources:    v1.ResourceRequirements{Limits: u.limits},
+						VolumeMounts: []v1.VolumeMount{{Name: "scratch", MountPath: "/scratch", ReadOnly: u.readOnly}},
+					},
+				},
+			})
+
+			ii := p.Outcome()["default/p1"]
+			assert.Equal(t, len(u.issues), len(ii))
+			for i, m := range u.issues {
+				assert.Equal(t, m, ii[i].Message)
+				assert.Equal(t, rules.InfoLevel, ii[i].Level)
+				assert.Equal(t, "c1", ii[i].Group)
+			}
+		})
+	}
+}
+
+func TestPodCheckControlPlane(t *testing.T) {
+	uu := map[string]struct {
+		ns, node string
+		owners   []metav1.OwnerReference
+		issue    string
+	}{
+		"control-plane": {
+			ns:    "default",
+			node:  "n1",
+			issue: `[POP-222] Workload pod scheduled on control plane node "n1"`,
+		},
+		"worker": {
+			ns:   "default",
+			node: "n2",
+		},
+		"system": {
+			ns:   "kube-system",
+			node: "n1",
+		},
+		"daemonset": {
+			ns:     "default",
+			node:   "n1",
+			owners: []metav1.OwnerReference{{Kind: "DaemonSet", Name: "ds1"}},
+		},
+		"unscheduled": {
+			ns: "default",
+		},
+	}
+
+	dba, err := test.NewTestDB()
+	assert.NoError(t, err)
+	l := db.NewLoader(dba)
+	assert.NoError(t, test.LoadDB[*v1.Node](test.MakeCtx(t), l.DB, "core/node/1.yaml", test.Glossary[internal.NO]))
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			p := NewPod(test.MakeCollector(t), dba)
+			fqn := client.FQN(u.ns, "p1")
+			ctx := test.MakeContext("v1/pods", "pods")
+			ctx = internal.WithSpec(ctx, SpecFor(fqn, nil))
+			p.checkControlPlane(ctx, &v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            "p1",
+					Namespace:       u.ns,
+					OwnerReferences: u.owners,
+				},
+				Spec: v1.PodSpec{NodeName: u.node},
+			})
+
+			ii := p.Outcome()[fqn]
+			if u.issue == "" {
+				assert.Equal(t, 0, len(ii))
+				return
+			}
+			assert.Equal(t, 1, len(ii))
+			assert.Equal(t, u.issue, ii[0].Message)
+			assert.Equal(t, rules.WarnLevel, ii[0].Level)
+		})
+	}
+}
+
+func TestPodCheckContainerNames(t *testing.T) {
+	uu := map[string]struct {
+		inits, cos []string
+		issues     []string
+		level      rules.Level
+	}{
+		"unique": {
+			inits: []string{"i1"},
+			cos:   []string{"c1", "c2"},
+		},
+		"init-collision": {
+			inits:  []string{"c1"},
+			cos:    []string{"c1", "c2"},
+			issues: []string{`[POP-223] Init container and container share name "c1". Logs and exec targets are ambiguous`},
+			level:  rules.WarnLevel,
+		},
+		"duplicate": {
+			cos:    []string{"c1", "c2", "c1", "c1"},
+			issues: []string{`[POP-224] Duplicate container name "c1"`},
+			level:  rules.ErrorLevel,
+		},
+		"init-duplicate": {
+			inits:  []string{"i1", "i1"},
+			cos:    []string{"c1"},
+			issues: []string{`[POP-224] Duplicate container name "i1"`},
+			level:  rules.ErrorLevel,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			dba, err := test.NewTestDB()
+			assert.NoError(t, err)
+			p := NewPod(test.MakeCollector(t), dba)
+			ctx := test.MakeContext("v1/pods", "pods")
+			ctx = internal.WithSpec(ctx, SpecFor("default/p1", nil))
+			var spec v1.PodSpec
+			for _, n := range u.inits {
+				spec.InitContainers = append(spec.InitContainers, v1.Container{Name: n})
+			}
+			for _, n := range u.cos {
+				spec.Containers = append(spec.Containers, v1.Container{Name: n})
+			}
+			p.checkContainerNames(ctx, spec)
+
+			ii := p.Outcome()["default/p1"]
+			assert.Equal(t, len(u.issues), len(ii))
+			for i, m := range u.issues {
+				assert.Equal(t, m, ii[i].Message)
+				assert.Equal(t, u.level, ii[i].Level)
+			}
+		})
+	}
+}
+
+func TestPodCheckSpreadScheduling(t *testing.T) {
+	uu := map[string]struct {
+		phase  v1.PodPhase
+		reason string
+		msg    string
+		issue  string
+	}{
+		"anti-affinity": {
+			phase:  v1.PodPending,
+			reason: v1.PodReasonUnschedulable,
+			msg:    "0/3 nodes are available: 1 node(s) had untolerated taint {node-role.kubernetes.io/control-plane: }, 2 node(s) didn't match pod anti-affinity rules. preemption: 0/3 nodes are available: 3 No preemption victims found for incoming pod.",
+			issue:  "[POP-225] Pod is unschedulable as its pod anti-affinity constraints cannot be met by the current nodes",
+		},
+		"spread": {
+			phase:  v1.PodPending,
+			reason: v1.PodReasonUnschedulable,
+			msg:    "0/4 nodes are available: 2 node(s) didn't match pod topology spread constraints, 2 node(s) didn't satisfy existing pods anti-affinity rules.",
+			issue:  "[POP-225] Pod is unschedulable as its pod anti-affinity, topology spread constraints cannot be met by the current nodes",
+		},
+		"resources": {
+			phase:  v1.PodPending,
+			reason: v1.PodReasonUnschedulable,
+			msg:    "0/3 nodes are available: 3 Insufficient cpu. preemption: 0/3 nodes are available: 3 No preemption victims found for incoming pod.",
+		},
+		"running": {
+			phase: v1.PodRunning,
+			msg:   "2 node(s) didn't match pod anti-affinity rules",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			dba, err := test.NewTestDB()
+			assert.NoError(t, err)
+			p := NewPod(test.MakeCollector(t), dba)
+			ctx := test.MakeContext("v1/pods", "pods")
+			ctx = internal.WithSpec(ctx, SpecFor("default/p1", nil))
+			p.checkSpreadScheduling(ctx, v1.PodStatus{
+				Phase: u.phase,
+				Conditions: []v1.PodCondition{
+					{
+						Type:    v1.PodScheduled,
+						Status:  v1.ConditionFalse,
+						Reason:  u.reason,
+						Message: u.msg,
+					},
+				},
+			})
+
+			ii := p.Outcome()["default/p1"]
+			if u.issue == "" {
+				assert.Equal(t, 0, len(ii))
+				return
+			}
+			assert.Equal(t, 1, len(ii))
+			assert.Equal(t, u.issue, ii[0].Message)
+			assert.Equal(t, rules.WarnLevel, ii[0].Level)
+		})
+	}
+}
+
+func TestPodCheckNodeConstraints(t *testing.T) {
+	zone := func(op v1.NodeSelectorOperator, vv ...string) v1.NodeSelectorTerm {
+		return v1.NodeSelectorTerm{
+			MatchExpressions: []v1.NodeSelectorRequirement{
+				{Key: "topology.kubernetes.io/zone", Operator: op, Values: vv},
+			},
+		}
+	}
+	uu := map[string]struct {
+		sel   map[string]string
+		terms []v1.NodeSelectorTerm
+		issue string
+	}{
+		"selector-only": {
+			sel: map[string]string{"disktype": "ssd"},
+		},
+		"affinity-only": {
+			terms: []v1.NodeSelectorTerm{zone(v1.NodeSelectorOpIn, "us-east-1b")},
+		},
+		"satisfiable": {
+			sel:   map[string]string{"disktype": "ssd"},
+			terms: []v1.NodeSelectorTerm{zone(v1.NodeSelectorOpIn, "us-east-1a")},
+		},
+		"satisfiable-any-term": {
+			sel: map[string]string{"disktype": "ssd"},
+			terms: []v1.NodeSelectorTerm{
+				zone(v1.NodeSelectorOpIn, "us-east-1b"),
+				{MatchFields: []v1.NodeSelectorRequirement{
+					{Key: "metadata.name", Operator: v1.NodeSelectorOpIn, Values: []string{"n1"}},
+				}},
+			},
+		},
+		"unsatisfiable": {
+			sel:   map[string]string{"disktype": "ssd"},
+			terms: []v1.NodeSelectorTerm{zone(v1.NodeSelectorOpNotIn, "us-east-1a")},
+			issue: `[POP-230] nodeSelector disktype=ssd conflicts with required node affinity (topology.kubernetes.io/zone notin (us-east-1a)). No node matches both`,
+		},
+		"unsatisfiable-terms": {
+			sel: map[string]string{"disktype": "hdd"},
+			terms: []v1.NodeSelectorTerm{
+				zone(v1.NodeSelectorOpIn, "us-east-1a"),
+				zone(v1.NodeSelectorOpDoesNotExist),
+			},
+			issue: `[POP-230] nodeSelector disktype=hdd conflicts with required node affinity (topology.kubernetes.io/zone in (us-east-1a)) or (!topology.kubernetes.io/zone). No node matches both`,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			dba, err := test.NewTestDB()
+			assert.NoError(t, err)
+			l := db.NewLoader(dba)
+			ctx := test.MakeCtx(t)
+			assert.NoError(t, test.LoadDB[*v1.Node](ctx, l.DB, "core/node/2.yaml", test.Glossary[internal.NO]))
+
+			p := NewPod(test.MakeCollector(t), dba)
+			fqn := "default/p1"
+			p.InitOutcome(fqn)
+			ctx = internal.WithSpec(test.MakeContext("v1/pods", "pods"), SpecFor(fqn, nil))
+			spec := v1.PodSpec{NodeSelector: u.sel}
+			if len(u.terms) > 0 {
+				spec.Affinity = &v1.Affinity{NodeAffinity: &v1.NodeAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: &v1.NodeSelector{NodeSelectorTerms: u.terms},
+				}}
+			}
+			p.checkNodeConstraints(ctx, spec, listNodes(dba))
+
+			ii := p.Outcome()[fqn]
+			if u.issue == "" {
+				assert.Empty(t, ii)
+				return
+			}
+			assert.Equal(t, 1, len(ii))
+			assert.Equal(t, u.issue, ii[0].Message)
+			assert.Equal(t, rules.WarnLevel, ii[0].Level)
+		})
+	}
+}
+
+func TestPodCheckGracePeriod(t *testing.T) {
+	sleep := func(cmd ...string) *v1.Lifecycle {
+		return &v1.Lifecycle{PreStop: &v1.LifecycleHandler{Exec: &v1.ExecAction{Command: cmd}}}
+	}
+	readiness := &v1.Probe{PeriodSeconds: 5, FailureThreshold: 3}
+	grace := func(n int64) *int64 { return &n }
+	uu := map[string]struct {
+		grace     *int64
+		lifecycle *v1.Lifecycle
+		probe     *v1.Probe
+		issue     string
+	}{
+		"none": {},
+		"adequate": {
+			grace:     grace(60),
+			lifecycle: sleep("sh", "-c", "sleep 20"),
+			probe:     readiness,
+		},
+		"default-adequate": {
+			lifecycle: sleep("sleep", "15"),
+			probe:     readiness,
+		},
+		"too-short": {
+			grace:     grace(10),
+			lifecycle: sleep("sh", "-c", "sleep 20"),
+			probe:     readiness,
+			issue:     `[POP-131] Container needs 35s to run its preStop hook and fail readiness probes but termination grace period is 10s (25s short)`,
+		},
+		"default-too-short": {
+			lifecycle: &v1.Lifecycle{PreStop: &v1.LifecycleHandler{Sleep: &v1.SleepAction{Seconds: 15}}},
+			probe:     &v1.Probe{},
+			issue:     `[POP-131] Container needs 45s to run its preStop hook and fail readiness probes but termination grace period is 30s (15s short)`,
+		},
+		"http-hook": {
+			grace: grace(10),
+			lifecycle: &v1.Lifecycle{PreStop: &v1.LifecycleHandler{
+				HTTPGet: &v1.HTTPGetAction{Path: "/drain"},
+			}},
+			probe: &v1.Probe{PeriodSeconds: 2, FailureThreshold: 3},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			p := NewPod(test.MakeCollector(t), nil)
+			fqn := "default/p1"
+			p.InitOutcome(fqn)
+			ctx := internal.WithSpec(test.MakeContext("v1/pods", "pods"), SpecFor(fqn, nil))
+			spec := v1.PodSpec{
+				TerminationGracePeriodSeconds: u.grace,
+				Containers: []v1.Container{
+					{Name: "c1", Lifecycle: u.lifecycle, ReadinessProbe: u.probe},
+				},
+			}
+			p.checkGracePeriod(ctx, spec)
+
+			ii := p.Outcome()[fqn]
+			if u.issue == "" {
+				assert.Empty(t, ii)
+				return
+			}
+			assert.Equal(t, 1, len(ii))
+			assert.Equal(t, "c1", ii[0].Group)
+			assert.Equal(t, u.issue, ii[0].Message)
+			assert.Equal(t, rules.InfoLevel, ii[0].Level)
+		})
+	}
+}
+
+func TestPodCheckPortConflicts(t *testing.T) {
+	always := v1.ContainerRestartPolicyAlways
+	port := func(n int32, proto v1.Protocol) []v1.ContainerPort {
+		return []v1.ContainerPort{{ContainerPort: n, Protocol: proto}}
+	}
+	uu := map[string]struct {
+		inits  []v1.Container
+		cos    []v1.Container
+		issues []string
+	}{
+		"distinct": {
+			cos: []v1.Container{
+				{Name: "c1", Ports: port(8080, v1.ProtocolTCP)},
+				{Name: "c2", Ports: port(9090, v1.ProtocolTCP)},
+			},
+		},
+		"distinct-protocols": {
+			cos: []v1.Container{
+				{Name: "c1", Ports: port(53, v1.ProtocolTCP)},
+				{Name: "c2", Ports: port(53, v1.ProtocolUDP)},
+			},
+		},
+		"conflict": {
+			cos: []v1.Container{
+				{Name: "c1", Ports: port(8080, "")},
+				{Name: "c2", Ports: port(8080, v1.ProtocolTCP)},
+			},
+			issues: []string{
+				`[POP-231] Port 8080/TCP is declared by containers "c1" and "c2". Containers share the pod network namespace and will conflict`,
+			},
+		},
+		"init-container": {
+			inits: []v1.Container{{Name: "i1", Ports: port(8080, v1.ProtocolTCP)}},
+			cos:   []v1.Container{{Name: "c1", Ports: port(8080, v1.ProtocolTCP)}},
+		},
+		"sidecar-conflict": {
+			inits: []v1.Container{{Name: "i1", RestartPolicy: &always, Ports: port(8080, v1.ProtocolTCP)}},
+			cos:   []v1.Container{{Name: "c1", Ports: port(8080, v1.ProtocolTCP)}},
+			issues: []string{
+				`[POP-231] Port 8080/TCP is declared by containers "i1" and "c1". Containers share the pod network namespace and will conflict`,
+			},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			p := NewPod(test.MakeCollector(t), nil)
+			fqn := "default/p1"
+			p.InitOutcome(fqn)
+			ctx := internal.WithSpec(test.MakeContext("v1/pods", "pods"), SpecFor(fqn, nil))
+			p.checkPortConflicts(ctx, v1.PodSpec{InitContainers: u.inits, Containers: u.cos})
+
+			ii := p.Outcome()[fqn]
+			assert.Equal(t, len(u.issues), len(ii))
+			for i, m := range u.issues {
+				assert.Equal(t, m, ii[i].Message)
+				assert.Equal(t, rules.ErrorLevel, ii[i].Level)
+			}
+		})
+	}
+}
+
+func TestPodCheckImageDigests(t *testing.T) {
+	const (
+		d1 = "sha256:1111111111111111111111111111111111111111111111111111111111111111"
+		d2 = "sha256:2222222222222222222222222222222222222222222222222222222222222222"
+	)
+	uu := map[string]struct {
+		image, imageID string
+		annotations    map[string]string
+		issue          string
+	}{
+		"tag-only": {
+			image:   "nginx:1.25",
+			imageID: "docker.io/library/nginx@" + d2,
+		},
+		"pinned-match": {
+			image:   "nginx@" + d1,
+			imageID: "docker.io/library/nginx@" + d1,
+		},
+		"pinned-drift": {
+			image:   "nginx:1.25@" + d1,
+			imageID: "docker-pullable://nginx@" + d2,
+			issue:   `[POP-232] Container "c1" runs image digest ` + d2 + ` but expects nginx:1.25@` + d1 + `. The image tag may have moved`,
+		},
+		"annotated-match": {
+			image:       "nginx:1.25",
+			imageID:     "docker.io/library/nginx@" + d1,
+			annotations: map[string]string{ImageDigestAnnotation + "c1": d1},
+		},
+		"annotated-drift": {
+			image:       "nginx:1.25",
+			imageID:     "docker.io/library/nginx@" + d2,
+			annotations: map[string]string{ImageDigestAnnotation + "c1": d1},
+			issue:       `[POP-232] Container "c1" runs image digest ` + d2 + ` but expects ` + d1 + `. The image tag may have moved`,
+		},
+		"not-running": {
+			image:       "nginx:1.25",
+			annotations: map[string]string{ImageDigestAnnotation + "c1": d1},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			p := NewPod(test.MakeCollector(t), nil)
+			fqn := "default/p1"
+			p.InitOutcome(fqn)
+			ctx := internal.WithSpec(test.MakeContext("v1/pods", "pods"), SpecFor(fqn, nil))
+			po := v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: "p1", Namespace: "default", Annotations: u.annotations},
+				Spec:       v1.PodSpec{Containers: []v1.Container{{Name: "c1", Image: u.image}}},
+			}
+			if u.imageID != "" {
+				po.Status.ContainerStatuses = []v1.ContainerStatus{{Name: "c1", ImageID: u.imageID}}
+			}
+			p.checkImageDigests(ctx, &po)
+
+			ii := p.Outcome()[fqn]
+			if u.issue == "" {
+				assert.Empty(t, ii)
+				return
+			}
+			assert.Equal(t, 1, len(ii))
+			assert.Equal(t, "c1", ii[0].Group)
+			assert.Equal(t, u.issue, ii[0].Message)
+			assert.Equal(t, rules.InfoLevel, ii[0].Level)
+		})
+	}
+}
+
+func TestPodCheckVolumesCount(t *testing.T) {
+	vols := func(n int) []v1.Volume {
+		vv := make([]v1.Volume, n)
+		for i := range vv {
+			vv[i].Name = fmt.Sprintf("v%d", i)
+		}
+		return vv
+	}
+	mounts := func(n int) []v1.VolumeMount {
+		mm := make([]v1.VolumeMount, n)
+		for i := range mm {
+			mm[i] = v1.VolumeMount{Name: fmt.Sprintf("v%d", i), MountPath: fmt.Sprintf("/v%d", i)}
+		}
+		return mm
+	}
+	uu := map[string]struct {
+		spec   v1.PodSpec
+		issues []string
+	}{
+		"below": {
+			spec: v1.PodSpec{
+				Volumes:    vols(19),
+				Containers: []v1.Container{{Name: "c1", VolumeMounts: mounts(19)}},
+			},
+		},
+		"at": {
+			spec: v1.PodSpec{
+				Volumes:    vols(20),
+				Containers: []v1.Container{{Name: "c1", VolumeMounts: mounts(20)}},
+			},
+		},
+		"above": {
+			spec: v1.PodSpec{
+				Volumes:    vols(21),
+				Containers: []v1.Container{{Name: "c1", VolumeMounts: mounts(21)}},
+			},
+			issues: []string{
+				`[POP-233] Pod declares 21 volumes which exceeds the 20 volumes threshold`,
+				`[POP-234] Container "c1" mounts 21 volumes which exceeds the 20 mounts threshold`,
+			},
+		},
+		"init-mounts": {
+			spec: v1.PodSpec{
+				InitContainers: []v1.Container{{Name: "i1", VolumeMounts: mounts(25)}},
+				Containers:     []v1.Container{{Name: "c1", VolumeMounts: mounts(2)}},
+			},
+			issues: []string{
+				`[POP-234] Container "i1" mounts 25 volumes which exceeds the 20 mounts threshold`,
+			},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			p := NewPod(test.MakeCollector(t), nil)
+			fqn := "default/p1"
+			p.InitOutcome(fqn)
+			ctx := internal.WithSpec(test.MakeContext("v1/pods", "pods"), SpecFor(fqn, nil))
+			p.checkVolumesCount(ctx, u.spec)
+
+			ii := p.Outcome()[fqn]
+			assert.Equal(t, len(u.issues), len(ii))
+			for i, m := range u.issues {
+				assert.Equal(t, m, ii[i].Message)
+				assert.Equal(t, rules.InfoLevel, ii[i].Level)
+			}
+		})
+	}
+}
+
+func TestPodCheckInitChain(t *testing.T) {
+	inits := func(n int) []v1.Container {
+		cc := make([]v1.Container, n)
+		for i := range cc {
+			cc[i].Name = fmt.Sprintf("i%d", i)
+		}
+		return cc
+	}
+	always := v1.ContainerRestartPolicyAlways
+	uu := map[string]struct {
+		max   int
+		inits []v1.Container
+		issue string
+	}{
+		"below": {
+			inits: inits(2),
+		},
+		"at": {
+			inits: inits(3),
+		},
+		"above": {
+			inits: inits(4),
+			issue: `[POP-241] Pod chains 4 init containers (i0, i1, i2, i3) which exceeds the 3 threshold. Serial init containers slow startup, consider consolidating`,
+		},
+		"sidecars": {
+			inits: append(inits(3), v1.Container{Name: "proxy", RestartPolicy: &always}),
+		},
+		"custom": {
+			max:   1,
+			inits: inits(2),
+			issue: `[POP-241] Pod chains 2 init containers (i0, i1) which exceeds the 1 threshold. Serial init containers slow startup, consider consolidating`,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			co := test.MakeCollector(t)
+			co.Config.Resources.Pod.MaxInits = u.max
+			p := NewPod(co, nil)
+			fqn := "default/p1"
+			p.InitOutcome(fqn)
+			ctx := internal.WithSpec(test.MakeContext("v1/pods", "pods"), SpecFor(fqn, nil))
+			p.checkInitChain(ctx, v1.PodSpec{InitContainers: u.inits})
+
+			ii := p.Outcome()[fqn]
+			if u.issue == "" {
+				assert.Equal(t, 0, len(ii))
+				return
+			}
+			assert.Equal(t, 1, len(ii))
+			assert.Equal(t, u.issue, ii[0].Message)
+			assert.Equal(t, rules.InfoLevel, ii[0].Level)
+		})
+	}
+}
+
+func TestPodCheckSchedulingGates(t *testing.T) {
+	uu := map[string]struct {
+		age   time.Duration
+		gates []v1.PodSchedulingGate
+		issue string
+	}{
+		"ungated": {
+			age: time.Hour,
+		},
+		"recent": {
+			age:   5 * time.Minute,
+			gates: []v1.PodSchedulingGate{{Name: "example.com/quota"}},
+		},
+		"stuck": {
+			age:   2 * time.Hour,
+			gates: []v1.PodSchedulingGate{{Name: "example.com/quota"}, {Name: "example.com/gpu"}},
+			issue: `[POP-237] Pod has been held by scheduling gates example.com/quota, example.com/gpu for 120m. The controller owning them may have failed to remove them`,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			p := NewPod(test.MakeCollector(t), nil)
+			fqn := "default/p1"
+			p.InitOutcome(fqn)
+			ctx := internal.WithSpec(test.MakeContext("v1/pods", "pods"), SpecFor(fqn, nil))
+			po := v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:         "default",
+					Name:              "p1",
+					CreationTimestamp: metav1.NewTime(time.Now().Add(-u.age)),
+				},
+				Spec: v1.PodSpec{SchedulingGates: u.gates},
+			}
+			p.checkSchedulingGates(ctx, &po)
+
+			ii := p.Outcome()[fqn]
+			if u.issue == "" {
+				assert.Equal(t, 0, len(ii))
+				return
+			}
+			assert.Equal(t, 1, len(ii))
+			assert.Equal(t, u.issue, ii[0].Message)
+			assert.Equal(t, rules.WarnLevel, ii[0].Level)
+		})
+	}
+}
+
+func TestPodCheckAffinityLabels(t *testing.T) {
+	req := func(key string, op v1.NodeSelectorOperator, vv ...string) v1.NodeSelectorRequirement {
+		return v1.NodeSelectorRequirement{Key: key, Operator: op, Values: vv}
+	}
+	uu := map[string]struct {
+		terms  []v1.NodeSelectorTerm
+		issues []string
+	}{
+		"none": {},
+		"matching": {
+			terms: []v1.NodeSelectorTerm{{MatchExpressions: []v1.NodeSelectorRequirement{
+				req("topology.kubernetes.io/zone", v1.NodeSelectorOpIn, "us-east-1a"),
+				req("disktype", v1.NodeSelectorOpExists),
+			}}},
+		},
+		"absent-negative": {
+			terms: []v1.NodeSelectorTerm{{MatchExpressions: []v1.NodeSelectorRequirement{
+				req("dedicated", v1.NodeSelectorOpDoesNotExist),
+				req("gpu", v1.NodeSelectorOpNotIn, "true"),
+			}}},
+		},
+		"typo": {
+			terms: []v1.NodeSelectorTerm{
+				{MatchExpressions: []v1.NodeSelectorRequirement{req("topology.kubernetes.io/zon", v1.NodeSelectorOpIn, "us-east-1a")}},
+				{MatchExpressions: []v1.NodeSelectorRequirement{req("topology.kubernetes.io/zon", v1.NodeSelectorOpIn, "us-east-1b")}},
+				{MatchExpressions: []v1.NodeSelectorRequirement{req("node.example.com/pool", v1.NodeSelectorOpExists)}},
+			},
+			issues: []string{
+				`[POP-238] Node affinity requires label "topology.kubernetes.io/zon" which no node carries. Likely a typo or a decommissioned label`,
+				`[POP-238] Node affinity requires label "node.example.com/pool" which no node carries. Likely a typo or a decommissioned label`,
+			},
+		},
+	}
+
+	dba, err := test.NewTestDB()
+	assert.NoError(t, err)
+	l := db.NewLoader(dba)
+	ctx := test.MakeCtx(t)
+	assert.NoError(t, test.LoadDB[*v1.Node](ctx, l.DB, "core/node/2.yaml", test.Glossary[internal.NO]))
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			p := NewPod(test.MakeCollector(t), dba)
+			fqn := "default/p1"
+			p.InitOutcome(fqn)
+			ctx := internal.WithSpec(test.MakeContext("v1/pods", "pods"), SpecFor(fqn, nil))
+			var spec v1.PodSpec
+			if len(u.terms) > 0 {
+				spec.Affinity = &v1.Affinity{NodeAffinity: &v1.NodeAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: &v1.NodeSelector{NodeSelectorTerms: u.terms},
+				}}
+			}
+			p.checkAffinityLabels(ctx, spec, listNodes(dba))
+
+			ii := p.Outcome()[fqn]
+			assert.Equal(t, len(u.issues), len(ii))
+			for i, m := range u.issues {
+				assert.Equal(t, m, ii[i].Message)
+				assert.Equal(t, rules.WarnLevel, ii[i].Level)
+			}
+		})
+	}
+}
+
+func TestPodCheckTolerations(t *testing.T) {
+	uu := map[string]struct {
+		ns          string
+		owners      []metav1.OwnerReference
+		annotations map[string]string
+		tolerations []v1.Toleration
+		issue       string
+	}{
+		"none": {
+			ns: "default",
+		},
+		"specific": {
+			ns: "default",
+			tolerations: []v1.Toleration{
+				{Key: "node.kubernetes.io/not-ready", Operator: v1.TolerationOpExists, Effect: v1.TaintEffectNoExecute},
+				{Key: "dedicated", Operator: v1.TolerationOpEqual, Value: "gpu", Effect: v1.TaintEffectNoSchedule},
+			},
+		},
+		"wildcard-no-schedule": {
+			ns:          "default",
+			tolerations: []v1.Toleration{{Operator: v1.TolerationOpExists, Effect: v1.TaintEffectNoSchedule}},
+		},
+		"everything": {
+			ns:          "default",
+			tolerations: []v1.Toleration{{Operator: v1.TolerationOpExists}},
+			issue:       `[POP-239] Wildcard toleration "*:Exists" tolerates every taint including NoExecute. Pod will not be evicted from unhealthy nodes`,
+		},
+		"no-execute": {
+			ns:          "default",
+			owners:      []metav1.OwnerReference{{Kind: "DaemonSet", Name: "ds1"}},
+			tolerations: []v1.Toleration{{Operator: v1.TolerationOpExists, Effect: v1.TaintEffectNoExecute}},
+			issue:       `[POP-239] Wildcard toleration "*:Exists:NoExecute" tolerates every taint including NoExecute. Pod will not be evicted from unhealthy nodes`,
+		},
+		"system-daemonset": {
+			ns:          "kube-system",
+			owners:      []metav1.OwnerReference{{Kind: "DaemonSet", Name: "kube-proxy"}},
+			tolerations: []v1.Toleration{{Operator: v1.TolerationOpExists}},
+		},
+		"system-static": {
+			ns:          "kube-system",
+			annotations: map[string]string{mirrorPodAnnotation: "blee"},
+			tolerations: []v1.Toleration{{Operator: v1.TolerationOpExists}},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			p := NewPod(test.MakeCollector(t), nil)
+			fqn := u.ns + "/p1"
+			p.InitOutcome(fqn)
+			ctx := internal.WithSpec(test.MakeContext("v1/pods", "pods"), SpecFor(fqn, nil))
+			po := v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:       u.ns,
+					Name:            "p1",
+					OwnerReferences: u.owners,
+					Annotations:     u.annotations,
+				},
+				Spec: v1.PodSpec{Tolerations: u.tolerations},
+			}
+			p.checkTolerations(ctx, &po)
+
+			ii := p.Outcome()[fqn]
+			if u.issue == "" {
+				assert.Equal(t, 0, len(ii))
+				return
+			}
+			assert.Equal(t, 1, len(ii))
+			assert.Equal(t, u.issue, ii[0].Message)
+			assert.Equal(t, rules.WarnLevel, ii[0].Level)
+		})
+	}
+}
@@ -31,7 +31,7 @@ func NewPersistentVolume(co *issues.Collector, db *db.DB) *PersistentVolume {
 
 // Lint cleanse the resource.
 func (s *PersistentVolume) Lint(ctx context.Context) error {
-	txn, it := s.db.MustITFor(internal.Glossary[internal.PV])
+	txn, it := s.db.MustITFor(s.db.GVR(internal.PV))
 	defer txn.Abort()
 	for o := it.Next(); o != nil; o = it.Next() {
 		pv := o.(*v1.PersistentVolume)
@@ -40,6 +40,7 @@ func (s *PersistentVolume) Lint(ctx context.Context) error {
 		ctx = internal.WithSpec(ctx, SpecFor(fqn, pv))
 
 		s.checkBound(ctx, pv.Status.Phase)
+		runChecks(ctx, internal.PV, s, pv)
 	}
 
 	return nil
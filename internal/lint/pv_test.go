@@ -20,8 +20,8 @@ func TestPVLint(t *testing.T) {
 	l := db.NewLoader(dba)
 
 	ctx := test.MakeCtx(t)
-	assert.NoError(t, test.LoadDB[*v1.PersistentVolume](ctx, l.DB, "core/pv/1.yaml", internal.Glossary[internal.PV]))
-	assert.NoError(t, test.LoadDB[*v1.Pod](ctx, l.DB, "core/pod/1.yaml", internal.Glossary[internal.PO]))
+	assert.NoError(t, test.LoadDB[*v1.PersistentVolume](ctx, l.DB, "core/pv/1.yaml", test.Glossary[internal.PV]))
+	assert.NoError(t, test.LoadDB[*v1.Pod](ctx, l.DB, "core/pod/1.yaml", test.Glossary[internal.PO]))
 
 	pv := NewPersistentVolume(test.MakeCollector(t), dba)
 	assert.Nil(t, pv.Lint(test.MakeContext("v1/persistentvolumes", "persistentvolumes")))
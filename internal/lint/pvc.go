@@ -11,7 +11,9 @@ import (
 	"github.com/derailed/popeye/internal/client"
 	"github.com/derailed/popeye/internal/db"
 	"github.com/derailed/popeye/internal/issues"
+	"github.com/derailed/popeye/types"
 	v1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
 )
 
 type (
@@ -33,7 +35,7 @@ func NewPersistentVolumeClaim(co *issues.Collector, db *db.DB) *PersistentVolume
 // Lint cleanse the resource.
 func (s *PersistentVolumeClaim) Lint(ctx context.Context) error {
 	refs := make(map[string]struct{})
-	txn, it := s.db.MustITFor(internal.Glossary[internal.PO])
+	txn, it := s.db.MustITFor(s.db.GVR(internal.PO))
 	defer txn.Abort()
 	for o := it.Next(); o != nil; o = it.Next() {
 		pod := o.(*v1.Pod)
@@ -45,7 +47,12 @@ func (s *PersistentVolumeClaim) Lint(ctx context.Context) error {
 		}
 	}
 
-	txn, it = s.db.MustITFor(internal.Glossary[internal.PVC])
+	scs, err := s.db.ListStorageClasses()
+	if err != nil {
+		return err
+	}
+
+	txn, it = s.db.MustITFor(s.db.GVR(internal.PVC))
 	defer txn.Abort()
 	for o := it.Next(); o != nil; o = it.Next() {
 		pvc := o.(*v1.PersistentVolumeClaim)
@@ -54,9 +61,11 @@ func (s *PersistentVolumeClaim) Lint(ctx context.Context) error {
 		ctx = internal.WithSpec(ctx, SpecFor(fqn, pvc))
 
 		s.checkBound(ctx, pvc.Status.Phase)
+		s.checkStorageClass(ctx, pvc, scs)
 		if _, ok := refs[fqn]; !ok {
 			s.AddCode(ctx, 400)
 		}
+		runChecks(ctx, internal.PVC, s, pvc)
 	}
 
 	return nil
@@ -70,3 +79,28 @@ func (s *PersistentVolumeClaim) checkBound(ctx context.Context, phase v1.Persist
 		s.AddCode(ctx, 1004)
 	}
 }
+
+// checkStorageClass checks unbound claims reference a provisionable storage class.
+// An explicit empty class requests a pre-provisioned volume and is skipped.
+// Claims are not checked when storage classes could not be listed.
+func (s *PersistentVolumeClaim) checkStorageClass(ctx context.Context, pvc *v1.PersistentVolumeClaim, scs map[string]*storagev1.StorageClass) {
+	if s.db.GVR(internal.SC) == types.BlankGVR || scs == nil {
+		return
+	}
+	if pvc.Status.Phase == v1.ClaimBound {
+		return
+	}
+	sc := pvc.Spec.StorageClassName
+	if sc == nil {
+		if !hasDefaultClass(scs) {
+			s.AddCode(ctx, 1006)
+		}
+		return
+	}
+	if *sc == "" {
+		return
+	}
+	if _, ok := scs[*sc]; !ok {
+		s.AddCode(ctx, 1005, *sc)
+	}
+}
@@ -12,6 +12,8 @@ import (
 	"github.com/derailed/popeye/internal/test"
 	"github.com/stretchr/testify/assert"
 	v1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func TestPVCLint(t *testing.T) {
@@ -20,8 +22,9 @@ func TestPVCLint(t *testing.T) {
 	l := db.NewLoader(dba)
 
 	ctx := test.MakeCtx(t)
-	assert.NoError(t, test.LoadDB[*v1.PersistentVolumeClaim](ctx, l.DB, "core/pvc/1.yaml", internal.Glossary[internal.PVC]))
-	assert.NoError(t, test.LoadDB[*v1.Pod](ctx, l.DB, "core/pod/1.yaml", internal.Glossary[internal.PO]))
+	assert.NoError(t, test.LoadDB[*v1.PersistentVolumeClaim](ctx, l.DB, "core/pvc/1.yaml", test.Glossary[internal.PVC]))
+	assert.NoError(t, test.LoadDB[*v1.Pod](ctx, l.DB, "core/pod/1.yaml", test.Glossary[internal.PO]))
+	assert.NoError(t, test.LoadDB[*storagev1.StorageClass](ctx, l.DB, "storage/sc/1.yaml", test.Glossary[internal.SC]))
 
 	pvc := NewPersistentVolumeClaim(test.MakeCollector(t), dba)
 	assert.Nil(t, pvc.Lint(test.MakeContext("v1/persistentvolumeclaims", "persistentvolumeclaims")))
@@ -44,3 +47,80 @@ func TestPVCLint(t *testing.T) {
 	assert.Equal(t, `[POP-400] Used? Unable to locate resource reference`, ii[1].Message)
 	assert.Equal(t, rules.InfoLevel, ii[1].Level)
 }
+
+func TestPVCCheckStorageClass(t *testing.T) {
+	standard, dangling, blank := "standard", "zorg", ""
+	uu := map[string]struct {
+		sc     string
+		class  *string
+		phase  v1.PersistentVolumeClaimPhase
+		issues []string
+	}{
+		"valid": {
+			sc:    "storage/sc/1.yaml",
+			class: &standard,
+			phase: v1.ClaimPending,
+		},
+		"dangling": {
+			sc:     "storage/sc/1.yaml",
+			class:  &dangling,
+			phase:  v1.ClaimPending,
+			issues: []string{`[POP-1005] StorageClass "zorg" does not exist. Claim will remain pending`},
+		},
+		"dangling-bound": {
+			sc:    "storage/sc/1.yaml",
+			class: &dangling,
+			phase: v1.ClaimBound,
+		},
+		"empty-no-default": {
+			sc:     "storage/sc/1.yaml",
+			phase:  v1.ClaimPending,
+			issues: []string{`[POP-1006] No StorageClass specified and no default StorageClass found`},
+		},
+		"empty-default": {
+			sc:    "storage/sc/2.yaml",
+			phase: v1.ClaimPending,
+		},
+		"explicit-blank": {
+			sc:    "storage/sc/1.yaml",
+			class: &blank,
+			phase: v1.ClaimPending,
+		},
+		"no-classes": {
+			class: &dangling,
+			phase: v1.ClaimPending,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			dba, err := test.NewTestDB()
+			assert.NoError(t, err)
+			l := db.NewLoader(dba)
+			ctx := test.MakeCtx(t)
+			var scs map[string]*storagev1.StorageClass
+			if u.sc != "" {
+				assert.NoError(t, test.LoadDB[*storagev1.StorageClass](ctx, l.DB, u.sc, test.Glossary[internal.SC]))
+				scs, err = dba.ListStorageClasses()
+				assert.NoError(t, err)
+			}
+
+			s := NewPersistentVolumeClaim(test.MakeCollector(t), dba)
+			ctx = test.MakeContext("v1/persistentvolumeclaims", "persistentvolumeclaims")
+			ctx = internal.WithSpec(ctx, SpecFor("default/pvc1", nil))
+			pvc := v1.PersistentVolumeClaim{
+				ObjectMeta: metav1.ObjectMeta{Name: "pvc1", Namespace: "default"},
+				Spec:       v1.PersistentVolumeClaimSpec{StorageClassName: u.class},
+				Status:     v1.PersistentVolumeClaimStatus{Phase: u.phase},
+			}
+			s.checkStorageClass(ctx, &pvc, scs)
+
+			ii := s.Outcome()["default/pvc1"]
+			assert.Equal(t, len(u.issues), len(ii))
+			for i, m := range u.issues {
+				assert.Equal(t, m, ii[i].Message)
+			}
+		})
+	}
+}
@@ -39,7 +39,7 @@ func (r *RoleBinding) Lint(ctx context.Context) error {
 }
 
 func (r *RoleBinding) checkInUse(ctx context.Context) {
-	txn, it := r.db.MustITFor(internal.Glossary[internal.ROB])
+	txn, it := r.db.MustITFor(r.db.GVR(internal.ROB))
 	defer txn.Abort()
 	for o := it.Next(); o != nil; o = it.Next() {
 		rb := o.(*rbacv1.RoleBinding)
@@ -49,14 +49,15 @@ func (r *RoleBinding) checkInUse(ctx context.Context) {
 
 		switch rb.RoleRef.Kind {
 		case "ClusterRole":
-			if !r.db.Exists(internal.Glossary[internal.CR], rb.RoleRef.Name) {
+			if !r.db.Exists(r.db.GVR(internal.CR), rb.RoleRef.Name) {
 				r.AddCode(ctx, 1300, rb.RoleRef.Kind, rb.RoleRef.Name)
 			}
 		case "Role":
 			rFQN := cache.FQN(rb.Namespace, rb.RoleRef.Name)
-			if !r.db.Exists(internal.Glossary[internal.RO], rFQN) {
+			if !r.db.Exists(r.db.GVR(internal.RO), rFQN) {
 				r.AddCode(ctx, 1300, rb.RoleRef.Kind, rFQN)
 			}
 		}
+		runChecks(ctx, internal.ROB, r, rb)
 	}
 }
@@ -0,0 +1,23 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Popeye
+
+package lint
+
+import (
+	"context"
+	"strconv"
+)
+
+// maxRevisionHistory tracks the largest sane revisionHistoryLimit.
+const maxRevisionHistory = 10
+
+// checkRevisionHistory flags workloads keeping an unbounded or very large
+// number of old revisions around.
+func checkRevisionHistory(ctx context.Context, c Collector, limit *int32) {
+	switch {
+	case limit == nil:
+		c.AddCode(ctx, 510, "unset", maxRevisionHistory)
+	case *limit > maxRevisionHistory:
+		c.AddCode(ctx, 510, strconv.Itoa(int(*limit)), maxRevisionHistory)
+	}
+}
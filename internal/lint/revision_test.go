@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Popeye
+
+package lint
+
+import (
+	"testing"
+
+	"github.com/derailed/popeye/internal"
+	"github.com/derailed/popeye/internal/rules"
+	"github.com/derailed/popeye/internal/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckRevisionHistory(t *testing.T) {
+	uu := map[string]struct {
+		limit int32
+		unset bool
+		issue string
+	}{
+		"unset": {
+			unset: true,
+			issue: "[POP-510] Unbounded revisionHistoryLimit (unset). Old revisions accumulate in etcd. Consider a limit of 10 or less",
+		},
+		"large": {
+			limit: 100,
+			issue: "[POP-510] Unbounded revisionHistoryLimit (100). Old revisions accumulate in etcd. Consider a limit of 10 or less",
+		},
+		"happy": {
+			limit: 5,
+		},
+		"max": {
+			limit: 10,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			co := test.MakeCollector(t)
+			co.InitOutcome("default/dp1")
+			ctx := test.MakeContext("apps/v1/deployments", "deployments")
+			ctx = internal.WithSpec(ctx, SpecFor("default/dp1", nil))
+			limit := &u.limit
+			if u.unset {
+				limit = nil
+			}
+			checkRevisionHistory(ctx, co, limit)
+
+			ii := co.Outcome()["default/dp1"]
+			if u.issue == "" {
+				assert.Equal(t, 0, len(ii))
+				return
+			}
+			assert.Equal(t, 1, len(ii))
+			assert.Equal(t, u.issue, ii[0].Message)
+			assert.Equal(t, rules.InfoLevel, ii[0].Level)
+		})
+	}
+}
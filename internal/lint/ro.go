@@ -48,7 +48,7 @@ func (s *Role) Lint(ctx context.Context) error {
 }
 
 func (s *Role) checkInUse(ctx context.Context, refs *sync.Map) {
-	txn, it := s.db.MustITFor(internal.Glossary[internal.RO])
+	txn, it := s.db.MustITFor(s.db.GVR(internal.RO))
 	defer txn.Abort()
 	for o := it.Next(); o != nil; o = it.Next() {
 		ro := o.(*rbacv1.Role)
@@ -59,5 +59,6 @@ func (s *Role) checkInUse(ctx context.Context, refs *sync.Map) {
 		if _, ok := refs.Load(cache.ResFqn(cache.RoleKey, fqn)); !ok {
 			s.AddCode(ctx, 400)
 		}
+		runChecks(ctx, internal.RO, s, ro)
 	}
 }
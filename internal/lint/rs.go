@@ -30,7 +30,7 @@ func NewReplicaSet(co *issues.Collector, db *db.DB) *ReplicaSet {
 
 // Lint cleanse the resource.
 func (s *ReplicaSet) Lint(ctx context.Context) error {
-	txn, it := s.db.MustITFor(internal.Glossary[internal.RS])
+	txn, it := s.db.MustITFor(s.db.GVR(internal.RS))
 	defer txn.Abort()
 	for o := it.Next(); o != nil; o = it.Next() {
 		rs := o.(*appsv1.ReplicaSet)
@@ -39,6 +39,7 @@ func (s *ReplicaSet) Lint(ctx context.Context) error {
 		ctx = internal.WithSpec(ctx, SpecFor(fqn, rs))
 
 		s.checkHealth(ctx, rs)
+		runChecks(ctx, internal.RS, s, rs)
 	}
 
 	return nil
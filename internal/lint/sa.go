@@ -46,7 +46,7 @@ func (s *ServiceAccount) Lint(ctx context.Context) error {
 		return err
 	}
 
-	txn, it := s.db.MustITFor(internal.Glossary[internal.SA])
+	txn, it := s.db.MustITFor(s.db.GVR(internal.SA))
 	defer txn.Abort()
 	for o := it.Next(); o != nil; o = it.Next() {
 		sa := o.(*v1.ServiceAccount)
@@ -60,6 +60,7 @@ func (s *ServiceAccount) Lint(ctx context.Context) error {
 		if _, ok := refs[fqn]; !ok && sa.Name != defaultSA {
 			s.AddCode(ctx, 400)
 		}
+		runChecks(ctx, internal.SA, s, sa)
 	}
 
 	return nil
@@ -72,7 +73,7 @@ func (s *ServiceAccount) checkSecretRefs(ctx context.Context, fqn string, refs [
 			ns = ref.Namespace
 		}
 		sfqn := cache.FQN(ns, ref.Name)
-		if !s.db.Exists(internal.Glossary[internal.SEC], sfqn) {
+		if !s.db.Exists(s.db.GVR(internal.SEC), sfqn) {
 			s.AddCode(ctx, 304, sfqn)
 		}
 	}
@@ -82,7 +83,7 @@ func (s *ServiceAccount) checkPullSecretRefs(ctx context.Context, fqn string, re
 	ns, _ := namespaced(fqn)
 	for _, ref := range refs {
 		sfqn := cache.FQN(ns, ref.Name)
-		if !s.db.Exists(internal.Glossary[internal.SEC], sfqn) {
+		if !s.db.Exists(s.db.GVR(internal.SEC), sfqn) {
 			s.AddCode(ctx, 305, sfqn)
 		}
 	}
@@ -97,7 +98,7 @@ func (s *ServiceAccount) checkMounts(ctx context.Context, b *bool) {
 func (s *ServiceAccount) crbRefs(refs map[string]struct{}) error {
 	txn := s.db.Txn(false)
 	defer txn.Abort()
-	it, err := txn.Get(internal.Glossary[internal.CRB].String(), "id")
+	it, err := txn.Get(s.db.GVR(internal.CRB).String(), "id")
 	if err != nil {
 		return err
 	}
@@ -112,7 +113,7 @@ func (s *ServiceAccount) crbRefs(refs map[string]struct{}) error {
 func (s *ServiceAccount) rbRefs(refs map[string]struct{}) error {
 	txn := s.db.Txn(false)
 	defer txn.Abort()
-	it, err := txn.Get(internal.Glossary[internal.ROB].String(), "id")
+	it, err := txn.Get(s.db.GVR(internal.ROB).String(), "id")
 	if err != nil {
 		return err
 	}
@@ -127,7 +128,7 @@ func (s *ServiceAccount) rbRefs(refs map[string]struct{}) error {
 func (s *ServiceAccount) podRefs(refs map[string]struct{}) error {
 	txn := s.db.Txn(false)
 	defer txn.Abort()
-	it, err := txn.Get(internal.Glossary[internal.PO].String(), "id")
+	it, err := txn.Get(s.db.GVR(internal.PO).String(), "id")
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,149 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Popeye
+
+package lint
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/derailed/popeye/internal"
+	"github.com/derailed/popeye/internal/client"
+	"github.com/derailed/popeye/internal/db"
+	"github.com/derailed/popeye/internal/issues"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+)
+
+var defaultClassAnnotations = []string{
+	"storageclass.kubernetes.io/is-default-class",
+	"storageclass.beta.kubernetes.io/is-default-class",
+}
+
+type (
+	// StorageClass tracks StorageClass sanitization.
+	StorageClass struct {
+		*issues.Collector
+
+		db *db.DB
+	}
+)
+
+// NewStorageClass returns a new instance.
+func NewStorageClass(co *issues.Collector, db *db.DB) *StorageClass {
+	return &StorageClass{
+		Collector: co,
+		db:        db,
+	}
+}
+
+// Lint cleanse the resource.
+func (s *StorageClass) Lint(ctx context.Context) error {
+	scs, err := s.db.ListStorageClasses()
+	if err != nil {
+		return err
+	}
+	defaults := defaultClasses(scs)
+	users := s.statefulUsers(defaults)
+
+	kk := make([]string, 0, len(scs))
+	for k := range scs {
+		kk = append(kk, k)
+	}
+	sort.Strings(kk)
+	for _, k := range kk {
+		sc := scs[k]
+		fqn := client.FQN(sc.Namespace, sc.Name)
+		s.InitOutcome(fqn)
+		ctx = internal.WithSpec(ctx, SpecFor(fqn, sc))
+		s.checkDefault(ctx, sc, defaults)
+		s.checkReclaim(ctx, sc, users[sc.Name])
+		s.checkExpansion(ctx, sc)
+		runChecks(ctx, internal.SC, s, sc)
+	}
+
+	return nil
+}
+
+func (s *StorageClass) checkDefault(ctx context.Context, sc *storagev1.StorageClass, defaults []string) {
+	switch {
+	case len(defaults) == 0:
+		s.AddCode(ctx, 1800)
+	case len(defaults) > 1 && isDefaultClass(sc):
+		s.AddCode(ctx, 1801, strings.Join(defaults, ", "))
+	}
+}
+
+func (s *StorageClass) checkReclaim(ctx context.Context, sc *storagev1.StorageClass, sts []string) {
+	if len(sts) == 0 {
+		return
+	}
+	if sc.ReclaimPolicy == nil || *sc.ReclaimPolicy == v1.PersistentVolumeReclaimDelete {
+		s.AddCode(ctx, 1802, strings.Join(sts, ", "))
+	}
+}
+
+func (s *StorageClass) checkExpansion(ctx context.Context, sc *storagev1.StorageClass) {
+	if sc.AllowVolumeExpansion == nil || !*sc.AllowVolumeExpansion {
+		s.AddCode(ctx, 1803)
+	}
+}
+
+// statefulUsers returns the statefulsets claiming volumes per storage class.
+func (s *StorageClass) statefulUsers(defaults []string) map[string][]string {
+	users := make(map[string][]string)
+	txn, it := s.db.MustITFor(s.db.GVR(internal.STS))
+	defer txn.Abort()
+	for o := it.Next(); o != nil; o = it.Next() {
+		sts := o.(*appsv1.StatefulSet)
+		for _, pvc := range sts.Spec.VolumeClaimTemplates {
+			sc := pvc.Spec.StorageClassName
+			switch {
+			case sc != nil && *sc != "":
+				users[*sc] = appendUnique(users[*sc], client.FQN(sts.Namespace, sts.Name))
+			case sc == nil && len(defaults) == 1:
+				users[defaults[0]] = appendUnique(users[defaults[0]], client.FQN(sts.Namespace, sts.Name))
+			}
+		}
+	}
+
+	return users
+}
+
+func appendUnique(ss []string, s string) []string {
+	for _, v := range ss {
+		if v == s {
+			return ss
+		}
+	}
+
+	return append(ss, s)
+}
+
+func defaultClasses(scs map[string]*storagev1.StorageClass) []string {
+	dd := make([]string, 0, 1)
+	for _, sc := range scs {
+		if isDefaultClass(sc) {
+			dd = append(dd, sc.Name)
+		}
+	}
+	sort.Strings(dd)
+
+	return dd
+}
+
+func hasDefaultClass(scs map[string]*storagev1.StorageClass) bool {
+	return len(defaultClasses(scs)) > 0
+}
+
+func isDefaultClass(sc *storagev1.StorageClass) bool {
+	for _, a := range defaultClassAnnotations {
+		if sc.Annotations[a] == "true" {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,82 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Popeye
+
+package lint
+
+import (
+	"testing"
+
+	"github.com/derailed/popeye/internal"
+	"github.com/derailed/popeye/internal/db"
+	"github.com/derailed/popeye/internal/test"
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	storagev1 "k8s.io/api/storage/v1"
+)
+
+func TestStorageClassLint(t *testing.T) {
+	uu := map[string]struct {
+		sc     string
+		issues map[string][]string
+	}{
+		"no-default": {
+			sc: "storage/sc/1.yaml",
+			issues: map[string][]string{
+				"fast": {
+					`[POP-1800] No default StorageClass defined. Claims without a class will not be provisioned`,
+					`[POP-1802] Reclaim policy Delete used by StatefulSet(s) default/cache. Volumes are deleted when claims are released`,
+					`[POP-1803] Volume expansion is not allowed`,
+				},
+				"standard": {
+					`[POP-1800] No default StorageClass defined. Claims without a class will not be provisioned`,
+					`[POP-1803] Volume expansion is not allowed`,
+				},
+			},
+		},
+		"single-default": {
+			sc: "storage/sc/2.yaml",
+			issues: map[string][]string{
+				"standard": {
+					`[POP-1802] Reclaim policy Delete used by StatefulSet(s) default/db. Volumes are deleted when claims are released`,
+					`[POP-1803] Volume expansion is not allowed`,
+				},
+			},
+		},
+		"multiple-defaults": {
+			sc: "storage/sc/3.yaml",
+			issues: map[string][]string{
+				"fast": {
+					`[POP-1801] Multiple default StorageClasses defined: fast, standard`,
+					`[POP-1802] Reclaim policy Delete used by StatefulSet(s) default/cache. Volumes are deleted when claims are released`,
+				},
+				"slow": {},
+				"standard": {
+					`[POP-1801] Multiple default StorageClasses defined: fast, standard`,
+				},
+			},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			dba, err := test.NewTestDB()
+			assert.NoError(t, err)
+			l := db.NewLoader(dba)
+			ctx := test.MakeCtx(t)
+			assert.NoError(t, test.LoadDB[*storagev1.StorageClass](ctx, l.DB, u.sc, test.Glossary[internal.SC]))
+			assert.NoError(t, test.LoadDB[*appsv1.StatefulSet](ctx, l.DB, "apps/sts/2.yaml", test.Glossary[internal.STS]))
+
+			sc := NewStorageClass(test.MakeCollector(t), dba)
+			assert.Nil(t, sc.Lint(test.MakeContext("storage.k8s.io/v1/storageclasses", "storageclasses")))
+			assert.Equal(t, len(u.issues), len(sc.Outcome()))
+			for fqn, ee := range u.issues {
+				ii := sc.Outcome()[fqn]
+				assert.Equal(t, len(ee), len(ii), fqn)
+				for i, m := range ee {
+					assert.Equal(t, m, ii[i].Message)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,50 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Popeye
+
+package lint
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/robfig/cron/v3"
+)
+
+const (
+	// maxScheduleDays bounds how far ahead firings are simulated.
+	maxScheduleDays = 2 * 366
+
+	// maxScheduleRuns bounds how many firings are inspected.
+	maxScheduleRuns = 1_000
+)
+
+// scheduleInterval returns the shortest time between two firings of a
+// standard cron schedule, parsed the same way the CronJob controller does.
+func scheduleInterval(spec string) (time.Duration, error) {
+	sched, err := cron.ParseStandard(spec)
+	if err != nil {
+		return 0, err
+	}
+
+	var (
+		start    = time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+		end      = start.AddDate(0, 0, maxScheduleDays)
+		prev     = sched.Next(start)
+		shortest time.Duration
+	)
+	for runs := 0; runs < maxScheduleRuns && !prev.IsZero() && prev.Before(end); runs++ {
+		next := sched.Next(prev)
+		if next.IsZero() {
+			break
+		}
+		if gap := next.Sub(prev); shortest == 0 || gap < shortest {
+			shortest = gap
+		}
+		prev = next
+	}
+	if shortest == 0 {
+		return 0, fmt.Errorf("schedule %q fires at most once", spec)
+	}
+
+	return shortest, nil
+}
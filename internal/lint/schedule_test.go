@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Popeye
+
+package lint
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_scheduleInterval(t *testing.T) {
+	uu := map[string]struct {
+		spec string
+		e    time.Duration
+		err  bool
+	}{
+		"every-minute": {
+			spec: "* * * * *",
+			e:    time.Minute,
+		},
+		"step": {
+			spec: "*/15 * * * *",
+			e:    15 * time.Minute,
+		},
+		"list": {
+			spec: "0,10,45 * * * *",
+			e:    10 * time.Minute,
+		},
+		"range-step": {
+			spec: "0 8-18/2 * * mon-fri",
+			e:    2 * time.Hour,
+		},
+		"daily": {
+			spec: "@daily",
+			e:    24 * time.Hour,
+		},
+		"weekly-names": {
+			spec: "30 2 * * SUN",
+			e:    7 * 24 * time.Hour,
+		},
+		"dom-or-dow": {
+			spec: "0 0 1 * 1",
+			e:    24 * time.Hour,
+		},
+		"star-step-dom": {
+			spec: "0 0 */2 * 1",
+			e:    24 * time.Hour,
+		},
+		"timezone": {
+			spec: "CRON_TZ=Europe/Paris */5 * * * *",
+			e:    5 * time.Minute,
+		},
+		"every": {
+			spec: "@every 90s",
+			e:    90 * time.Second,
+		},
+		"bad-fields": {
+			spec: "* * *",
+			err:  true,
+		},
+		"bad-range": {
+			spec: "61 * * * *",
+			err:  true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			d, err := scheduleInterval(u.spec)
+			if u.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, u.e, d)
+		})
+	}
+}
@@ -5,7 +5,10 @@ package lint
 
 import (
 	"context"
+	"crypto/x509"
+	"encoding/pem"
 	"sync"
+	"time"
 
 	"github.com/derailed/popeye/internal"
 	"github.com/derailed/popeye/internal/cache"
@@ -13,6 +16,7 @@ import (
 	"github.com/derailed/popeye/internal/db"
 	"github.com/derailed/popeye/internal/issues"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/duration"
 )
 
 // Secret tracks Secret sanitization.
@@ -55,7 +59,7 @@ func (s *Secret) Lint(ctx context.Context) error {
 }
 
 func (s *Secret) checkStale(ctx context.Context, refs *sync.Map) {
-	txn, it := s.db.MustITFor(internal.Glossary[internal.SEC])
+	txn, it := s.db.MustITFor(s.db.GVR(internal.SEC))
 	defer txn.Abort()
 	for o := it.Next(); o != nil; o = it.Next() {
 		sec := o.(*v1.Secret)
@@ -66,26 +70,54 @@ func (s *Secret) checkStale(ctx context.Context, refs *sync.Map) {
 		if s.system.skip(fqn) {
 			continue
 		}
-		refs.Range(func(k, v interface{}) bool {
-			return true
-		})
+		checkHelmOwnership(ctx, s, sec.ObjectMeta)
+		s.checkCertExpiry(ctx, sec)
+		s.checkKeys(ctx, refs, fqn, sec.Data)
+		runChecks(ctx, internal.SEC, s, sec)
+	}
+}
 
-		keys, ok := refs.Load(cache.ResFqn(cache.SecretKey, fqn))
-		if !ok {
-			s.AddCode(ctx, 400)
-			continue
-		}
-		if keys.(internal.StringSet).Has(internal.All) {
-			continue
-		}
+// checkKeys flags unused Secrets and unreferenced keys.
+func (s *Secret) checkKeys(ctx context.Context, refs *sync.Map, fqn string, data map[string][]byte) {
+	keys, ok := refs.Load(cache.ResFqn(cache.SecretKey, fqn))
+	if !ok {
+		s.AddCode(ctx, 400)
+		return
+	}
+	if keys.(internal.StringSet).Has(internal.All) {
+		return
+	}
+	kk := make(internal.StringSet, len(data))
+	for k := range data {
+		kk.Add(k)
+	}
+	deltas := keys.(internal.StringSet).Diff(kk)
+	for k := range deltas {
+		s.AddCode(ctx, 401, k)
+	}
+}
 
-		kk := make(internal.StringSet, len(sec.Data))
-		for k := range sec.Data {
-			kk.Add(k)
-		}
-		deltas := keys.(internal.StringSet).Diff(kk)
-		for k := range deltas {
-			s.AddCode(ctx, 401, k)
-		}
+// checkCertExpiry flags TLS secrets holding an expired or soon to expire
+// certificate. Only the public certificate is inspected.
+func (s *Secret) checkCertExpiry(ctx context.Context, sec *v1.Secret) {
+	if sec.Type != v1.SecretTypeTLS {
+		return
+	}
+	b, _ := pem.Decode(sec.Data[v1.TLSCertKey])
+	if b == nil || b.Type != "CERTIFICATE" {
+		return
+	}
+	cert, err := x509.ParseCertificate(b.Bytes)
+	if err != nil {
+		return
+	}
+	subject, expiry := cert.Subject.String(), cert.NotAfter.UTC().Format(time.DateOnly)
+	left := time.Until(cert.NotAfter)
+	if left <= 0 {
+		s.AddCode(ctx, 309, subject, expiry)
+		return
+	}
+	if w := s.CertExpiryWindow(); left <= w {
+		s.AddCode(ctx, 308, subject, expiry, duration.HumanDuration(w))
 	}
 }
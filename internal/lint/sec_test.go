@@ -4,7 +4,15 @@
 package lint
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
 	"testing"
+	"time"
 
 	"github.com/derailed/popeye/internal"
 	"github.com/derailed/popeye/internal/db"
@@ -13,6 +21,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	v1 "k8s.io/api/core/v1"
 	netv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func TestSecretLint(t *testing.T) {
@@ -21,10 +30,10 @@ func TestSecretLint(t *testing.T) {
 	l := db.NewLoader(dba)
 
 	ctx := test.MakeCtx(t)
-	assert.NoError(t, test.LoadDB[*v1.Secret](ctx, l.DB, "core/secret/1.yaml", internal.Glossary[internal.SEC]))
-	assert.NoError(t, test.LoadDB[*v1.Pod](ctx, l.DB, "core/pod/1.yaml", internal.Glossary[internal.PO]))
-	assert.NoError(t, test.LoadDB[*v1.ServiceAccount](ctx, l.DB, "core/sa/1.yaml", internal.Glossary[internal.SA]))
-	assert.NoError(t, test.LoadDB[*netv1.Ingress](ctx, l.DB, "net/ingress/1.yaml", internal.Glossary[internal.ING]))
+	assert.NoError(t, test.LoadDB[*v1.Secret](ctx, l.DB, "core/secret/1.yaml", test.Glossary[internal.SEC]))
+	assert.NoError(t, test.LoadDB[*v1.Pod](ctx, l.DB, "core/pod/1.yaml", test.Glossary[internal.PO]))
+	assert.NoError(t, test.LoadDB[*v1.ServiceAccount](ctx, l.DB, "core/sa/1.yaml", test.Glossary[internal.SA]))
+	assert.NoError(t, test.LoadDB[*netv1.Ingress](ctx, l.DB, "net/ingress/1.yaml", test.Glossary[internal.ING]))
 
 	sec := NewSecret(test.MakeCollector(t), dba)
 	assert.Nil(t, sec.Lint(test.MakeContext("v1/secrets", "secrets")))
@@ -46,3 +55,80 @@ func TestSecretLint(t *testing.T) {
 	assert.Equal(t, rules.InfoLevel, ii[0].Level)
 
 }
+
+func TestSecretCheckCertExpiry(t *testing.T) {
+	valid, soon, expired := time.Now().Add(90*24*time.Hour), time.Now().Add(10*24*time.Hour), time.Now().Add(-time.Hour)
+	uu := map[string]struct {
+		kind  v1.SecretType
+		cert  []byte
+		issue string
+		level rules.Level
+	}{
+		"valid": {
+			kind: v1.SecretTypeTLS,
+			cert: makeTestCert(t, "valid.example.com", valid),
+		},
+		"expiring": {
+			kind:  v1.SecretTypeTLS,
+			cert:  makeTestCert(t, "soon.example.com", soon),
+			issue: `[POP-308] TLS certificate "CN=soon.example.com" expires on ` + soon.UTC().Format(time.DateOnly) + `, within the 30d expiry window`,
+			level: rules.WarnLevel,
+		},
+		"expired": {
+			kind:  v1.SecretTypeTLS,
+			cert:  makeTestCert(t, "old.example.com", expired),
+			issue: `[POP-309] TLS certificate "CN=old.example.com" expired on ` + expired.UTC().Format(time.DateOnly),
+			level: rules.ErrorLevel,
+		},
+		"opaque": {
+			kind: v1.SecretTypeOpaque,
+			cert: makeTestCert(t, "old.example.com", expired),
+		},
+		"garbage": {
+			kind: v1.SecretTypeTLS,
+			cert: []byte("blee"),
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			s := NewSecret(test.MakeCollector(t), nil)
+			fqn := "default/sec1"
+			s.InitOutcome(fqn)
+			ctx := internal.WithSpec(test.MakeContext("v1/secrets", "secrets"), SpecFor(fqn, nil))
+			s.checkCertExpiry(ctx, &v1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "sec1"},
+				Type:       u.kind,
+				Data:       map[string][]byte{v1.TLSCertKey: u.cert},
+			})
+
+			ii := s.Outcome()[fqn]
+			if u.issue == "" {
+				assert.Equal(t, 0, len(ii))
+				return
+			}
+			assert.Equal(t, 1, len(ii))
+			assert.Equal(t, u.issue, ii[0].Message)
+			assert.Equal(t, u.level, ii[0].Level)
+		})
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func makeTestCert(t *testing.T, cn string, notAfter time.Time) []byte {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	tpl := x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: cn},
+		NotBefore:    notAfter.Add(-365 * 24 * time.Hour),
+		NotAfter:     notAfter,
+	}
+	raw, err := x509.CreateCertificate(rand.Reader, &tpl, &tpl, &key.PublicKey, key)
+	assert.NoError(t, err)
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: raw})
+}
@@ -0,0 +1,70 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Popeye
+
+package lint
+
+import (
+	"context"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+// checkImageSkew flags containers running the same image repository at a
+// different major version than the first container using it.
+func checkImageSkew(ctx context.Context, c Collector, spec v1.PodSpec) {
+	cc := make([]v1.Container, 0, len(spec.InitContainers)+len(spec.Containers))
+	cc = append(cc, spec.Containers...)
+	for _, co := range spec.InitContainers {
+		if restartableInitCO(co.RestartPolicy) {
+			cc = append(cc, co)
+		}
+	}
+
+	type ref struct {
+		co, tag, major string
+	}
+	refs := make(map[string]ref)
+	for _, co := range cc {
+		repo, tag := splitImage(co.Image)
+		major, ok := majorVersion(tag)
+		if !ok {
+			continue
+		}
+		r, seen := refs[repo]
+		if !seen {
+			refs[repo] = ref{co: co.Name, tag: tag, major: major}
+			continue
+		}
+		if r.major != major {
+			c.AddCode(ctx, 521, r.co, co.Name, repo, r.tag, tag)
+		}
+	}
+}
+
+// splitImage splits an image reference into its repository and tag.
+func splitImage(image string) (string, string) {
+	if i := strings.Index(image, "@"); i >= 0 {
+		image = image[:i]
+	}
+	i := strings.LastIndex(image, ":")
+	if i < 0 || i < strings.LastIndex(image, "/") {
+		return image, ""
+	}
+
+	return image[:i], image[i+1:]
+}
+
+// majorVersion extracts the leading major version from a tag like v1.2.3.
+func majorVersion(tag string) (string, bool) {
+	tag = strings.TrimPrefix(strings.TrimPrefix(tag, "v"), "V")
+	i := 0
+	for i < len(tag) && tag[i] >= '0' && tag[i] <= '9' {
+		i++
+	}
+	if i == 0 {
+		return "", false
+	}
+
+	return tag[:i], true
+}
@@ -0,0 +1,77 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Popeye
+
+package lint
+
+import (
+	"testing"
+
+	"github.com/derailed/popeye/internal"
+	"github.com/derailed/popeye/internal/rules"
+	"github.com/derailed/popeye/internal/test"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+)
+
+func TestCheckImageSkew(t *testing.T) {
+	always := v1.ContainerRestartPolicyAlways
+	uu := map[string]struct {
+		inits, cos []v1.Container
+		issue      string
+	}{
+		"aligned": {
+			cos: []v1.Container{
+				{Name: "app", Image: "example.com/team/app:v2.3.1"},
+				{Name: "agent", Image: "example.com/team/app:v2.1.0"},
+			},
+		},
+		"distinct-repos": {
+			cos: []v1.Container{
+				{Name: "app", Image: "nginx:1.25"},
+				{Name: "envoy", Image: "envoyproxy/envoy:v2.0.0"},
+			},
+		},
+		"untagged": {
+			cos: []v1.Container{
+				{Name: "app", Image: "registry:5000/app:3.1"},
+				{Name: "agent", Image: "registry:5000/app"},
+			},
+		},
+		"skewed": {
+			cos: []v1.Container{
+				{Name: "app", Image: "registry:5000/team/app:v3.0.0"},
+				{Name: "helper", Image: "registry:5000/team/app:2.9-alpine@sha256:abc"},
+			},
+			issue: `[POP-521] Containers "app" and "helper" run registry:5000/team/app at skewed major versions v3.0.0 and 2.9-alpine. Forgotten sidecar upgrade?`,
+		},
+		"skewed-sidecar": {
+			inits: []v1.Container{{Name: "proxy", Image: "example.com/proxy:1.4", RestartPolicy: &always}},
+			cos:   []v1.Container{{Name: "app", Image: "example.com/proxy:2.0"}},
+			issue: `[POP-521] Containers "app" and "proxy" run example.com/proxy at skewed major versions 2.0 and 1.4. Forgotten sidecar upgrade?`,
+		},
+		"init-skipped": {
+			inits: []v1.Container{{Name: "migrate", Image: "example.com/app:1.0"}},
+			cos:   []v1.Container{{Name: "app", Image: "example.com/app:2.0"}},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			co := test.MakeCollector(t)
+			co.InitOutcome("default/dp1")
+			ctx := test.MakeContext("apps/v1/deployments", "deployments")
+			ctx = internal.WithSpec(ctx, SpecFor("default/dp1", nil))
+			checkImageSkew(ctx, co, v1.PodSpec{InitContainers: u.inits, Containers: u.cos})
+
+			ii := co.Outcome()["default/dp1"]
+			if u.issue == "" {
+				assert.Equal(t, 0, len(ii))
+				return
+			}
+			assert.Equal(t, 1, len(ii))
+			assert.Equal(t, u.issue, ii[0].Message)
+			assert.Equal(t, rules.InfoLevel, ii[0].Level)
+		})
+	}
+}
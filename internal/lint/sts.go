@@ -42,7 +42,8 @@ func NewStatefulSet(co *issues.Collector, db *db.DB) *StatefulSet {
 // Lint cleanse the resource.
 func (s *StatefulSet) Lint(ctx context.Context) error {
 	over := pullOverAllocs(ctx)
-	txn, it := s.db.MustITFor(internal.Glossary[internal.STS])
+	ctx = withAllocatable(ctx, s.db)
+	txn, it := s.db.MustITFor(s.db.GVR(internal.STS))
 	defer txn.Abort()
 	for o := it.Next(); o != nil; o = it.Next() {
 		sts := o.(*appsv1.StatefulSet)
@@ -50,9 +51,19 @@ func (s *StatefulSet) Lint(ctx context.Context) error {
 		s.InitOutcome(fqn)
 		ctx = internal.WithSpec(ctx, SpecFor(fqn, sts))
 
+		checkHelmOwnership(ctx, s, sts.ObjectMeta)
+		checkConfigHash(ctx, s, s.db, s.ConfigHashAnnotation(), sts.Namespace, sts.Spec.Template)
+		checkRecommendedLabels(ctx, s, s.RecommendedLabels(), sts.ObjectMeta, sts.Spec.Template.ObjectMeta)
 		s.checkStatefulSet(ctx, sts)
+		checkGeneration(ctx, s, "StatefulSet", sts.ObjectMeta, sts.Status.ObservedGeneration)
+		s.checkPodManagement(ctx, sts)
+		checkRevisionHistory(ctx, s, sts.Spec.RevisionHistoryLimit)
+		checkHostPorts(ctx, s, sts.Spec.Template.Spec, sts.Spec.Replicas)
+		checkImageSkew(ctx, s, sts.Spec.Template.Spec)
+		checkSharedClaims(ctx, s, s.db, sts.Namespace, sts.Spec.Template.Spec, sts.Spec.Replicas)
 		s.checkContainers(ctx, fqn, sts)
 		s.checkUtilization(ctx, over, sts)
+		runChecks(ctx, internal.STS, s, sts)
 	}
 
 	return nil
@@ -73,11 +84,36 @@ func (s *StatefulSet) checkStatefulSet(ctx context.Context, sts *appsv1.Stateful
 	}
 
 	saFQN := client.FQN(sts.Namespace, sts.Spec.Template.Spec.ServiceAccountName)
-	if !s.db.Exists(internal.Glossary[internal.SA], saFQN) {
+	if !s.db.Exists(s.db.GVR(internal.SA), saFQN) {
 		s.AddCode(ctx, 507, sts.Spec.Template.Spec.ServiceAccountName)
 	}
 }
 
+// checkPodManagement notes parallel pod management and flags ordered
+// rollouts stalled behind a pod that is not ready.
+func (s *StatefulSet) checkPodManagement(ctx context.Context, sts *appsv1.StatefulSet) {
+	policy := sts.Spec.PodManagementPolicy
+	if policy == appsv1.ParallelPodManagement {
+		s.AddCode(ctx, 519, policy)
+		return
+	}
+	if policy == "" {
+		policy = appsv1.OrderedReadyPodManagement
+	}
+	if sts.Spec.Replicas == nil {
+		return
+	}
+	desired, created, ready := *sts.Spec.Replicas, sts.Status.Replicas, sts.Status.ReadyReplicas
+	if created >= desired || ready >= created {
+		return
+	}
+	var start int32
+	if sts.Spec.Ordinals != nil {
+		start = sts.Spec.Ordinals.Start
+	}
+	s.AddCode(ctx, 520, policy, start+ready, desired-created)
+}
+
 func (s *StatefulSet) checkContainers(ctx context.Context, fqn string, st *appsv1.StatefulSet) {
 	spec := st.Spec.Template.Spec
 
@@ -22,35 +22,119 @@ func TestSTSLint(t *testing.T) {
 	l := db.NewLoader(dba)
 
 	ctx := test.MakeCtx(t)
-	assert.NoError(t, test.LoadDB[*appsv1.StatefulSet](ctx, l.DB, "apps/sts/1.yaml", internal.Glossary[internal.STS]))
-	assert.NoError(t, test.LoadDB[*v1.ServiceAccount](ctx, l.DB, "core/sa/1.yaml", internal.Glossary[internal.SA]))
-	assert.NoError(t, test.LoadDB[*v1.Pod](ctx, l.DB, "core/pod/1.yaml", internal.Glossary[internal.PO]))
-	assert.NoError(t, test.LoadDB[*mv1beta1.PodMetrics](ctx, l.DB, "mx/pod/1.yaml", internal.Glossary[internal.PMX]))
+	assert.NoError(t, test.LoadDB[*appsv1.StatefulSet](ctx, l.DB, "apps/sts/1.yaml", test.Glossary[internal.STS]))
+	assert.NoError(t, test.LoadDB[*v1.ServiceAccount](ctx, l.DB, "core/sa/1.yaml", test.Glossary[internal.SA]))
+	assert.NoError(t, test.LoadDB[*v1.Pod](ctx, l.DB, "core/pod/1.yaml", test.Glossary[internal.PO]))
+	assert.NoError(t, test.LoadDB[*mv1beta1.PodMetrics](ctx, l.DB, "mx/pod/1.yaml", test.Glossary[internal.PMX]))
 
 	sts := NewStatefulSet(test.MakeCollector(t), dba)
 	assert.Nil(t, sts.Lint(test.MakeContext("apps/v1/statefulsets", "statefulsets")))
 	assert.Equal(t, 3, len(sts.Outcome()))
 
 	ii := sts.Outcome()["default/sts1"]
-	assert.Equal(t, 2, len(ii))
-	assert.Equal(t, `[POP-503] At current load, CPU under allocated. Current:20000m vs Requested:1000m (2000.00%)`, ii[0].Message)
-	assert.Equal(t, `[POP-505] At current load, Memory under allocated. Current:20Mi vs Requested:1Mi (2000.00%)`, ii[1].Message)
+	assert.Equal(t, 4, len(ii))
+	assert.Equal(t, `[POP-513] Missing recommended label(s): app.kubernetes.io/name, app.kubernetes.io/instance, app.kubernetes.io/version, app.kubernetes.io/component, app.kubernetes.io/part-of, app.kubernetes.io/managed-by`, ii[0].Message)
+	assert.Equal(t, rules.InfoLevel, ii[0].Level)
+	assert.Equal(t, `[POP-119] Container "c1" root filesystem is writable. Consider setting readOnlyRootFilesystem`, ii[1].Message)
+	assert.Equal(t, `[POP-503] At current load, CPU under allocated. Current:20000m vs Requested:1000m (2000.00%)`, ii[2].Message)
+	assert.Equal(t, `[POP-505] At current load, Memory under allocated. Current:20Mi vs Requested:1Mi (2000.00%)`, ii[3].Message)
 
 	ii = sts.Outcome()["default/sts2"]
-	assert.Equal(t, 2, len(ii))
-	assert.Equal(t, `[POP-100] Untagged docker image in use`, ii[0].Message)
-	assert.Equal(t, rules.ErrorLevel, ii[0].Level)
-	assert.Equal(t, `[POP-508] No pods match controller selector: app=p2`, ii[1].Message)
+	assert.Equal(t, 4, len(ii))
+	assert.Equal(t, `[POP-513] Missing recommended label(s): app.kubernetes.io/name, app.kubernetes.io/instance, app.kubernetes.io/version, app.kubernetes.io/component, app.kubernetes.io/part-of, app.kubernetes.io/managed-by`, ii[0].Message)
+	assert.Equal(t, rules.InfoLevel, ii[0].Level)
+	assert.Equal(t, `[POP-100] Untagged docker image in use`, ii[1].Message)
 	assert.Equal(t, rules.ErrorLevel, ii[1].Level)
+	assert.Equal(t, `[POP-119] Container "c1" root filesystem is writable. Consider setting readOnlyRootFilesystem`, ii[2].Message)
+	assert.Equal(t, rules.InfoLevel, ii[2].Level)
+	assert.Equal(t, `[POP-508] No pods match controller selector: app=p2`, ii[3].Message)
+	assert.Equal(t, rules.ErrorLevel, ii[3].Level)
 
 	ii = sts.Outcome()["default/sts3"]
-	assert.Equal(t, 4, len(ii))
-	assert.Equal(t, `[POP-501] Unhealthy 1 desired but have 0 available`, ii[0].Message)
-	assert.Equal(t, rules.ErrorLevel, ii[0].Level)
-	assert.Equal(t, `[POP-507] Deployment references ServiceAccount "sa-bozo" which does not exist`, ii[1].Message)
+	assert.Equal(t, 6, len(ii))
+	assert.Equal(t, `[POP-513] Missing recommended label(s): app.kubernetes.io/name, app.kubernetes.io/instance, app.kubernetes.io/version, app.kubernetes.io/component, app.kubernetes.io/part-of, app.kubernetes.io/managed-by`, ii[0].Message)
+	assert.Equal(t, rules.InfoLevel, ii[0].Level)
+	assert.Equal(t, `[POP-501] Unhealthy 1 desired but have 0 available`, ii[1].Message)
 	assert.Equal(t, rules.ErrorLevel, ii[1].Level)
-	assert.Equal(t, `[POP-106] No resources requests/limits defined`, ii[2].Message)
-	assert.Equal(t, rules.WarnLevel, ii[2].Level)
-	assert.Equal(t, `[POP-508] No pods match controller selector: app=p3`, ii[3].Message)
-	assert.Equal(t, rules.ErrorLevel, ii[3].Level)
+	assert.Equal(t, `[POP-507] Deployment references ServiceAccount "sa-bozo" which does not exist`, ii[2].Message)
+	assert.Equal(t, rules.ErrorLevel, ii[2].Level)
+	assert.Equal(t, `[POP-106] No resources requests/limits defined`, ii[3].Message)
+	assert.Equal(t, rules.WarnLevel, ii[3].Level)
+	assert.Equal(t, `[POP-119] Container "c1" root filesystem is writable. Consider setting readOnlyRootFilesystem`, ii[4].Message)
+	assert.Equal(t, rules.InfoLevel, ii[4].Level)
+	assert.Equal(t, `[POP-508] No pods match controller selector: app=p3`, ii[5].Message)
+	assert.Equal(t, rules.ErrorLevel, ii[5].Level)
+}
+
+func TestSTSCheckPodManagement(t *testing.T) {
+	uu := map[string]struct {
+		policy                  appsv1.PodManagementPolicyType
+		ordinals                *appsv1.StatefulSetOrdinals
+		desired, created, ready int32
+		issue                   string
+		level                   rules.Level
+	}{
+		"ordered-healthy": {
+			desired: 3,
+			created: 3,
+			ready:   3,
+		},
+		"ordered-rolling": {
+			desired: 3,
+			created: 2,
+			ready:   2,
+		},
+		"parallel": {
+			policy:  appsv1.ParallelPodManagement,
+			desired: 3,
+			created: 3,
+			ready:   1,
+			issue:   `[POP-519] podManagementPolicy is Parallel. Pods are started and terminated without ordering guarantees`,
+			level:   rules.InfoLevel,
+		},
+		"ordered-blocked": {
+			desired: 5,
+			created: 2,
+			ready:   1,
+			issue:   `[POP-520] podManagementPolicy is OrderedReady and pod ordinal 1 is not ready. 3 subsequent pods are blocked`,
+			level:   rules.WarnLevel,
+		},
+		"ordered-blocked-start": {
+			policy:   appsv1.OrderedReadyPodManagement,
+			ordinals: &appsv1.StatefulSetOrdinals{Start: 10},
+			desired:  3,
+			created:  1,
+			ready:    0,
+			issue:    `[POP-520] podManagementPolicy is OrderedReady and pod ordinal 10 is not ready. 2 subsequent pods are blocked`,
+			level:    rules.WarnLevel,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			s := NewStatefulSet(test.MakeCollector(t), nil)
+			fqn := "default/sts1"
+			s.InitOutcome(fqn)
+			ctx := internal.WithSpec(test.MakeContext("apps/v1/statefulsets", "statefulsets"), SpecFor(fqn, nil))
+			sts := appsv1.StatefulSet{
+				Spec: appsv1.StatefulSetSpec{
+					Replicas:            &u.desired,
+					PodManagementPolicy: u.policy,
+					Ordinals:            u.ordinals,
+				},
+				Status: appsv1.StatefulSetStatus{Replicas: u.created, ReadyReplicas: u.ready},
+			}
+			s.checkPodManagement(ctx, &sts)
+
+			ii := s.Outcome()[fqn]
+			if u.issue == "" {
+				assert.Equal(t, 0, len(ii))
+				return
+			}
+			assert.Equal(t, 1, len(ii))
+			assert.Equal(t, u.issue, ii[0].Message)
+			assert.Equal(t, u.level, ii[0].Level)
+		})
+	}
 }
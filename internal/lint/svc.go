@@ -5,17 +5,38 @@ package lint
 
 import (
 	"context"
+	"slices"
+	"sort"
 	"strconv"
+	"strings"
 
 	"github.com/derailed/popeye/internal"
 	"github.com/derailed/popeye/internal/cache"
 	"github.com/derailed/popeye/internal/client"
 	"github.com/derailed/popeye/internal/db"
 	"github.com/derailed/popeye/internal/issues"
+	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
+// portAppProtocols maps port name prefixes to their appProtocol following the
+// common <protocol>[-<suffix>] naming convention.
+var portAppProtocols = map[string]string{
+	"http":  "http",
+	"http2": "http2",
+	"https": "https",
+	"grpc":  "grpc",
+	"h2c":   "kubernetes.io/h2c",
+	"ws":    "kubernetes.io/ws",
+	"wss":   "kubernetes.io/wss",
+	"tcp":   "tcp",
+	"tls":   "tls",
+	"mongo": "mongo",
+	"mysql": "mysql",
+	"redis": "redis",
+}
+
 // Service represents a service linter.
 type Service struct {
 	*issues.Collector
@@ -32,7 +53,8 @@ func NewService(co *issues.Collector, db *db.DB) *Service {
 
 // Lint cleanse the resource.
 func (s *Service) Lint(ctx context.Context) error {
-	txn, it := s.db.MustITFor(internal.Glossary[internal.SVC])
+	overlaps := overlappingServices(s.listServices())
+	txn, it := s.db.MustITFor(s.db.GVR(internal.SVC))
 	defer txn.Abort()
 	for o := it.Next(); o != nil; o = it.Next() {
 		svc := o.(*v1.Service)
@@ -40,17 +62,90 @@ func (s *Service) Lint(ctx context.Context) error {
 		s.InitOutcome(fqn)
 		ctx = internal.WithSpec(ctx, SpecFor(fqn, svc))
 
+		checkHelmOwnership(ctx, s, svc.ObjectMeta)
 		if len(svc.Spec.Selector) > 0 {
 			s.checkPorts(ctx, svc.Namespace, svc.Spec.Selector, svc.Spec.Ports)
 			s.checkEndpoints(ctx, fqn, svc.Spec.Type)
+			s.checkAffinity(ctx, fqn, svc)
 		}
 		s.checkType(ctx, svc.Spec.Type)
 		s.checkExternalTrafficPolicy(ctx, svc.Spec.Type, svc.Spec.ExternalTrafficPolicy)
+		s.checkSensitivePorts(ctx, svc.Spec.Type, svc.Spec.Ports)
+		s.checkAppProtocols(ctx, svc.Spec.Ports)
+		s.checkNotReadyAddresses(ctx, svc)
+		if peers, ok := overlaps[fqn]; ok {
+			s.AddCode(ctx, 1115, strings.Join(peers, ", "))
+		}
+		runChecks(ctx, internal.SVC, s, svc)
 	}
 
 	return nil
 }
 
+func (s *Service) listServices() []*v1.Service {
+	txn, it := s.db.MustITFor(s.db.GVR(internal.SVC))
+	defer txn.Abort()
+	var ss []*v1.Service
+	for o := it.Next(); o != nil; o = it.Next() {
+		ss = append(ss, o.(*v1.Service))
+	}
+
+	return ss
+}
+
+// overlappingServices returns, for each service, the services in the same
+// namespace sharing its selector and at least one port.
+func overlappingServices(ss []*v1.Service) map[string][]string {
+	groups := make(map[string][]*v1.Service)
+	for _, svc := range ss {
+		if len(svc.Spec.Selector) == 0 || svc.Spec.Type == v1.ServiceTypeExternalName {
+			continue
+		}
+		key := svc.Namespace + "|" + selectorKey(svc.Spec.Selector)
+		groups[key] = append(groups[key], svc)
+	}
+
+	overlaps := make(map[string][]string)
+	for _, group := range groups {
+		for i, a := range group {
+			for j, b := range group {
+				if i == j || !sharePorts(a.Spec.Ports, b.Spec.Ports) {
+					continue
+				}
+				fqn := client.FQN(a.Namespace, a.Name)
+				overlaps[fqn] = append(overlaps[fqn], client.FQN(b.Namespace, b.Name))
+			}
+		}
+	}
+	for _, peers := range overlaps {
+		sort.Strings(peers)
+	}
+
+	return overlaps
+}
+
+func selectorKey(sel map[string]string) string {
+	kk := make([]string, 0, len(sel))
+	for k, v := range sel {
+		kk = append(kk, k+"="+v)
+	}
+	sort.Strings(kk)
+
+	return strings.Join(kk, ",")
+}
+
+func sharePorts(aa, bb []v1.ServicePort) bool {
+	for _, a := range aa {
+		for _, b := range bb {
+			if a.Port == b.Port && a.Protocol == b.Protocol {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
 func (s *Service) checkPorts(ctx context.Context, ns string, sel map[string]string, ports []v1.ServicePort) {
 	po, err := s.db.FindPod(ns, sel)
 	if err != nil || po == nil {
@@ -68,8 +163,17 @@ func (s *Service) checkPorts(ctx context.Context, ns string, sel map[string]stri
 		s.AddCode(ctx, 1101, pfqn)
 		return
 	}
+	named := namedPortsFor(po.Spec.Containers...)
 	for _, p := range ports {
+		if named.dangling(p.TargetPort) {
+			s.AddCode(ctx, 1116, portAsStr(p), p.TargetPort.StrVal, pfqn)
+			continue
+		}
 		if !checkServicePort(p, pports) {
+			if co, proto, ok := protocolMismatch(p, pports); ok {
+				s.AddCode(ctx, 1111, portAsStr(p), co, proto)
+				continue
+			}
 			s.AddCode(ctx, 1106, portAsStr(p))
 			continue
 		}
@@ -79,6 +183,105 @@ func (s *Service) checkPorts(ctx context.Context, ns string, sel map[string]stri
 	}
 }
 
+// checkSensitivePorts flags sensitive ports exposed outside the cluster.
+func (s *Service) checkSensitivePorts(ctx context.Context, kind v1.ServiceType, ports []v1.ServicePort) {
+	if kind != v1.ServiceTypeLoadBalancer && kind != v1.ServiceTypeNodePort {
+		return
+	}
+	sensitive := s.SensitivePorts()
+	for _, p := range ports {
+		if slices.Contains(sensitive, p.Port) {
+			s.AddCode(ctx, 1114, portAsStr(p), kind)
+		}
+	}
+}
+
+// checkAppProtocols nudges ports named after a protocol to declare it as
+// appProtocol, which meshes and observability tools rely on.
+func (s *Service) checkAppProtocols(ctx context.Context, ports []v1.ServicePort) {
+	for _, p := range ports {
+		if p.AppProtocol != nil && *p.AppProtocol != "" {
+			continue
+		}
+		if proto, ok := appProtocolFor(p.Name); ok {
+			s.AddCode(ctx, 1119, portAsStr(p), proto)
+		}
+	}
+}
+
+// appProtocolFor infers an appProtocol from a port named <protocol>[-<suffix>].
+func appProtocolFor(name string) (string, bool) {
+	name = strings.ToLower(name)
+	if name == "grpc-web" || strings.HasPrefix(name, "grpc-web-") {
+		return "grpc-web", true
+	}
+	prefix, _, _ := strings.Cut(name, "-")
+	proto, ok := portAppProtocols[prefix]
+
+	return proto, ok
+}
+
+// checkNotReadyAddresses flags headless services routing traffic to not ready
+// pods when they are not governing a StatefulSet.
+func (s *Service) checkNotReadyAddresses(ctx context.Context, svc *v1.Service) {
+	if !svc.Spec.PublishNotReadyAddresses || svc.Spec.ClusterIP != v1.ClusterIPNone {
+		return
+	}
+	txn, it := s.db.MustITForNS(s.db.GVR(internal.STS), svc.Namespace)
+	defer txn.Abort()
+	for o := it.Next(); o != nil; o = it.Next() {
+		if o.(*appsv1.StatefulSet).Spec.ServiceName == svc.Name {
+			return
+		}
+	}
+	s.AddCode(ctx, 1118, client.FQN(svc.Namespace, svc.Name))
+}
+
+func (s *Service) checkAffinity(ctx context.Context, fqn string, svc *v1.Service) {
+	if svc.Spec.SessionAffinity != v1.ServiceAffinityClientIP {
+		return
+	}
+	if o, err := s.db.Find(s.db.GVR(internal.EP), fqn); err == nil {
+		var eps int
+		for _, ss := range o.(*v1.Endpoints).Subsets {
+			eps += len(ss.Addresses)
+		}
+		if eps == 1 {
+			s.AddCode(ctx, 1112)
+			return
+		}
+	}
+
+	txn, it := s.db.MustITForNS(s.db.GVR(internal.DP), svc.Namespace)
+	defer txn.Abort()
+	for o := it.Next(); o != nil; o = it.Next() {
+		dp := o.(*appsv1.Deployment)
+		if !cache.MatchLabels(dp.Spec.Template.Labels, svc.Spec.Selector) {
+			continue
+		}
+		if surge, ok := rollingSurge(dp.Spec.Strategy); ok {
+			s.AddCode(ctx, 1113, dp.Name, surge)
+		}
+	}
+}
+
+// rollingSurge returns the deployment max surge if rolling updates may surge.
+func rollingSurge(st appsv1.DeploymentStrategy) (string, bool) {
+	if st.Type == appsv1.RecreateDeploymentStrategyType {
+		return "", false
+	}
+	// Kubernetes defaults max surge to 25%.
+	surge := intstr.FromString("25%")
+	if st.RollingUpdate != nil && st.RollingUpdate.MaxSurge != nil {
+		surge = *st.RollingUpdate.MaxSurge
+	}
+	if v := surge.String(); v == "0" || v == "0%" {
+		return "", false
+	}
+
+	return surge.String(), true
+}
+
 func (s *Service) checkType(ctx context.Context, kind v1.ServiceType) {
 	if kind == v1.ServiceTypeLoadBalancer {
 		s.AddCode(ctx, 1103)
@@ -105,7 +308,7 @@ func (s *Service) checkEndpoints(ctx context.Context, fqn string, kind v1.Servic
 		return
 	}
 
-	o, err := s.db.Find(internal.Glossary[internal.EP], fqn)
+	o, err := s.db.Find(s.db.GVR(internal.EP), fqn)
 	if err != nil {
 		s.AddCode(ctx, 1105)
 		return
@@ -141,6 +344,22 @@ func checkServicePort(port v1.ServicePort, ports map[string]string) bool {
 	return false
 }
 
+// protocolMismatch checks if the service target port is exposed by a
+// container under a different protocol.
+func protocolMismatch(port v1.ServicePort, ports map[string]string) (string, v1.Protocol, bool) {
+	target := targetPort(port)
+	for _, proto := range []v1.Protocol{v1.ProtocolTCP, v1.ProtocolUDP, v1.ProtocolSCTP} {
+		if proto == protocolOrDefault(port.Protocol) {
+			continue
+		}
+		if co, ok := ports[portFQN(proto, target)]; ok {
+			return co, proto, true
+		}
+	}
+
+	return "", "", false
+}
+
 // PortsForPod computes a port map for a given pod.
 func portsForPod(pod *v1.Pod, ports map[string]string) {
 	for _, co := range pod.Spec.Containers {
@@ -154,13 +373,27 @@ func portsForPod(pod *v1.Pod, ports map[string]string) {
 }
 
 func servicePortFQN(port v1.ServicePort) string {
-	if port.TargetPort.String() != "" {
-		return portFQN(port.Protocol, port.TargetPort.String())
+	return portFQN(port.Protocol, targetPort(port))
+}
+
+// targetPort returns the service target port, defaulting to the service port.
+func targetPort(port v1.ServicePort) string {
+	if ts := port.TargetPort.String(); ts == "" || ts == "0" {
+		return strconv.Itoa(int(port.Port))
 	}
 
-	return portFQN(port.Protocol, strconv.Itoa(int(port.Port)))
+	return port.TargetPort.String()
 }
 
 func portFQN(p v1.Protocol, port string) string {
-	return string(p) + ":" + port
+	return string(protocolOrDefault(p)) + ":" + port
+}
+
+// protocolOrDefault returns the given protocol or TCP when unspecified.
+func protocolOrDefault(p v1.Protocol) v1.Protocol {
+	if p == "" {
+		return v1.ProtocolTCP
+	}
+
+	return p
 }
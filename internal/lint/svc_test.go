@@ -8,12 +8,16 @@ import (
 	"testing"
 
 	"github.com/derailed/popeye/internal"
+	"github.com/derailed/popeye/internal/client"
 	"github.com/derailed/popeye/internal/db"
 	"github.com/derailed/popeye/internal/issues"
 	"github.com/derailed/popeye/internal/rules"
 	"github.com/derailed/popeye/internal/test"
 	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 func TestSVCLint(t *testing.T) {
@@ -22,9 +26,9 @@ func TestSVCLint(t *testing.T) {
 	l := db.NewLoader(dba)
 
 	ctx := test.MakeCtx(t)
-	assert.NoError(t, test.LoadDB[*v1.Service](ctx, l.DB, "core/svc/1.yaml", internal.Glossary[internal.SVC]))
-	assert.NoError(t, test.LoadDB[*v1.Pod](ctx, l.DB, "core/pod/1.yaml", internal.Glossary[internal.PO]))
-	assert.NoError(t, test.LoadDB[*v1.Endpoints](ctx, l.DB, "core/ep/1.yaml", internal.Glossary[internal.EP]))
+	assert.NoError(t, test.LoadDB[*v1.Service](ctx, l.DB, "core/svc/1.yaml", test.Glossary[internal.SVC]))
+	assert.NoError(t, test.LoadDB[*v1.Pod](ctx, l.DB, "core/pod/1.yaml", test.Glossary[internal.PO]))
+	assert.NoError(t, test.LoadDB[*v1.Endpoints](ctx, l.DB, "core/ep/1.yaml", test.Glossary[internal.EP]))
 
 	svc := NewService(test.MakeCollector(t), dba)
 	assert.Nil(t, svc.Lint(test.MakeContext("v1/pods", "pods")))
@@ -41,18 +45,20 @@ func TestSVCLint2(t *testing.T) {
 	l := db.NewLoader(dba)
 
 	ctx := test.MakeCtx(t)
-	assert.NoError(t, test.LoadDB[*v1.Service](ctx, l.DB, "core/svc/2.yaml", internal.Glossary[internal.SVC]))
-	assert.NoError(t, test.LoadDB[*v1.Pod](ctx, l.DB, "core/pod/4.yaml", internal.Glossary[internal.PO]))
-	assert.NoError(t, test.LoadDB[*v1.Endpoints](ctx, l.DB, "core/ep/1.yaml", internal.Glossary[internal.EP]))
+	assert.NoError(t, test.LoadDB[*v1.Service](ctx, l.DB, "core/svc/2.yaml", test.Glossary[internal.SVC]))
+	assert.NoError(t, test.LoadDB[*v1.Pod](ctx, l.DB, "core/pod/4.yaml", test.Glossary[internal.PO]))
+	assert.NoError(t, test.LoadDB[*v1.Endpoints](ctx, l.DB, "core/ep/1.yaml", test.Glossary[internal.EP]))
 
 	svc := NewService(test.MakeCollector(t), dba)
 	assert.Nil(t, svc.Lint(test.MakeContext("v1/pods", "pods")))
 	assert.Equal(t, 1, len(svc.Outcome()))
 
 	ii := svc.Outcome()["default/svc1"]
-	assert.Equal(t, 1, len(ii))
+	assert.Equal(t, 2, len(ii))
 	assert.Equal(t, `[POP-1109] Single endpoint is associated with this service`, ii[0].Message)
 	assert.Equal(t, rules.WarnLevel, ii[0].Level)
+	assert.Equal(t, `[POP-1119] Port TCP:http:9090 sets no appProtocol. Its name suggests "http" for mesh and observability tooling`, ii[1].Message)
+	assert.Equal(t, rules.InfoLevel, ii[1].Level)
 
 }
 
@@ -122,7 +128,7 @@ func Test_svcCheckEndpoints(t *testing.T) {
 			ctx := test.MakeContext("v1/services", "services")
 			ctx = context.WithValue(ctx, internal.KeyConfig, test.MakeConfig(t))
 
-			assert.NoError(t, test.LoadDB[*v1.Endpoints](ctx, l.DB, "core/ep/1.yaml", internal.Glossary[internal.EP]))
+			assert.NoError(t, test.LoadDB[*v1.Endpoints](ctx, l.DB, "core/ep/1.yaml", test.Glossary[internal.EP]))
 
 			s := NewService(test.MakeCollector(t), dba)
 			if u.fqn != "" {
@@ -134,3 +140,451 @@ func Test_svcCheckEndpoints(t *testing.T) {
 		})
 	}
 }
+
+func Test_svcCheckPortsNamed(t *testing.T) {
+	uu := map[string]struct {
+		target intstr.IntOrString
+		issue  string
+	}{
+		"valid": {
+			target: intstr.FromString("http"),
+		},
+		"typo": {
+			target: intstr.FromString("htpp"),
+			issue:  `[POP-1116] Service port TCP::80 targets named port "htpp" which is not declared by pod default/p1`,
+		},
+	}
+
+	dba, err := test.NewTestDB()
+	assert.NoError(t, err)
+	l := db.NewLoader(dba)
+	assert.NoError(t, test.LoadDB[*v1.Pod](test.MakeCtx(t), l.DB, "core/pod/1.yaml", test.Glossary[internal.PO]))
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			s := NewService(test.MakeCollector(t), dba)
+			ctx := test.MakeContext("v1/services", "services")
+			ctx = internal.WithSpec(ctx, SpecFor("default/svc1", nil))
+			s.checkPorts(ctx, "default", map[string]string{"app": "p1"}, []v1.ServicePort{
+				{Protocol: v1.ProtocolTCP, Port: 80, TargetPort: u.target},
+			})
+
+			ii := s.Outcome()["default/svc1"]
+			if u.issue == "" {
+				assert.Equal(t, 0, len(ii))
+				return
+			}
+			assert.Equal(t, 1, len(ii))
+			assert.Equal(t, u.issue, ii[0].Message)
+			assert.Equal(t, rules.WarnLevel, ii[0].Level)
+		})
+	}
+}
+
+func Test_svcCheckSensitivePorts(t *testing.T) {
+	uu := map[string]struct {
+		kind   v1.ServiceType
+		ports  []v1.ServicePort
+		custom []int32
+		issues []string
+	}{
+		"lb-database": {
+			kind:  v1.ServiceTypeLoadBalancer,
+			ports: []v1.ServicePort{{Name: "pg", Protocol: v1.ProtocolTCP, Port: 5432}},
+			issues: []string{
+				`[POP-1114] Sensitive port TCP:pg:5432 exposed via LoadBalancer service`,
+			},
+		},
+		"nodeport-ssh": {
+			kind: v1.ServiceTypeNodePort,
+			ports: []v1.ServicePort{
+				{Protocol: v1.ProtocolTCP, Port: 80},
+				{Protocol: v1.ProtocolTCP, Port: 22},
+			},
+			issues: []string{
+				`[POP-1114] Sensitive port TCP::22 exposed via NodePort service`,
+			},
+		},
+		"lb-http": {
+			kind:  v1.ServiceTypeLoadBalancer,
+			ports: []v1.ServicePort{{Name: "http", Protocol: v1.ProtocolTCP, Port: 80}},
+		},
+		"cluster-ip-database": {
+			kind:  v1.ServiceTypeClusterIP,
+			ports: []v1.ServicePort{{Protocol: v1.ProtocolTCP, Port: 3306}},
+		},
+		"custom": {
+			kind:   v1.ServiceTypeLoadBalancer,
+			ports:  []v1.ServicePort{{Protocol: v1.ProtocolTCP, Port: 8080}, {Protocol: v1.ProtocolTCP, Port: 3306}},
+			custom: []int32{8080},
+			issues: []string{
+				`[POP-1114] Sensitive port TCP::8080 exposed via LoadBalancer service`,
+			},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			s := NewService(test.MakeCollector(t), nil)
+			s.SensitivePortList = u.custom
+			ctx := test.MakeContext("v1/services", "services")
+			ctx = internal.WithSpec(ctx, SpecFor("default/svc1", nil))
+			s.checkSensitivePorts(ctx, u.kind, u.ports)
+
+			ii := s.Outcome()["default/svc1"]
+			assert.Equal(t, len(u.issues), len(ii))
+			for i, m := range u.issues {
+				assert.Equal(t, m, ii[i].Message)
+				assert.Equal(t, rules.WarnLevel, ii[i].Level)
+			}
+		})
+	}
+}
+
+func Test_svcProtocolMismatch(t *testing.T) {
+	uu := map[string]struct {
+		port       v1.ServicePort
+		coPort     v1.ContainerPort
+		match      bool
+		mismatch   bool
+		co         string
+		coProtocol v1.Protocol
+	}{
+		"match": {
+			port:   v1.ServicePort{Protocol: v1.ProtocolUDP, Port: 53, TargetPort: intstr.FromString("dns")},
+			coPort: v1.ContainerPort{Name: "dns", Protocol: v1.ProtocolUDP, ContainerPort: 53},
+			match:  true,
+		},
+		"mismatch": {
+			port:       v1.ServicePort{Protocol: v1.ProtocolTCP, Port: 53, TargetPort: intstr.FromInt(53)},
+			coPort:     v1.ContainerPort{Protocol: v1.ProtocolUDP, ContainerPort: 53},
+			mismatch:   true,
+			co:         "c1",
+			coProtocol: v1.ProtocolUDP,
+		},
+		"named-mismatch": {
+			port:       v1.ServicePort{Port: 53, TargetPort: intstr.FromString("dns")},
+			coPort:     v1.ContainerPort{Name: "dns", Protocol: v1.ProtocolUDP, ContainerPort: 53},
+			mismatch:   true,
+			co:         "c1",
+			coProtocol: v1.ProtocolUDP,
+		},
+		"defaulted": {
+			port:   v1.ServicePort{Port: 80},
+			coPort: v1.ContainerPort{ContainerPort: 80},
+			match:  true,
+		},
+		"defaulted-svc": {
+			port:   v1.ServicePort{Port: 80, TargetPort: intstr.FromInt(8080)},
+			coPort: v1.ContainerPort{Protocol: v1.ProtocolTCP, ContainerPort: 8080},
+			match:  true,
+		},
+		"no-port": {
+			port:   v1.ServicePort{Protocol: v1.ProtocolTCP, Port: 80},
+			coPort: v1.ContainerPort{Protocol: v1.ProtocolUDP, ContainerPort: 8080},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			po := v1.Pod{
+				Spec: v1.PodSpec{
+					Containers: []v1.Container{
+						{Name: "c1", Ports: []v1.ContainerPort{u.coPort}},
+					},
+				},
+			}
+			pports := make(map[string]string)
+			portsForPod(&po, pports)
+
+			assert.Equal(t, u.match, checkServicePort(u.port, pports))
+			co, proto, ok := protocolMismatch(u.port, pports)
+			assert.Equal(t, u.mismatch, ok)
+			assert.Equal(t, u.co, co)
+			assert.Equal(t, u.coProtocol, proto)
+		})
+	}
+}
+
+func Test_svcCheckAffinity(t *testing.T) {
+	uu := map[string]struct {
+		fqn      string
+		affinity v1.ServiceAffinity
+		sel      map[string]string
+		issues   issues.Issues
+	}{
+		"no-affinity": {
+			fqn: "default/svc1",
+			sel: map[string]string{"app": "p1"},
+		},
+		"single-endpoint": {
+			fqn:      "default/svc1",
+			affinity: v1.ServiceAffinityClientIP,
+			sel:      map[string]string{"app": "p1"},
+			issues: issues.Issues{
+				{
+					Group:   "__root__",
+					GVR:     "v1/services",
+					Level:   rules.InfoLevel,
+					Message: "[POP-1112] Session affinity is set but service is backed by a single endpoint",
+				},
+			},
+		},
+		"multi-endpoint": {
+			fqn:      "default/svc-none",
+			affinity: v1.ServiceAffinityClientIP,
+			sel:      map[string]string{"app": "zorg"},
+		},
+		"multi-endpoint-surge": {
+			fqn:      "default/svc-none",
+			affinity: v1.ServiceAffinityClientIP,
+			sel:      map[string]string{"app": "p1"},
+			issues: issues.Issues{
+				{
+					Group:   "__root__",
+					GVR:     "v1/services",
+					Level:   rules.WarnLevel,
+					Message: "[POP-1113] Session affinity may break during deployment dp1 rollouts (maxSurge 25%)",
+				},
+			},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			dba, err := test.NewTestDB()
+			assert.NoError(t, err)
+			l := db.NewLoader(dba)
+			ctx := test.MakeContext("v1/services", "services")
+			ctx = context.WithValue(ctx, internal.KeyConfig, test.MakeConfig(t))
+
+			assert.NoError(t, test.LoadDB[*v1.Endpoints](ctx, l.DB, "core/ep/1.yaml", test.Glossary[internal.EP]))
+			assert.NoError(t, test.LoadDB[*appsv1.Deployment](ctx, l.DB, "apps/dp/1.yaml", test.Glossary[internal.DP]))
+
+			s := NewService(test.MakeCollector(t), dba)
+			ctx = internal.WithSpec(ctx, SpecFor(u.fqn, nil))
+			ns, n := client.Namespaced(u.fqn)
+			svc := v1.Service{
+				ObjectMeta: metav1.ObjectMeta{Name: n, Namespace: ns},
+				Spec: v1.ServiceSpec{
+					SessionAffinity: u.affinity,
+					Selector:        u.sel,
+				},
+			}
+			s.checkAffinity(ctx, u.fqn, &svc)
+
+			assert.Equal(t, u.issues, s.Outcome()[u.fqn])
+		})
+	}
+}
+
+func Test_svcCheckNotReadyAddresses(t *testing.T) {
+	uu := map[string]struct {
+		fqn       string
+		clusterIP string
+		publish   bool
+		issues    issues.Issues
+	}{
+		"sts-headless": {
+			fqn:       "default/svc1",
+			clusterIP: v1.ClusterIPNone,
+			publish:   true,
+		},
+		"standalone-headless": {
+			fqn:       "default/svc-none",
+			clusterIP: v1.ClusterIPNone,
+			publish:   true,
+			issues: issues.Issues{
+				{
+					Group:   "__root__",
+					GVR:     "v1/services",
+					Level:   rules.InfoLevel,
+					Message: "[POP-1118] Headless service default/svc-none publishes not-ready addresses but does not govern a StatefulSet. Traffic may reach unhealthy pods",
+				},
+			},
+		},
+		"standalone-not-published": {
+			fqn:       "default/svc-none",
+			clusterIP: v1.ClusterIPNone,
+		},
+		"not-headless": {
+			fqn:     "default/svc-none",
+			publish: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			dba, err := test.NewTestDB()
+			assert.NoError(t, err)
+			l := db.NewLoader(dba)
+			ctx := test.MakeContext("v1/services", "services")
+			ctx = context.WithValue(ctx, internal.KeyConfig, test.MakeConfig(t))
+			assert.NoError(t, test.LoadDB[*appsv1.StatefulSet](ctx, l.DB, "apps/sts/1.yaml", test.Glossary[internal.STS]))
+
+			s := NewService(test.MakeCollector(t), dba)
+			ctx = internal.WithSpec(ctx, SpecFor(u.fqn, nil))
+			ns, n := client.Namespaced(u.fqn)
+			svc := v1.Service{
+				ObjectMeta: metav1.ObjectMeta{Name: n, Namespace: ns},
+				Spec: v1.ServiceSpec{
+					ClusterIP:                u.clusterIP,
+					PublishNotReadyAddresses: u.publish,
+				},
+			}
+			s.checkNotReadyAddresses(ctx, &svc)
+
+			assert.Equal(t, u.issues, s.Outcome()[u.fqn])
+		})
+	}
+}
+
+func Test_rollingSurge(t *testing.T) {
+	zero, pct := intstr.FromInt32(0), intstr.FromString("50%")
+	uu := map[string]struct {
+		st    appsv1.DeploymentStrategy
+		surge string
+		ok    bool
+	}{
+		"default": {
+			surge: "25%",
+			ok:    true,
+		},
+		"recreate": {
+			st: appsv1.DeploymentStrategy{Type: appsv1.RecreateDeploymentStrategyType},
+		},
+		"zero": {
+			st: appsv1.DeploymentStrategy{
+				Type:          appsv1.RollingUpdateDeploymentStrategyType,
+				RollingUpdate: &appsv1.RollingUpdateDeployment{MaxSurge: &zero},
+			},
+		},
+		"custom": {
+			st: appsv1.DeploymentStrategy{
+				Type:          appsv1.RollingUpdateDeploymentStrategyType,
+				RollingUpdate: &appsv1.RollingUpdateDeployment{MaxSurge: &pct},
+			},
+			surge: "50%",
+			ok:    true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			surge, ok := rollingSurge(u.st)
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.surge, surge)
+		})
+	}
+}
+
+func Test_svcOverlappingServices(t *testing.T) {
+	http := []v1.ServicePort{{Protocol: v1.ProtocolTCP, Port: 80}}
+	uu := map[string]struct {
+		ss []*v1.Service
+		e  map[string][]string
+	}{
+		"overlap": {
+			ss: []*v1.Service{
+				makeSvcWith("default", "s1", map[string]string{"app": "a", "tier": "fe"}, http),
+				makeSvcWith("default", "s2", map[string]string{"tier": "fe", "app": "a"}, http),
+			},
+			e: map[string][]string{
+				"default/s1": {"default/s2"},
+				"default/s2": {"default/s1"},
+			},
+		},
+		"distinct-selectors": {
+			ss: []*v1.Service{
+				makeSvcWith("default", "s1", map[string]string{"app": "a"}, http),
+				makeSvcWith("default", "s2", map[string]string{"app": "b"}, http),
+			},
+			e: map[string][]string{},
+		},
+		"distinct-ports": {
+			ss: []*v1.Service{
+				makeSvcWith("default", "s1", map[string]string{"app": "a"}, http),
+				makeSvcWith("default", "s2", map[string]string{"app": "a"}, []v1.ServicePort{{Protocol: v1.ProtocolTCP, Port: 9090}}),
+			},
+			e: map[string][]string{},
+		},
+		"distinct-namespaces": {
+			ss: []*v1.Service{
+				makeSvcWith("ns1", "s1", map[string]string{"app": "a"}, http),
+				makeSvcWith("ns2", "s1", map[string]string{"app": "a"}, http),
+			},
+			e: map[string][]string{},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, overlappingServices(u.ss))
+		})
+	}
+}
+
+func makeSvcWith(ns, n string, sel map[string]string, pp []v1.ServicePort) *v1.Service {
+	return &v1.Service{
+		ObjectMeta: metav1.ObjectMeta{Namespace: ns, Name: n},
+		Spec: v1.ServiceSpec{
+			Type:     v1.ServiceTypeClusterIP,
+			Selector: sel,
+			Ports:    pp,
+		},
+	}
+}
+
+func Test_svcCheckAppProtocols(t *testing.T) {
+	grpc := "grpc"
+	uu := map[string]struct {
+		ports  []v1.ServicePort
+		issues []string
+	}{
+		"set": {
+			ports: []v1.ServicePort{{Name: "grpc-api", Protocol: v1.ProtocolTCP, Port: 9000, AppProtocol: &grpc}},
+		},
+		"unnamed": {
+			ports: []v1.ServicePort{{Protocol: v1.ProtocolTCP, Port: 8080}},
+		},
+		"opaque-name": {
+			ports: []v1.ServicePort{{Name: "api", Protocol: v1.ProtocolTCP, Port: 8080}},
+		},
+		"implied": {
+			ports: []v1.ServicePort{
+				{Name: "http-web", Protocol: v1.ProtocolTCP, Port: 80},
+				{Name: "grpc-web", Protocol: v1.ProtocolTCP, Port: 9001},
+				{Name: "h2c", Protocol: v1.ProtocolTCP, Port: 9002},
+			},
+			issues: []string{
+				`[POP-1119] Port TCP:http-web:80 sets no appProtocol. Its name suggests "http" for mesh and observability tooling`,
+				`[POP-1119] Port TCP:grpc-web:9001 sets no appProtocol. Its name suggests "grpc-web" for mesh and observability tooling`,
+				`[POP-1119] Port TCP:h2c:9002 sets no appProtocol. Its name suggests "kubernetes.io/h2c" for mesh and observability tooling`,
+			},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			s := NewService(test.MakeCollector(t), nil)
+			ctx := test.MakeContext("v1/services", "services")
+			ctx = internal.WithSpec(ctx, SpecFor("default/svc1", nil))
+			s.checkAppProtocols(ctx, u.ports)
+
+			ii := s.Outcome()["default/svc1"]
+			assert.Equal(t, len(u.issues), len(ii))
+			for i, m := range u.issues {
+				assert.Equal(t, m, ii[i].Message)
+				assert.Equal(t, rules.InfoLevel, ii[i].Level)
+			}
+		})
+	}
+}
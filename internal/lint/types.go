@@ -43,6 +43,7 @@ type PodLimiter interface {
 
 type ContainerRestrictor interface {
 	AllowedRegistries() []string
+	MaxEnvValueSize() int
 }
 
 // PodSelectorLister list a collection of pod matching a selector.
@@ -0,0 +1,96 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Popeye
+
+package internal
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+// LogLevel represents a logging verbosity.
+type LogLevel int8
+
+const (
+	// DebugLog tracks debug events.
+	DebugLog LogLevel = iota
+
+	// InfoLog tracks info events.
+	InfoLog
+
+	// WarnLog tracks warning events.
+	WarnLog
+
+	// ErrorLog tracks error events.
+	ErrorLog
+)
+
+// Logger represents a structured scan logger.
+type Logger interface {
+	// Log records an event with key/value attributes.
+	Log(l LogLevel, msg string, kv ...any)
+}
+
+// ZeroLogger logs scan events via zerolog.
+type ZeroLogger struct {
+	log zerolog.Logger
+}
+
+// NewZeroLogger returns a new instance.
+func NewZeroLogger(l zerolog.Logger) *ZeroLogger {
+	return &ZeroLogger{log: l}
+}
+
+// Log records an event.
+func (z *ZeroLogger) Log(l LogLevel, msg string, kv ...any) {
+	z.log.WithLevel(toZeroLevel(l)).Fields(toFields(kv)).Msg(msg)
+}
+
+// DefaultLogger returns a logger backed by the global zerolog logger.
+func DefaultLogger() Logger {
+	return NewZeroLogger(log.Logger)
+}
+
+// WithLogger adds a logger to the context.
+func WithLogger(ctx context.Context, l Logger) context.Context {
+	return context.WithValue(ctx, KeyLogger, l)
+}
+
+// ExtractLogger extracts a logger from the context or defaults to the global logger.
+func ExtractLogger(ctx context.Context) Logger {
+	if l, ok := ctx.Value(KeyLogger).(Logger); ok {
+		return l
+	}
+
+	return DefaultLogger()
+}
+
+func toZeroLevel(l LogLevel) zerolog.Level {
+	switch l {
+	case DebugLog:
+		return zerolog.DebugLevel
+	case WarnLog:
+		return zerolog.WarnLevel
+	case ErrorLog:
+		return zerolog.ErrorLevel
+	default:
+		return zerolog.InfoLevel
+	}
+}
+
+func toFields(kv []any) map[string]interface{} {
+	ff := make(map[string]interface{}, len(kv)/2)
+	for i := 0; i < len(kv); i += 2 {
+		k := fmt.Sprintf("%v", kv[i])
+		if i+1 == len(kv) {
+			ff[k] = nil
+			continue
+		}
+		ff[k] = kv[i+1]
+	}
+
+	return ff
+}
@@ -5,7 +5,6 @@ package report
 
 import (
 	_ "embed"
-	"slices"
 
 	"bytes"
 	"encoding/json"
@@ -15,12 +14,12 @@ import (
 	"text/template"
 	"time"
 
+	"github.com/derailed/popeye/internal"
 	"github.com/derailed/popeye/internal/issues"
 	"github.com/derailed/popeye/internal/rules"
 	"github.com/derailed/popeye/pkg/config"
 	"github.com/derailed/popeye/types"
 	"github.com/prometheus/client_golang/prometheus/push"
-	"github.com/rs/zerolog/log"
 	"gopkg.in/yaml.v2"
 )
 
@@ -29,6 +28,10 @@ type Builder struct {
 	Report      Report `json:"popeye" yaml:"popeye"`
 	ClusterName string
 	ContextName string
+	utilization *internal.Utilization
+	logger      internal.Logger
+	maxIssues   int
+	sortBy      string
 }
 
 // NewBuilder returns a new instance.
@@ -43,11 +46,118 @@ func (b *Builder) SetClusterContext(cl, ct string) {
 	b.Report.Timestamp = time.Now().Format(time.RFC3339)
 }
 
+// SetLogger sets the scan logger.
+func (b *Builder) SetLogger(l internal.Logger) {
+	b.logger = l
+}
+
+func (b *Builder) scanLogger() internal.Logger {
+	if b.logger == nil {
+		return internal.DefaultLogger()
+	}
+
+	return b.logger
+}
+
+// SetUtilization sets the containers utilization samples.
+func (b *Builder) SetUtilization(u *internal.Utilization) {
+	b.utilization = u
+}
+
+// SetMaxIssues caps the number of issues rendered per resource.
+// Scores and tallies still account for all issues.
+func (b *Builder) SetMaxIssues(n int) {
+	b.maxIssues = n
+}
+
+// SetSort sets the order in which resources are rendered.
+func (b *Builder) SetSort(by string) {
+	b.sortBy = by
+}
+
+// view returns a copy of the builder normalized for rendering. Sections and
+// issue groups are ordered so encoders yield the same output across runs.
+func (b *Builder) view() *Builder {
+	v := *b
+	v.Report.Sections = make(Sections, 0, len(b.Report.Sections))
+	for _, s := range b.Report.Sections {
+		s.Outcome = ordered(s.Outcome).Truncate(b.maxIssues)
+		v.Report.Sections = append(v.Report.Sections, s)
+	}
+	sort.Stable(v.Report.Sections)
+
+	return &v
+}
+
+// Sections returns the report sections normalized for rendering.
+func (b *Builder) Sections() Sections {
+	return b.view().Report.Sections
+}
+
+// SortBy returns the order in which resources are rendered.
+func (b *Builder) SortBy() string {
+	return b.sortBy
+}
+
 // HasContent checks if we actually have anything to report.
 func (b *Builder) HasContent() bool {
 	return b.Report.sectionsCount != 0
 }
 
+// Scanned returns the number of resources examined across all sections.
+func (b *Builder) Scanned() int {
+	var count int
+	for _, s := range b.Report.Sections {
+		count += s.Scanned
+	}
+
+	return count
+}
+
+// ScannedBy returns the number of resources examined per section.
+func (b *Builder) ScannedBy() map[string]int {
+	cc := make(map[string]int, len(b.Report.Sections))
+	for _, s := range b.Report.Sections {
+		cc[s.Title] += s.Scanned
+	}
+
+	return cc
+}
+
+// Flagged returns the number of resources with issues across all sections.
+func (b *Builder) Flagged() int {
+	var count int
+	for _, s := range b.Report.Sections {
+		count += s.Flagged
+	}
+
+	return count
+}
+
+// flagged returns the number of resources with issues in an outcome.
+func flagged(o issues.Outcome) int {
+	var count int
+	for _, ii := range o {
+		if len(ii) > 0 {
+			count++
+		}
+	}
+
+	return count
+}
+
+// ErrCount returns the number of resources in error across all sections.
+func (b *Builder) ErrCount() int {
+	var count int
+	for _, s := range b.Report.Sections {
+		if s.Tally != nil {
+			count += s.Tally.ErrCount()
+		}
+	}
+
+	return count
+}
+
 // AddError record an error associated with the report.
 func (b *Builder) AddError(err error) {
 	b.Report.Errors = append(b.Report.Errors, err)
@@ -61,6 +171,8 @@ func (b *Builder) AddSection(gvr types.GVR, singular string, o issues.Outcome, t
 		singular: singular,
 		Tally:    t,
 		Outcome:  o,
+		Scanned:  len(o),
+		Flagged:  flagged(o),
 	}
 	b.Report.Sections = append(b.Report.Sections, section)
 	if t.IsValid() {
@@ -69,10 +181,57 @@ func (b *Builder) AddSection(gvr types.GVR, singular string, o issues.Outcome, t
 	}
 }
 
+// AddStreamedSection adds a linter section whose issues were streamed out.
+// Only the section tally and counts are retained.
+func (b *Builder) AddStreamedSection(gvr types.GVR, singular string, o issues.Outcome, t *Tally) {
+	b.AddSection(gvr, singular, o, t)
+	b.Report.Sections[len(b.Report.Sections)-1].Outcome = nil
+}
+
+// AddContext folds a kube context scan into the report. Resource FQNs are
+// prefixed by the context name so resources from several clusters can coexist.
+func (b *Builder) AddContext(ct string, o *Builder) {
+	for _, e := range o.Report.Errors {
+		b.AddError(fmt.Errorf("%s: %w", ct, e))
+	}
+	for _, s := range o.Report.Sections {
+		var oo issues.Outcome
+		if s.Outcome != nil {
+			oo = make(issues.Outcome, len(s.Outcome))
+		}
+		for fqn, ii := range s.Outcome {
+			oo[ContextFQN(ct, fqn)] = ii
+		}
+		b.mergeSection(s, oo)
+	}
+	b.retally()
+}
+
+// ContextFQN returns a resource FQN scoped by a kube context.
+func ContextFQN(ct, fqn string) string {
+	return ct + "/" + fqn
+}
+
+func (b *Builder) retally() {
+	b.Report.sectionsCount, b.Report.totalScore = 0, 0
+	for i := range b.Report.Sections {
+		s := &b.Report.Sections[i]
+		if s.Outcome != nil {
+			s.Tally = NewTally().Rollup(s.Outcome)
+			s.Scanned, s.Flagged = len(s.Outcome), flagged(s.Outcome)
+		}
+		if s.Tally == nil {
+			continue
+		}
+		b.Report.sectionsCount++
+		b.Report.totalScore += s.Tally.Score()
+	}
+}
+
 // ToJunit dumps scan to JUnit.
 func (b *Builder) ToJunit(level rules.Level) (string, error) {
 	b.finalize()
-	raw, err := junitMarshal(b, level)
+	raw, err := junitMarshal(b.view(), level)
 	if err != nil {
 		return "", err
 	}
@@ -84,12 +243,13 @@ func (b *Builder) finalize() {
 	score := b.Report.totalScore / b.Report.sectionsCount
 	b.Report.Score = score
 	b.Report.Grade = Grade(score)
+	b.Report.Histogram = b.IssueHistogram()
 }
 
 // ToYAML dumps scan to YAML.
 func (b *Builder) ToYAML() (string, error) {
 	b.finalize()
-	raw, err := yaml.Marshal(b)
+	raw, err := yaml.Marshal(b.view())
 	if err != nil {
 		return "", err
 	}
@@ -100,7 +260,7 @@ func (b *Builder) ToYAML() (string, error) {
 // ToJSON dumps scan to JSON.
 func (b *Builder) ToJSON() (string, error) {
 	b.finalize()
-	raw, err := json.Marshal(b)
+	raw, err := json.Marshal(b.view())
 	if err != nil {
 		return "", err
 	}
@@ -112,11 +272,15 @@ func (b *Builder) ToJSON() (string, error) {
 func (b *Builder) ToHTML() (string, error) {
 	b.finalize()
 
+	v := b.view()
 	fMap := template.FuncMap{
 		"toEmoji": toEmoji,
 		"toTitle": Titleize,
 		"isRoot":  isRoot,
-		"list":    b.Report.ListSections,
+		"list":    v.Report.ListSections,
+		"sorted": func(o issues.Outcome) []string {
+			return SortedKeys(o, v.sortBy)
+		},
 	}
 	tpl, err := template.New("sanitize").Funcs(fMap).Parse(htmlReport)
 	if err != nil {
@@ -124,7 +288,7 @@ func (b *Builder) ToHTML() (string, error) {
 	}
 
 	buff := bytes.NewBufferString("")
-	if err := tpl.Execute(buff, b); err != nil {
+	if err := tpl.Execute(buff, v); err != nil {
 		return "", err
 	}
 
@@ -135,12 +299,15 @@ func (b *Builder) ToHTML() (string, error) {
 func (b *Builder) ToPrometheus(gtwy *config.PushGateway, instance, ns, asset string, cc rules.Glossary) *push.Pusher {
 	b.finalize()
 
-	log.Debug().Msgf("Pushing prom metrics from instance: %q", instance)
-	p := newPusher(gtwy, instance)
+	l := b.scanLogger()
+	l.Log(internal.DebugLog, "pushing prometheus metrics", "instance", instance)
+	cpu, mem := newUtilizationVecs(gtwy.UtilizationBuckets())
+	p := newPusher(l, gtwy, instance, cpu, mem)
 	if ns == "" {
 		ns = "all"
 	}
 	b.promCollect(ns, asset, cc)
+	b.utilizationCollect(cpu, mem)
 
 	return p
 }
@@ -164,10 +331,41 @@ func (b *Builder) PrintSummary(s *ScanReport) {
 		for _, l := range s.Badge(b.Report.Score) {
 			fmt.Fprintf(s, "%s%s\n", strings.Repeat(" ", Width-20), l)
 		}
+		fmt.Fprintf(s, "Scanned %d resources, %d with issues\n", b.Scanned(), b.Flagged())
 	}
 	s.Close()
 }
 
+// LevelCounts returns the number of issues per severity level across all sections.
+func (b *Builder) LevelCounts() map[rules.Level]int {
+	cc := make(map[rules.Level]int, 4)
+	for _, s := range b.Report.Sections {
+		for _, ii := range s.Outcome {
+			for _, i := range ii {
+				cc[i.Level]++
+			}
+		}
+	}
+
+	return cc
+}
+
+// PrintFooter prints out a one line issues count by level and grade.
+func (b *Builder) PrintFooter(s *ScanReport) {
+	if b.Report.sectionsCount == 0 {
+		return
+	}
+
+	b.finalize()
+	cc := b.LevelCounts()
+	fmt.Fprintf(s, "Errors: %d  Warnings: %d  Infos: %d  Grade: %s\n",
+		cc[rules.ErrorLevel],
+		cc[rules.WarnLevel],
+		cc[rules.InfoLevel],
+		b.Report.Grade,
+	)
+}
+
 // PrintClusterInfo displays cluster information.
 func (b *Builder) PrintClusterInfo(s *ScanReport, metrics bool) {
 	cl := b.ClusterName
@@ -207,17 +405,11 @@ func (b *Builder) PrintHeader(s *ScanReport) {
 
 // PrintReport prints out scan report to screen
 func (b *Builder) PrintReport(level rules.Level, s *ScanReport) {
-	for _, section := range b.Report.Sections {
+	for _, section := range b.view().Report.Sections {
 		var any bool
 		s.Open(Titleize(section.Title, len(section.Outcome)), section.Tally)
 		{
-			kk := make([]string, 0, len(section.Outcome))
-			for k := range section.Outcome {
-				kk = append(kk, k)
-			}
-			slices.SortFunc(kk, issues.SortKeys)
-
-			for _, res := range kk {
+			for _, res := range SortedKeys(section.Outcome, b.sortBy) {
 				ii := section.Outcome[res]
 				if len(ii) == 0 {
 					if level <= rules.OkLevel {
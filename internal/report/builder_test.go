@@ -5,8 +5,12 @@ package report_test
 
 import (
 	"bytes"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/derailed/popeye/internal/issues"
 	"github.com/derailed/popeye/internal/report"
@@ -83,6 +87,148 @@ func TestBuilderJSON(t *testing.T) {
 	assert.Equal(t, reportJSON, s)
 }
 
+func TestBuilderMaxIssues(t *testing.T) {
+	gvr := types.NewGVR("v1/pods")
+	ii := make(issues.Issues, 0, 10)
+	for i := 0; i < 10; i++ {
+		ii = append(ii, issues.New(gvr, issues.Root, rules.WarnLevel, fmt.Sprintf("i%d", i)))
+	}
+	o := issues.Outcome{"default/p1": ii}
+
+	b := report.NewBuilder()
+	b.AddSection(gvr, "pod", o, report.NewTally().Rollup(o))
+	s1, err := b.ToScore()
+	assert.NoError(t, err)
+	b.SetMaxIssues(3)
+	s2, err := b.ToScore()
+	assert.NoError(t, err)
+	assert.Equal(t, s1, s2)
+
+	raw, err := b.ToJSON()
+	assert.NoError(t, err)
+	var r struct {
+		Popeye struct {
+			Sections []struct {
+				Issues map[string]issues.Issues `json:"issues"`
+			} `json:"sections"`
+		} `json:"popeye"`
+	}
+	assert.NoError(t, json.Unmarshal([]byte(raw), &r))
+	tt := r.Popeye.Sections[0].Issues["default/p1"]
+	assert.Equal(t, 4, len(tt))
+	assert.Equal(t, "i2", tt[2].Message)
+	assert.Equal(t, "(+7 more)", tt[3].Message)
+	assert.Equal(t, rules.WarnLevel, tt[3].Level)
+
+	buff := bytes.NewBuffer([]byte(""))
+	b.PrintReport(rules.OkLevel, report.New(buff, true))
+	assert.Contains(t, buff.String(), "(+7 more)")
+	assert.NotContains(t, buff.String(), "i3")
+	assert.Equal(t, 10, len(o["default/p1"]))
+	assert.Equal(t, 10, b.LevelCounts()[rules.WarnLevel])
+}
+
+func TestBuilderSort(t *testing.T) {
+	gvr := types.NewGVR("v1/pods")
+	o := issues.Outcome{
+		"default/p3": issues.Issues{issues.New(gvr, issues.Root, rules.InfoLevel, "m3")},
+		"default/p1": issues.Issues{issues.New(gvr, issues.Root, rules.WarnLevel, "m1")},
+		"default/p4": issues.Issues{issues.New(gvr, issues.Root, rules.ErrorLevel, "m4")},
+		"default/p2": issues.Issues{issues.New(gvr, issues.Root, rules.ErrorLevel, "m2")},
+	}
+
+	uu := map[string]struct {
+		by string
+		ee []string
+	}{
+		"default": {
+			ee: []string{"default/p1", "default/p2", "default/p3", "default/p4"},
+		},
+		"name": {
+			by: report.SortByName,
+			ee: []string{"default/p1", "default/p2", "default/p3", "default/p4"},
+		},
+		"severity": {
+			by: report.SortBySeverity,
+			ee: []string{"default/p2", "default/p4", "default/p1", "default/p3"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.ee, report.SortedKeys(o, u.by))
+
+			b := report.NewBuilder()
+			b.AddSection(gvr, "pod", o, report.NewTally().Rollup(o))
+			b.SetSort(u.by)
+			for i := 0; i < 3; i++ {
+				buff := bytes.NewBuffer([]byte(""))
+				b.PrintReport(rules.OkLevel, report.New(buff, true))
+				assertOrdered(t, buff.String(), u.ee)
+
+				raw, err := b.ToJunit(rules.OkLevel)
+				assert.NoError(t, err)
+				assertOrdered(t, raw, u.ee)
+
+				raw, err = b.ToHTML()
+				assert.NoError(t, err)
+				assertOrdered(t, raw, u.ee)
+			}
+		})
+	}
+}
+
+func TestBuilderTimings(t *testing.T) {
+	b, gvr := report.NewBuilder(), types.NewGVR("v1/pods")
+	o := issues.Outcome{"default/p1": issues.Issues{}}
+	b.AddSection(gvr, "pod", o, report.NewTally().Rollup(o))
+	b.SetElapsed(gvr, 1500*time.Millisecond)
+
+	assert.Equal(t, map[string]time.Duration{"pods": 1500 * time.Millisecond}, b.Timings())
+	raw, err := b.ToJSON()
+	assert.NoError(t, err)
+	assert.Contains(t, raw, `"elapsed":"1.5s"`)
+
+	buff := bytes.NewBuffer([]byte(""))
+	b.PrintTimings(report.New(buff, true))
+	assert.Contains(t, buff.String(), "TIMINGS")
+	assert.Contains(t, buff.String(), "pods")
+	assert.Contains(t, buff.String(), "1.5s")
+}
+
+func TestBuilderStreamedSections(t *testing.T) {
+	gvr := types.NewGVR("v1/pods")
+	scan := func(l rules.Level) *report.Builder {
+		o := issues.Outcome{
+			"default/p1": issues.Issues{issues.New(gvr, issues.Root, l, "[POP-206] blah")},
+			"default/p2": issues.Issues{},
+		}
+		b := report.NewBuilder()
+		b.AddStreamedSection(gvr, "pod", o, report.NewTally().Rollup(o))
+
+		return b
+	}
+
+	b := scan(rules.ErrorLevel)
+	assert.Nil(t, b.Report.Sections[0].Outcome)
+	assert.Equal(t, 2, b.Scanned())
+	assert.Equal(t, 1, b.Flagged())
+	assert.Equal(t, 1, b.ErrCount())
+
+	m := report.NewBuilder()
+	m.AddContext("c1", b)
+	m.AddContext("c2", scan(rules.InfoLevel))
+	assert.Equal(t, 1, len(m.Report.Sections))
+	assert.Nil(t, m.Report.Sections[0].Outcome)
+	assert.Equal(t, 4, m.Scanned())
+	assert.Equal(t, 2, m.Flagged())
+	assert.Equal(t, 1, m.ErrCount())
+	score, err := m.ToScore()
+	assert.NoError(t, err)
+	assert.Equal(t, 75, score)
+}
+
 func TestPrintSummary(t *testing.T) {
 	b, ta := report.NewBuilder(), report.NewTally()
 	o := issues.Outcome{
@@ -102,6 +248,44 @@ func TestPrintSummary(t *testing.T) {
 	assert.Equal(t, summaryExp, buff.String())
 }
 
+func TestPrintFooter(t *testing.T) {
+	b := report.NewBuilder()
+	po, svc := types.NewGVR("v1/pods"), types.NewGVR("v1/services")
+	oo := map[types.GVR]issues.Outcome{
+		po: {
+			"default/p1": issues.Issues{
+				issues.New(po, issues.Root, rules.ErrorLevel, "Blah"),
+				issues.New(po, "c1", rules.WarnLevel, "Blah"),
+				issues.New(po, "c1", rules.InfoLevel, "Blah"),
+			},
+			"default/p2": issues.Issues{
+				issues.New(po, issues.Root, rules.WarnLevel, "Blah"),
+			},
+		},
+		svc: {
+			"default/s1": issues.Issues{
+				issues.New(svc, issues.Root, rules.ErrorLevel, "Blah"),
+				issues.New(svc, issues.Root, rules.OkLevel, "Blah"),
+			},
+		},
+	}
+	counts := make(map[rules.Level]int)
+	for gvr, o := range oo {
+		b.AddSection(gvr, gvr.R(), o, report.NewTally().Rollup(o))
+		for _, ii := range o {
+			for _, i := range ii {
+				counts[i.Level]++
+			}
+		}
+	}
+	assert.Equal(t, counts, b.LevelCounts())
+
+	buff := bytes.NewBuffer([]byte(""))
+	b.PrintFooter(report.New(buff, false))
+
+	assert.Equal(t, "Errors: 2  Warnings: 2  Infos: 1  Grade: F\n", buff.String())
+}
+
 func TestPrintHeader(t *testing.T) {
 	b, ta := report.NewBuilder(), report.NewTally()
 	o := issues.Outcome{
@@ -164,9 +348,24 @@ func TestTitleize(t *testing.T) {
 var (
 	reportHTML  = "<html>\n<head>\n  <title>Popeye Scan Report</title>\n  <script src=\"https://kit.fontawesome.com/b45e86135f.js\" crossorigin=\"anonymous\"></script>\n</head>\n<style>\n  body {\n    background-color: #111;\n    color: white;\n    font-family: 'Gill Sans', 'Gill Sans MT', Calibri, 'Trebuchet MS', sans-serif;\n  }\n  .linter {\n    padding: 10px 30px;\n  }\n  ul.outcome {\n    list-style-type: disc;\n  }\n  div.clear {\n    display: block;\n  }\n  .outcome-score {\n    float: right;\n  }\n  div.outcome {\n    display: inline-block;\n  }\n  .issue {\n    text-align: right;\n  }\n  ul.issues {\n    display: block;\n    padding-left: 15px;\n  }\n  ul.sub-issues {\n    padding-left: 20px;\n  }\n  .section {\n    padding-top: 30px;\n  }\n  .section-title {\n    text-transform: uppercase;\n    float: left;\n  }\n  .scores {\n    text-align: right;\n  }\n  .msg {\n    display: block;\n  }\n  .section-score {\n    color: purple;\n  }\n  .scorer {\n    padding-right: 3px;\n  }\n  .level-0 {\n    color: rgb(65, 255, 65);\n  }\n  .level-1 {\n    color: rgb(2, 156, 207);\n  }\n  .level-2 {\n    color: rgb(255, 193, 77);\n  }\n  .level-3 {\n    color: rgb(199, 39, 39);\n  }\n  .grade-A {\n    color: rgb(65, 255, 65);\n  }\n  .grade-B {\n    color: rgb(2, 156, 207);\n  }\n  .grade-C {\n    color: rgb(255, 193, 77);\n  }\n  .grade-D {\n    color: rgb(199, 39, 39);\n  }\n  .grade-E {\n    color: rgb(199, 39, 39);\n  }\n  .grade-F {\n    color: rgb(199, 39, 39);\n  }\n  .grade {\n    font-size: 5em;\n  }\n  .container {\n    color: #38ABCC;\n  }\n  div.time {\n    font-style: italic;\n    text-transform: uppercase;\n    font-size: .8em;\n    color: gray;\n  }\n  span.cluster {\n    font-style: italic;\n    text-transform: uppercase;\n    color: greenyellow;\n  }\n  h3 {\n    border-bottom: 1px dashed black;\n    width: 50%;\n  }\n  span.cluster-score {\n    font-size: 3em;\n  }\n  div.score-summary {\n    flex: 3 1 auto;\n    font-size: 2em;\n    text-align: left;\n  }\n  div.title {\n    font-size: 3em;\n    text-align: center;\n  }\n  a.popeye-logo {\n    display: inline-block;\n  }\n  div.summary {\n    display: flex;\n    flex-flow: row wrap;\n    align-items: center;\n    font-weight: 2em;\n  }\n  img.logo {\n    max-width: 175px;\n    border-radius: 10px;\n    -webkit-filter: drop-shadow(8px 8px 10px #373831);\n    filter: drop-shadow(8px 8px 10px #373831);\n  }\n  div.a {\n    color: blue;\n    float: left;\n    display: block;\n  }\n  div.scorer {\n    text-align: right;\n  }\n</style>\n\n<body>\n  <div class=\"linter\">\n    <div class=\"title\">Popeye Scan Report</div>\n    <div class=\"summary\">\n      <a class=\"popeye-logo\" href=\"https://github.com/derailed/popeye\">\n        <img class=\"logo\" src=\"https://github.com/derailed/popeye/raw/master/assets/popeye_logo.png\" />\n      </a>\n      <div class=\"score-summary\">\n        Scanned\n        <span class=\"cluster\">/</span>\n        <div class=\"time\"></div>\n      </div>\n      <div class=\"scorer\">\n        <span class=\"grade grade-A\">A</span>\n        <span class=\"section-score cluster-score\"> 100 </span>\n      </div>\n    </div>\n    <div class=\"section\">\n      <hr />\n      <div class=\"section-title\">FRED (1 SCANNED)</div>\n      <div class=\"scores\">\n        <span class=\"scorer level-3\"> <i class=\"fas fa-bomb\"></i> 0 </span>\n        <span class=\"scorer level-2\"> <i class=\"fas fa-radiation-alt\"></i> 0 </span>\n        <span class=\"scorer level-1\"> <i class=\"fas fa-info-circle\"></i> 0 </span>\n        <span class=\"scorer level-0\"> <i class=\"far fa-check-circle\"></i> 1 </span>\n        <span class=\"section-score\">100%</span>\n      </div>\n      <ul class=\"outcome\">\n        <li>\n          <div class=\"outcome level-0\">blee</div>\n          <div class=\"outcome-score level-0\"><i class=\"far fa-check-circle\"></i></div>\n          <div class=\"clear\"></div>\n          <ul class=\"issues\">\n            <li><span class=\" msg level-0\"><i class=\"far fa-check-circle\"></i> Blah</span></li>\n          </ul>\n        </li>\n        </ul>\n      </div>\n    </div>\n</body>\n</html>"
 	reportJunit = "<testsuites name=\"Popeye\" report_time=\"\" tests=\"1\" failures=\"0\" errors=\"1\">\n\t<testsuite name=\"fred\" tests=\"1\" failures=\"0\" errors=\"0\">\n\t\t<properties>\n\t\t\t<property name=\"OK\" value=\"1\"></property>\n\t\t\t<property name=\"Info\" value=\"0\"></property>\n\t\t\t<property name=\"Warn\" value=\"0\"></property>\n\t\t\t<property name=\"Error\" value=\"0\"></property>\n\t\t\t<property name=\"Score\" value=\"100%\"></property>\n\t\t</properties>\n\t\t<testcase classname=\"\" name=\"blee\"></testcase>\n\t</testsuite>\n</testsuites>"
-	reportJSON  = "{\"popeye\":{\"report_time\":\"\",\"score\":100,\"grade\":\"A\",\"sections\":[{\"linter\":\"fred\",\"gvr\":\"fred\",\"tally\":{\"ok\":1,\"info\":0,\"warning\":0,\"error\":0,\"score\":100},\"issues\":{\"blee\":[{\"group\":\"__root__\",\"gvr\":\"fred\",\"level\":0,\"message\":\"Blah\"}]}}],\"errors\":{\"error\":\"boom\"}},\"ClusterName\":\"\",\"ContextName\":\"\"}"
-	reportYAML  = "popeye:\n  report_time: \"\"\n  score: 100\n  grade: A\n  sections:\n  - linter: fred\n    gvr: fred\n    tally:\n      ok: 1\n      info: 0\n      warning: 0\n      error: 0\n      score: 100\n    issues:\n      blee:\n      - group: __root__\n        gvr: fred\n        level: 0\n        message: Blah\n  errors:\n  - boom\nclustername: \"\"\ncontextname: \"\"\n"
-	summaryExp  = "\n\x1b[38;5;75mSUMMARY\x1b[0m\n\x1b[38;5;75m┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅\x1b[0m\n\x1b[38;5;122mYour cluster score: A (100)\n\x1b[0m                                                                                \x1b[38;5;82mo          .-'-.     \x1b[0m\n                                                                                \x1b[38;5;82m o     __| A    `\\  \x1b[0m\n                                                                                \x1b[38;5;82m  o   `-,-`--._   `\\\x1b[0m\n                                                                                \x1b[38;5;82m []  .->'  a     `|-'\x1b[0m\n                                                                                \x1b[38;5;82m  `=/ (__/_       /  \x1b[0m\n                                                                                \x1b[38;5;82m    \\_,    `    _)  \x1b[0m\n                                                                                \x1b[38;5;82m       `----;  |     \x1b[0m\n\n"
+	reportJSON  = "{\"popeye\":{\"report_time\":\"\",\"score\":100,\"grade\":\"A\",\"sections\":[{\"linter\":\"fred\",\"gvr\":\"fred\",\"tally\":{\"ok\":1,\"info\":0,\"warning\":0,\"error\":0,\"score\":100},\"issues\":{\"blee\":[{\"group\":\"__root__\",\"gvr\":\"fred\",\"level\":0,\"message\":\"Blah\"}]},\"scanned\":1,\"flagged\":1}],\"errors\":{\"error\":\"boom\"}},\"ClusterName\":\"\",\"ContextName\":\"\"}"
+	reportYAML  = "popeye:\n  report_time: \"\"\n  score: 100\n  grade: A\n  sections:\n  - linter: fred\n    gvr: fred\n    tally:\n      ok: 1\n      info: 0\n      warning: 0\n      error: 0\n      score: 100\n    issues:\n      blee:\n      - group: __root__\n        gvr: fred\n        level: 0\n        message: Blah\n    scanned: 1\n    flagged: 1\n  errors:\n  - boom\nclustername: \"\"\ncontextname: \"\"\n"
+	summaryExp  = "\n\x1b[38;5;75mSUMMARY\x1b[0m\n\x1b[38;5;75m┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅\x1b[0m\n\x1b[38;5;122mYour cluster score: A (100)\n\x1b[0m                                                                                \x1b[38;5;82mo          .-'-.     \x1b[0m\n                                                                                \x1b[38;5;82m o     __| A    `\\  \x1b[0m\n                                                                                \x1b[38;5;82m  o   `-,-`--._   `\\\x1b[0m\n                                                                                \x1b[38;5;82m []  .->'  a     `|-'\x1b[0m\n                                                                                \x1b[38;5;82m  `=/ (__/_       /  \x1b[0m\n                                                                                \x1b[38;5;82m    \\_,    `    _)  \x1b[0m\n                                                                                \x1b[38;5;82m       `----;  |     \x1b[0m\nScanned 1 resources, 1 with issues\n\n"
 	headerExp   = "\n\x1b[38;5;122m ___     ___ _____   _____ \x1b[0m                                                     \x1b[38;5;75mK          .-'-.     \x1b[0m\n\x1b[38;5;122m| _ \\___| _ \\ __\\ \\ / / __|\x1b[0m                                                     \x1b[38;5;75m 8     __|      `\\  \x1b[0m\n\x1b[38;5;122m|  _/ _ \\  _/ _| \\ V /| _| \x1b[0m                                                     \x1b[38;5;75m  s   `-,-`--._   `\\\x1b[0m\n\x1b[38;5;122m|_| \\___/_| |___| |_| |___|\x1b[0m                                                     \x1b[38;5;75m []  .->'  a     `|-'\x1b[0m\n\x1b[38;5;75m  Biffs`em and Buffs`em!\x1b[0m                                                        \x1b[38;5;75m  `=/ (__/_       /  \x1b[0m\n                                                                                \x1b[38;5;75m    \\_,    `    _)  \x1b[0m\n                                                                                \x1b[38;5;75m       `----;  |     \x1b[0m\n\n"
 	reportExp   = "\n\x1b[38;5;75mFRED (1 SCANNED)\x1b[0m                                                             💥 0 😱 0 🔊 0 ✅ 1 \x1b[38;5;122m100\x1b[0m٪\n\x1b[38;5;75m┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅┅\x1b[0m\n  · \x1b[38;5;155mblee\x1b[0m\x1b[38;5;250m...........................................................................................\x1b[0m✅\n    ✅ \x1b[38;5;155mBlah.\x1b[0m\n\n"
 )
+
+// Helpers...
+
+func assertOrdered(t *testing.T, s string, ee []string) {
+	var last int
+	for _, e := range ee {
+		idx := strings.Index(s, e)
+		if idx < 0 {
+			_, n, _ := strings.Cut(e, "/")
+			idx = strings.Index(s, `name="`+n+`"`)
+		}
+		assert.Greater(t, idx, last, e)
+		last = idx
+	}
+}
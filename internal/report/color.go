@@ -32,6 +32,13 @@ const (
 // Color tracks the output color.
 type Color int
 
+var colorless bool
+
+// DisableColors turns ANSI colors on or off for all outputs.
+func DisableColors(b bool) {
+	colorless = b
+}
+
 // Colorizef colorizes a formatted string.
 func Colorizef(c Color, fmat string, args ...interface{}) string {
 	return Colorize(fmt.Sprintf(fmat, args...), c)
@@ -39,6 +46,9 @@ func Colorizef(c Color, fmat string, args ...interface{}) string {
 
 // Colorize a string based on given color.
 func Colorize(s string, c Color) string {
+	if colorless {
+		return s
+	}
 	return "\033[38;5;" + strconv.Itoa(int(c)) + "m" + s + "\033[0m"
 }
 
@@ -4,9 +4,12 @@
 package report
 
 import (
+	"bytes"
 	"testing"
 
+	"github.com/derailed/popeye/internal/issues"
 	"github.com/derailed/popeye/internal/rules"
+	"github.com/derailed/popeye/types"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -23,3 +26,26 @@ func TestColorForLevel(t *testing.T) {
 		assert.Equal(t, v, colorForLevel(rules.Level(k)))
 	}
 }
+
+func TestDisableColors(t *testing.T) {
+	DisableColors(true)
+	defer DisableColors(false)
+
+	b := NewBuilder()
+	o := issues.Outcome{
+		"default/p1": issues.Issues{
+			issues.New(types.NewGVR("v1/pods"), issues.Root, rules.ErrorLevel, "Blah"),
+			issues.New(types.NewGVR("v1/pods"), "c1", rules.WarnLevel, "Blah"),
+		},
+	}
+	b.AddSection(types.NewGVR("v1/pods"), "pod", o, NewTally().Rollup(o))
+
+	buff := bytes.NewBuffer([]byte(""))
+	s := New(buff, false)
+	b.PrintClusterInfo(s, true)
+	b.PrintReport(rules.OkLevel, s)
+	b.PrintSummary(s)
+
+	assert.NotEmpty(t, buff.String())
+	assert.NotContains(t, buff.String(), "\033[")
+}
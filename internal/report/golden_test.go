@@ -0,0 +1,96 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Popeye
+
+package report_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/derailed/popeye/internal/issues"
+	"github.com/derailed/popeye/internal/report"
+	"github.com/derailed/popeye/internal/rules"
+	"github.com/derailed/popeye/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuilderGolden(t *testing.T) {
+	uu := map[string]struct {
+		file   string
+		encode func(*report.Builder) (string, error)
+	}{
+		"standard": {
+			file: "golden.txt",
+			encode: func(b *report.Builder) (string, error) {
+				buff := bytes.NewBuffer([]byte(""))
+				b.PrintReport(rules.OkLevel, report.New(buff, true))
+				return buff.String(), nil
+			},
+		},
+		"junit": {
+			file: "golden.xml",
+			encode: func(b *report.Builder) (string, error) {
+				return b.ToJunit(rules.OkLevel)
+			},
+		},
+		"json": {
+			file:   "golden.json",
+			encode: (*report.Builder).ToJSON,
+		},
+		"yaml": {
+			file:   "golden.yaml",
+			encode: (*report.Builder).ToYAML,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			exp, err := os.ReadFile(filepath.Join("test_assets", u.file))
+			assert.NoError(t, err)
+			for i := 0; i < 10; i++ {
+				s, err := u.encode(goldenBuilder())
+				assert.NoError(t, err)
+				assert.Equal(t, string(exp), s)
+			}
+		})
+	}
+}
+
+func TestBuilderHTMLStable(t *testing.T) {
+	exp, err := goldenBuilder().ToHTML()
+	assert.NoError(t, err)
+	for i := 0; i < 10; i++ {
+		s, err := goldenBuilder().ToHTML()
+		assert.NoError(t, err)
+		assert.Equal(t, exp, s)
+	}
+}
+
+// Helpers...
+
+func goldenBuilder() *report.Builder {
+	b := report.NewBuilder()
+	for _, gvr := range []types.GVR{types.NewGVR("v1/services"), types.NewGVR("v1/pods")} {
+		o := issues.Outcome{
+			"ns2/r1": issues.Issues{
+				issues.New(gvr, "c2", rules.WarnLevel, "[POP-100] c2 warn"),
+				issues.New(gvr, issues.Root, rules.InfoLevel, "[POP-200] root info"),
+				issues.New(gvr, "c1", rules.ErrorLevel, "[POP-101] c1 error"),
+				issues.New(gvr, "c2", rules.InfoLevel, "[POP-102] c2 info"),
+			},
+			"ns1/r2": issues.Issues{
+				issues.New(gvr, issues.Root, rules.WarnLevel, "[POP-201] root warn"),
+			},
+			"ns1/r1": issues.Issues{},
+			"ns10/r1": issues.Issues{
+				issues.New(gvr, "c1", rules.InfoLevel, "[POP-103] c1 info"),
+			},
+		}
+		b.AddSection(gvr, gvr.R(), o, report.NewTally().Rollup(o))
+	}
+
+	return b
+}
@@ -0,0 +1,66 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Popeye
+
+package report
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// topIssuesCount caps the number of codes listed in the top issues line.
+const topIssuesCount = 5
+
+// CodeCount tracks the number of occurrences of an issue code.
+type CodeCount struct {
+	Code  string `json:"code" yaml:"code"`
+	Count int    `json:"count" yaml:"count"`
+}
+
+// IssueHistogram tallies issues per code across all resources, most frequent
+// codes first.
+func (b *Builder) IssueHistogram() []CodeCount {
+	cc := make(map[string]int)
+	for _, s := range b.Report.Sections {
+		for _, ii := range s.Outcome {
+			for _, i := range ii {
+				if code, ok := i.Code(); ok {
+					cc[code]++
+				}
+			}
+		}
+	}
+
+	hh := make([]CodeCount, 0, len(cc))
+	for code, n := range cc {
+		hh = append(hh, CodeCount{Code: code, Count: n})
+	}
+	sort.Slice(hh, func(i, j int) bool {
+		if hh[i].Count != hh[j].Count {
+			return hh[i].Count > hh[j].Count
+		}
+		a, _ := strconv.Atoi(hh[i].Code)
+		b, _ := strconv.Atoi(hh[j].Code)
+		return a < b
+	})
+
+	return hh
+}
+
+// PrintTopIssues prints out the most frequent issue codes cluster wide.
+func (b *Builder) PrintTopIssues(s *ScanReport) {
+	hh := b.IssueHistogram()
+	if len(hh) == 0 {
+		return
+	}
+	if len(hh) > topIssuesCount {
+		hh = hh[:topIssuesCount]
+	}
+	ss := make([]string, 0, len(hh))
+	for _, h := range hh {
+		ss = append(ss, fmt.Sprintf("POP-%s ×%d", h.Code, h.Count))
+	}
+	fmt.Fprintf(s, "Top issues: %s\n", strings.Join(ss, ", "))
+}
@@ -0,0 +1,97 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Popeye
+
+package report_test
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/derailed/popeye/internal/issues"
+	"github.com/derailed/popeye/internal/report"
+	"github.com/derailed/popeye/internal/rules"
+	"github.com/derailed/popeye/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func newCodeIssues(gvr types.GVR, code, n int) issues.Issues {
+	ii := make(issues.Issues, 0, n)
+	for i := 0; i < n; i++ {
+		ii = append(ii, issues.New(gvr, issues.Root, rules.WarnLevel, fmt.Sprintf("[POP-%d] Blah", code)))
+	}
+
+	return ii
+}
+
+func TestIssueHistogram(t *testing.T) {
+	po, svc := types.NewGVR("v1/pods"), types.NewGVR("v1/services")
+	uu := map[string]struct {
+		oo map[types.GVR]issues.Outcome
+		e  []report.CodeCount
+	}{
+		"empty": {
+			e: []report.CodeCount{},
+		},
+		"no-codes": {
+			oo: map[types.GVR]issues.Outcome{
+				po: {"default/p1": issues.Issues{issues.New(po, issues.Root, rules.WarnLevel, "Blah")}},
+			},
+			e: []report.CodeCount{},
+		},
+		"multi": {
+			oo: map[types.GVR]issues.Outcome{
+				po: {
+					"default/p1": append(newCodeIssues(po, 206, 2), newCodeIssues(po, 300, 1)...),
+					"default/p2": newCodeIssues(po, 206, 1),
+				},
+				svc: {
+					"default/s1": append(newCodeIssues(svc, 1109, 1), newCodeIssues(svc, 300, 1)...),
+				},
+			},
+			e: []report.CodeCount{
+				{Code: "206", Count: 3},
+				{Code: "300", Count: 2},
+				{Code: "1109", Count: 1},
+			},
+		},
+		"ties": {
+			oo: map[types.GVR]issues.Outcome{
+				po: {
+					"default/p1": append(newCodeIssues(po, 1109, 2), newCodeIssues(po, 300, 2)...),
+				},
+			},
+			e: []report.CodeCount{
+				{Code: "300", Count: 2},
+				{Code: "1109", Count: 2},
+			},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			b := report.NewBuilder()
+			for gvr, o := range u.oo {
+				b.AddSection(gvr, gvr.R(), o, report.NewTally().Rollup(o))
+			}
+			assert.Equal(t, u.e, b.IssueHistogram())
+		})
+	}
+}
+
+func TestPrintTopIssues(t *testing.T) {
+	po := types.NewGVR("v1/pods")
+	ii := make(issues.Issues, 0, 20)
+	for code := 100; code < 106; code++ {
+		ii = append(ii, newCodeIssues(po, code, code-99)...)
+	}
+	o := issues.Outcome{"default/p1": ii}
+
+	b := report.NewBuilder()
+	b.AddSection(po, "pod", o, report.NewTally().Rollup(o))
+	buff := bytes.NewBuffer([]byte(""))
+	b.PrintTopIssues(report.New(buff, false))
+
+	assert.Equal(t, "Top issues: POP-105 ×6, POP-104 ×5, POP-103 ×4, POP-102 ×3, POP-101 ×2\n", buff.String())
+}
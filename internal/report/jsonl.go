@@ -0,0 +1,117 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Popeye
+
+package report
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+
+	"github.com/derailed/popeye/internal/issues"
+	"github.com/derailed/popeye/internal/rules"
+	"github.com/derailed/popeye/types"
+)
+
+const streamBuffer = 10
+
+// StreamIssue represents a single issue in a JSON Lines stream.
+type StreamIssue struct {
+	Context  string      `json:"context,omitempty"`
+	Section  string      `json:"section"`
+	GVR      string      `json:"gvr"`
+	Resource string      `json:"resource"`
+	Group    string      `json:"group"`
+	Level    rules.Level `json:"level"`
+	Message  string      `json:"message"`
+}
+
+// Streamer encodes issues as JSON Lines as they are pushed. Each pushed
+// linter outcome is flushed out once encoded.
+type Streamer struct {
+	issues    chan []StreamIssue
+	done      chan error
+	context   string
+	maxIssues int
+	sortBy    string
+}
+
+// NewStreamer returns a new instance streaming issues to the given writer.
+func NewStreamer(w io.Writer) *Streamer {
+	s := Streamer{
+		issues: make(chan []StreamIssue, streamBuffer),
+		done:   make(chan error, 1),
+	}
+	go func() {
+		s.done <- encodeJSONL(w, s.issues)
+	}()
+
+	return &s
+}
+
+// SetMaxIssues caps the number of issues streamed per resource.
+func (s *Streamer) SetMaxIssues(n int) {
+	s.maxIssues = n
+}
+
+// SetSort sets the order in which resources are streamed.
+func (s *Streamer) SetSort(by string) {
+	s.sortBy = by
+}
+
+// ForContext returns a streamer tagging issues with a kube context.
+func (s *Streamer) ForContext(ct string) *Streamer {
+	return &Streamer{issues: s.issues, done: s.done, context: ct, maxIssues: s.maxIssues, sortBy: s.sortBy}
+}
+
+// Push streams out a linter outcome.
+func (s *Streamer) Push(gvr types.GVR, o issues.Outcome) {
+	o = ordered(o).Truncate(s.maxIssues)
+	var ii []StreamIssue
+	for _, fqn := range SortedKeys(o, s.sortBy) {
+		for _, i := range o[fqn] {
+			ii = append(ii, StreamIssue{
+				Context:  s.context,
+				Section:  gvr.R(),
+				GVR:      gvr.String(),
+				Resource: fqn,
+				Group:    i.Group,
+				Level:    i.Level,
+				Message:  i.Message,
+			})
+		}
+	}
+	if len(ii) > 0 {
+		s.issues <- ii
+	}
+}
+
+// Close flushes the stream and reports encoding errors if any.
+func (s *Streamer) Close() error {
+	close(s.issues)
+
+	return <-s.done
+}
+
+func encodeJSONL(w io.Writer, c <-chan []StreamIssue) error {
+	var (
+		bw  = bufio.NewWriter(w)
+		enc = json.NewEncoder(bw)
+		err error
+	)
+	for ii := range c {
+		if err != nil {
+			continue
+		}
+		for _, i := range ii {
+			if err = enc.Encode(i); err != nil {
+				break
+			}
+		}
+		if err == nil {
+			err = bw.Flush()
+		}
+	}
+
+	return err
+}
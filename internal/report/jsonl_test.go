@@ -0,0 +1,100 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Popeye
+
+package report_test
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/derailed/popeye/internal/issues"
+	"github.com/derailed/popeye/internal/report"
+	"github.com/derailed/popeye/internal/rules"
+	"github.com/derailed/popeye/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStreamer(t *testing.T) {
+	po, svc := types.NewGVR("v1/pods"), types.NewGVR("v1/services")
+	var bb bytes.Buffer
+	s := report.NewStreamer(&bb)
+	s.Push(po, issues.Outcome{
+		"default/p2": issues.Issues{
+			issues.New(po, issues.Root, rules.WarnLevel, "[POP-206] no pdb"),
+		},
+		"default/p1": issues.Issues{
+			issues.New(po, issues.Root, rules.ErrorLevel, "[POP-207] bad phase"),
+			issues.New(po, "c1", rules.InfoLevel, "[POP-119] writable"),
+		},
+		"default/p3": issues.Issues{},
+	})
+	s.ForContext("ct1").Push(svc, issues.Outcome{
+		"default/s1": issues.Issues{
+			issues.New(svc, issues.Root, rules.InfoLevel, "[POP-1103] blah"),
+		},
+	})
+	assert.NoError(t, s.Close())
+
+	ee := []report.StreamIssue{
+		{Section: "pods", GVR: "v1/pods", Resource: "default/p1", Group: issues.Root, Level: rules.ErrorLevel, Message: "[POP-207] bad phase"},
+		{Section: "pods", GVR: "v1/pods", Resource: "default/p1", Group: "c1", Level: rules.InfoLevel, Message: "[POP-119] writable"},
+		{Section: "pods", GVR: "v1/pods", Resource: "default/p2", Group: issues.Root, Level: rules.WarnLevel, Message: "[POP-206] no pdb"},
+		{Context: "ct1", Section: "services", GVR: "v1/services", Resource: "default/s1", Group: issues.Root, Level: rules.InfoLevel, Message: "[POP-1103] blah"},
+	}
+	sc := bufio.NewScanner(&bb)
+	var count int
+	for sc.Scan() {
+		assert.True(t, json.Valid(sc.Bytes()), "invalid line %q", sc.Text())
+		var i report.StreamIssue
+		assert.NoError(t, json.Unmarshal(sc.Bytes(), &i))
+		assert.Equal(t, ee[count], i)
+		count++
+	}
+	assert.NoError(t, sc.Err())
+	assert.Equal(t, len(ee), count)
+}
+
+func TestStreamerEmpty(t *testing.T) {
+	var bb bytes.Buffer
+	s := report.NewStreamer(&bb)
+
+	assert.NoError(t, s.Close())
+	assert.Empty(t, bb.String())
+}
+
+func TestStreamerFlush(t *testing.T) {
+	po := types.NewGVR("v1/pods")
+	var w syncBuffer
+	s := report.NewStreamer(&w)
+	s.Push(po, issues.Outcome{
+		"default/p1": issues.Issues{
+			issues.New(po, issues.Root, rules.WarnLevel, "[POP-206] no pdb"),
+		},
+	})
+
+	assert.Eventually(t, func() bool { return w.Len() > 0 }, time.Second, 10*time.Millisecond)
+	assert.NoError(t, s.Close())
+}
+
+type syncBuffer struct {
+	bytes.Buffer
+	mx sync.Mutex
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mx.Lock()
+	defer b.mx.Unlock()
+
+	return b.Buffer.Write(p)
+}
+
+func (b *syncBuffer) Len() int {
+	b.mx.Lock()
+	defer b.mx.Unlock()
+
+	return b.Buffer.Len()
+}
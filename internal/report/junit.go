@@ -15,15 +15,21 @@ import (
 
 // TestSuites a collection of junit test suites.
 type TestSuites struct {
-	XMLName   xml.Name `xml:"testsuites"`
-	Name      string   `xml:"name,attr"`
-	Timestamp string   `xml:"report_time,attr"`
-	Tests     int      `xml:"tests,attr"`
-	Failures  int      `xml:"failures,attr"`
-	Errors    int      `xml:"errors,attr"`
+	XMLName   xml.Name   `xml:"testsuites"`
+	Name      string     `xml:"name,attr"`
+	Timestamp string     `xml:"report_time,attr"`
+	Tests     int        `xml:"tests,attr"`
+	Failures  int        `xml:"failures,attr"`
+	Errors    int        `xml:"errors,attr"`
+	Histogram *Histogram `xml:"properties,omitempty"`
 	Suites    []TestSuite
 }
 
+// Histogram represents issue code occurrences as junit properties.
+type Histogram struct {
+	Properties []Property `xml:"property"`
+}
+
 // TestSuite represents a collection of tests
 type TestSuite struct {
 	XMLName    xml.Name   `xml:"testsuite"`
@@ -72,14 +78,20 @@ func junitMarshal(b *Builder, level rules.Level) ([]byte, error) {
 		Errors:    len(b.Report.Errors),
 	}
 
+	if len(b.Report.Histogram) > 0 {
+		s.Histogram = new(Histogram)
+		for _, h := range b.Report.Histogram {
+			s.Histogram.Properties = append(s.Histogram.Properties, Property{Name: "POP-" + h.Code, Value: strconv.Itoa(h.Count)})
+		}
+	}
 	for _, section := range b.Report.Sections {
-		s.Suites = append(s.Suites, newSuite(section, level))
+		s.Suites = append(s.Suites, newSuite(section, level, b.sortBy))
 	}
 
 	return xml.MarshalIndent(s, "", "\t")
 }
 
-func newSuite(s Section, level rules.Level) TestSuite {
+func newSuite(s Section, level rules.Level, by string) TestSuite {
 	total, fails, errs := numTests(s.Outcome)
 	ts := TestSuite{
 		Name:     s.Title,
@@ -89,8 +101,8 @@ func newSuite(s Section, level rules.Level) TestSuite {
 	}
 	ts.Properties = tallyToProps(s.Tally, level)
 
-	for k, v := range s.Outcome {
-		ts.TestCases = append(ts.TestCases, newTestCase(k, v))
+	for _, k := range SortedKeys(s.Outcome, by) {
+		ts.TestCases = append(ts.TestCases, newTestCase(k, s.Outcome[k]))
 	}
 	return ts
 }
@@ -0,0 +1,89 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Popeye
+
+package report
+
+import (
+	"github.com/derailed/popeye/internal/issues"
+)
+
+// Merge combines several scan reports into a new one. Sections are matched
+// by GVR and issues reported more than once for the same resource and code
+// are only kept once. Tallies and scores are recomputed from the merged
+// issues rather than averaged across reports.
+func Merge(bb ...*Builder) *Builder {
+	m := NewBuilder()
+	for _, b := range bb {
+		if b == nil {
+			continue
+		}
+		if m.ClusterName == "" {
+			m.ClusterName, m.ContextName = b.ClusterName, b.ContextName
+		}
+		if b.Report.Timestamp > m.Report.Timestamp {
+			m.Report.Timestamp = b.Report.Timestamp
+		}
+		m.Report.Errors = append(m.Report.Errors, b.Report.Errors...)
+		for _, s := range b.Report.Sections {
+			m.mergeSection(s, s.Outcome)
+		}
+	}
+	m.retally()
+
+	return m
+}
+
+// mergeSection folds an outcome into the section matching the given one.
+// Streamed sections carry no outcome so their tallies are folded instead.
+func (b *Builder) mergeSection(s Section, o issues.Outcome) {
+	idx := b.Report.Sections.indexOf(s.GVR)
+	if idx < 0 {
+		section := Section{
+			Title:    s.Title,
+			GVR:      s.GVR,
+			singular: s.singular,
+		}
+		if o != nil {
+			section.Outcome = make(issues.Outcome, len(o))
+		}
+		b.Report.Sections = append(b.Report.Sections, section)
+		idx = len(b.Report.Sections) - 1
+	}
+	b.Report.Sections[idx].Elapsed += s.Elapsed
+	if o == nil {
+		b.Report.Sections[idx].mergeTally(s)
+		return
+	}
+	oo := b.Report.Sections[idx].Outcome
+	for fqn, ii := range o {
+		oo[fqn] = mergeIssues(oo[fqn], ii)
+	}
+}
+
+// mergeIssues appends new issues skipping codes already reported for the
+// resource by a previous source. Issues without a code are keyed by message.
+func mergeIssues(ii, nn issues.Issues) issues.Issues {
+	if ii == nil {
+		ii = make(issues.Issues, 0, len(nn))
+	}
+	seen := make(map[string]struct{}, len(ii))
+	for _, i := range ii {
+		seen[issueKey(i)] = struct{}{}
+	}
+	for _, i := range nn {
+		if _, ok := seen[issueKey(i)]; ok {
+			continue
+		}
+		ii = append(ii, i)
+	}
+
+	return ii
+}
+
+func issueKey(i issues.Issue) string {
+	if c, ok := i.Code(); ok {
+		return c
+	}
+
+	return i.Message
+}
@@ -0,0 +1,114 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Popeye
+
+package report_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/derailed/popeye/internal/issues"
+	"github.com/derailed/popeye/internal/report"
+	"github.com/derailed/popeye/internal/rules"
+	"github.com/derailed/popeye/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMerge(t *testing.T) {
+	po, svc := types.NewGVR("v1/pods"), types.NewGVR("v1/services")
+	uu := map[string]struct {
+		bb       []*report.Builder
+		sections int
+		issues   map[string]int
+		score    int
+		errs     int
+	}{
+		"empty": {},
+		"disjoint": {
+			bb: []*report.Builder{
+				makeBuilder(po, "default/p1", issues.New(po, issues.Root, rules.ErrorLevel, "[POP-206] blah")),
+				makeBuilder(svc, "default/s1", issues.New(svc, issues.Root, rules.InfoLevel, "[POP-1103] blah")),
+			},
+			sections: 2,
+			issues:   map[string]int{"default/p1": 1, "default/s1": 1},
+			score:    50,
+			errs:     2,
+		},
+		"overlapping": {
+			bb: []*report.Builder{
+				makeBuilder(po, "default/p1",
+					issues.New(po, issues.Root, rules.ErrorLevel, "[POP-206] blah"),
+					issues.New(po, "c1", rules.WarnLevel, "[POP-106] blah"),
+				),
+				makeBuilder(po, "default/p1",
+					issues.New(po, issues.Root, rules.ErrorLevel, "[POP-206] blah"),
+					issues.New(po, "c2", rules.WarnLevel, "[POP-106] blah"),
+				),
+				makeBuilder(po, "default/p2"),
+			},
+			sections: 1,
+			issues:   map[string]int{"default/p1": 2, "default/p2": 0},
+			score:    50,
+			errs:     3,
+		},
+		"same-code": {
+			bb: []*report.Builder{
+				makeBuilder(po, "default/p1",
+					issues.New(po, issues.Root, rules.InfoLevel, `[POP-401] Key "k1" used? Unable to locate key reference`),
+					issues.New(po, issues.Root, rules.InfoLevel, `[POP-401] Key "k2" used? Unable to locate key reference`),
+				),
+			},
+			sections: 1,
+			issues:   map[string]int{"default/p1": 2},
+			score:    100,
+			errs:     1,
+		},
+		"differing-message": {
+			bb: []*report.Builder{
+				makeBuilder(po, "default/p1", issues.New(po, "c1", rules.WarnLevel, `[POP-108] Unnamed port 3000`)),
+				makeBuilder(po, "default/p1", issues.New(po, "c2", rules.WarnLevel, `[POP-108] Unnamed port 8080`)),
+				makeBuilder(po, "default/p2", issues.New(po, "c1", rules.WarnLevel, `[POP-108] Unnamed port 3000`)),
+			},
+			sections: 1,
+			issues:   map[string]int{"default/p1": 1, "default/p2": 1},
+			score:    0,
+			errs:     3,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			b := report.Merge(u.bb...)
+
+			assert.Equal(t, u.sections, len(b.Report.Sections))
+			for fqn, count := range u.issues {
+				var found bool
+				for _, s := range b.Report.Sections {
+					if ii, ok := s.Outcome[fqn]; ok {
+						found = true
+						assert.Equal(t, count, len(ii))
+					}
+				}
+				assert.True(t, found, fqn)
+			}
+			assert.Equal(t, u.errs, len(b.Report.Errors))
+			if u.sections == 0 {
+				assert.False(t, b.HasContent())
+				return
+			}
+			score, err := b.ToScore()
+			assert.NoError(t, err)
+			assert.Equal(t, u.score, score)
+		})
+	}
+}
+
+func makeBuilder(gvr types.GVR, fqn string, ii ...issues.Issue) *report.Builder {
+	o := issues.Outcome{fqn: ii}
+	b := report.NewBuilder()
+	b.AddSection(gvr, gvr.R(), o, report.NewTally().Rollup(o))
+	b.AddError(errors.New("boom"))
+
+	return b
+}
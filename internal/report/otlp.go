@@ -0,0 +1,177 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Popeye
+
+package report
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/derailed/popeye/internal/issues"
+	"github.com/derailed/popeye/internal/rules"
+)
+
+const (
+	otlpLogsPath    = "/v1/logs"
+	otlpTimeout     = 5 * time.Second
+	otlpServiceName = "popeye"
+)
+
+// OTLP severity numbers per the OpenTelemetry logs data model.
+var otlpSeverities = map[rules.Level]int{
+	rules.InfoLevel:  9,
+	rules.WarnLevel:  13,
+	rules.ErrorLevel: 17,
+}
+
+type (
+	// OTLPExporter ships scan issues to an OpenTelemetry collector as log
+	// records using the OTLP/HTTP JSON encoding.
+	OTLPExporter struct {
+		url    string
+		client *http.Client
+	}
+
+	otlpLogs struct {
+		ResourceLogs []otlpResourceLogs `json:"resourceLogs"`
+	}
+
+	otlpResourceLogs struct {
+		Resource  otlpResource    `json:"resource"`
+		ScopeLogs []otlpScopeLogs `json:"scopeLogs"`
+	}
+
+	otlpResource struct {
+		Attributes []otlpKeyValue `json:"attributes"`
+	}
+
+	otlpScopeLogs struct {
+		Scope      otlpScope       `json:"scope"`
+		LogRecords []otlpLogRecord `json:"logRecords"`
+	}
+
+	otlpScope struct {
+		Name string `json:"name"`
+	}
+
+	otlpLogRecord struct {
+		TimeUnixNano   string         `json:"timeUnixNano"`
+		SeverityNumber int            `json:"severityNumber"`
+		SeverityText   string         `json:"severityText"`
+		Body           otlpValue      `json:"body"`
+		Attributes     []otlpKeyValue `json:"attributes"`
+	}
+
+	otlpKeyValue struct {
+		Key   string    `json:"key"`
+		Value otlpValue `json:"value"`
+	}
+
+	otlpValue struct {
+		StringValue string `json:"stringValue"`
+	}
+)
+
+// NewOTLPExporter returns an exporter targeting the given OTLP/HTTP endpoint.
+func NewOTLPExporter(endpoint string) *OTLPExporter {
+	url := strings.TrimSuffix(endpoint, "/")
+	if !strings.HasSuffix(url, otlpLogsPath) {
+		url += otlpLogsPath
+	}
+
+	return &OTLPExporter{
+		url:    url,
+		client: &http.Client{Timeout: otlpTimeout},
+	}
+}
+
+// URL returns the collector logs endpoint.
+func (e *OTLPExporter) URL() string {
+	return e.url
+}
+
+// Export sends the report issues to the collector.
+func (e *OTLPExporter) Export(ctx context.Context, b *Builder) error {
+	raw, err := json.Marshal(b.toOTLP(time.Now()))
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.url, bytes.NewReader(raw))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("otlp export to %s failed: %s", e.url, resp.Status)
+	}
+
+	return nil
+}
+
+// toOTLP converts the report issues to OTLP log records.
+func (b *Builder) toOTLP(t time.Time) otlpLogs {
+	ts := strconv.FormatInt(t.UnixNano(), 10)
+	rr := make([]otlpLogRecord, 0)
+	for _, s := range b.view().Report.Sections {
+		for _, fqn := range SortedKeys(s.Outcome, b.sortBy) {
+			for _, i := range s.Outcome[fqn] {
+				rr = append(rr, otlpRecord(ts, s, fqn, i))
+			}
+		}
+	}
+
+	return otlpLogs{
+		ResourceLogs: []otlpResourceLogs{
+			{
+				Resource: otlpResource{
+					Attributes: []otlpKeyValue{
+						otlpAttr("service.name", otlpServiceName),
+						otlpAttr("k8s.cluster.name", b.ClusterName),
+						otlpAttr("popeye.context", b.ContextName),
+					},
+				},
+				ScopeLogs: []otlpScopeLogs{
+					{
+						Scope:      otlpScope{Name: otlpServiceName},
+						LogRecords: rr,
+					},
+				},
+			},
+		},
+	}
+}
+
+func otlpRecord(ts string, s Section, fqn string, i issues.Issue) otlpLogRecord {
+	code, _ := i.Code()
+	return otlpLogRecord{
+		TimeUnixNano:   ts,
+		SeverityNumber: otlpSeverities[i.Level],
+		SeverityText:   strings.ToUpper(issues.LevelToStr(i.Level)),
+		Body:           otlpValue{StringValue: i.Message},
+		Attributes: []otlpKeyValue{
+			otlpAttr("popeye.linter", s.Title),
+			otlpAttr("popeye.gvr", s.GVR),
+			otlpAttr("popeye.resource", fqn),
+			otlpAttr("popeye.group", i.Group),
+			otlpAttr("popeye.code", code),
+			otlpAttr("popeye.severity", issues.LevelToStr(i.Level)),
+		},
+	}
+}
+
+func otlpAttr(k, v string) otlpKeyValue {
+	return otlpKeyValue{Key: k, Value: otlpValue{StringValue: v}}
+}
@@ -0,0 +1,112 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Popeye
+
+package report_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/derailed/popeye/internal/report"
+	"github.com/stretchr/testify/assert"
+)
+
+type (
+	otlpKV struct {
+		Key   string `json:"key"`
+		Value struct {
+			StringValue string `json:"stringValue"`
+		} `json:"value"`
+	}
+
+	otlpPayload struct {
+		ResourceLogs []struct {
+			Resource struct {
+				Attributes []otlpKV `json:"attributes"`
+			} `json:"resource"`
+			ScopeLogs []struct {
+				LogRecords []struct {
+					SeverityNumber int    `json:"severityNumber"`
+					SeverityText   string `json:"severityText"`
+					Body           struct {
+						StringValue string `json:"stringValue"`
+					} `json:"body"`
+					Attributes []otlpKV `json:"attributes"`
+				} `json:"logRecords"`
+			} `json:"scopeLogs"`
+		} `json:"resourceLogs"`
+	}
+)
+
+func TestOTLPExport(t *testing.T) {
+	var (
+		path, ct string
+		p        otlpPayload
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path, ct = r.URL.Path, r.Header.Get("Content-Type")
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&p))
+	}))
+	defer srv.Close()
+
+	b := goldenBuilder()
+	b.SetClusterContext("c1", "ct1")
+	assert.NoError(t, report.NewOTLPExporter(srv.URL).Export(context.Background(), b))
+
+	assert.Equal(t, "/v1/logs", path)
+	assert.Equal(t, "application/json", ct)
+	assert.Equal(t, 1, len(p.ResourceLogs))
+	rl := p.ResourceLogs[0]
+	assert.Equal(t, map[string]string{
+		"service.name":     "popeye",
+		"k8s.cluster.name": "c1",
+		"popeye.context":   "ct1",
+	}, otlpAttrs(rl.Resource.Attributes))
+
+	rr := rl.ScopeLogs[0].LogRecords
+	assert.Equal(t, 12, len(rr))
+	r := rr[0]
+	assert.Equal(t, 13, r.SeverityNumber)
+	assert.Equal(t, "WARN", r.SeverityText)
+	assert.Equal(t, "[POP-201] root warn", r.Body.StringValue)
+	assert.Equal(t, map[string]string{
+		"popeye.linter":   "pods",
+		"popeye.gvr":      "v1/pods",
+		"popeye.resource": "ns1/r2",
+		"popeye.group":    "__root__",
+		"popeye.code":     "201",
+		"popeye.severity": "warn",
+	}, otlpAttrs(r.Attributes))
+
+	r = rr[3]
+	assert.Equal(t, 17, r.SeverityNumber)
+	assert.Equal(t, "ERROR", r.SeverityText)
+	assert.Equal(t, "c1", otlpAttrs(r.Attributes)["popeye.group"])
+	assert.Equal(t, "101", otlpAttrs(r.Attributes)["popeye.code"])
+}
+
+func TestOTLPExportFailed(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	e := report.NewOTLPExporter(srv.URL + "/v1/logs")
+	assert.Equal(t, srv.URL+"/v1/logs", e.URL())
+	assert.ErrorContains(t, e.Export(context.Background(), goldenBuilder()), "503 Service Unavailable")
+
+	srv.Close()
+	assert.Error(t, e.Export(context.Background(), goldenBuilder()))
+}
+
+// Helpers...
+
+func otlpAttrs(kvs []otlpKV) map[string]string {
+	mm := make(map[string]string, len(kvs))
+	for _, kv := range kvs {
+		mm[kv.Key] = kv.Value.StringValue
+	}
+
+	return mm
+}
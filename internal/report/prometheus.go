@@ -7,11 +7,11 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/derailed/popeye/internal"
 	"github.com/derailed/popeye/internal/rules"
 	"github.com/derailed/popeye/pkg/config"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/push"
-	"github.com/rs/zerolog/log"
 )
 
 const namespace = "popeye"
@@ -116,16 +116,53 @@ func (b *Builder) promCollect(ns, scanReport string, codes rules.Glossary) {
 	}
 }
 
-func newPusher(gtwy *config.PushGateway, instance string) *push.Pusher {
+func newUtilizationVecs(buckets []float64) (*prometheus.HistogramVec, *prometheus.HistogramVec) {
+	cpu := prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "container_cpu_utilization_ratio",
+		Help:      "Popeye's containers CPU current/requested ratios.",
+		Buckets:   buckets,
+	},
+		[]string{
+			"cluster",
+			"namespace",
+		})
+
+	mem := prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "container_memory_utilization_ratio",
+		Help:      "Popeye's containers memory current/requested ratios.",
+		Buckets:   buckets,
+	},
+		[]string{
+			"cluster",
+			"namespace",
+		})
+
+	return cpu, mem
+}
+
+func (b *Builder) utilizationCollect(cpu, mem *prometheus.HistogramVec) {
+	if b.utilization == nil {
+		return
+	}
+	for _, s := range b.utilization.Samples() {
+		cpu.WithLabelValues(b.ClusterName, s.Namespace).Observe(s.CPU)
+		mem.WithLabelValues(b.ClusterName, s.Namespace).Observe(s.MEM)
+	}
+}
+
+func newPusher(l internal.Logger, gtwy *config.PushGateway, instance string, cc ...prometheus.Collector) *push.Pusher {
 	registry := prometheus.NewRegistry()
 	registry.MustRegister(scoreGauge, errGauge, linterGauge, sevGauge, codeGauge, reportGauge)
+	registry.MustRegister(cc...)
 
 	pusher := push.New(*gtwy.URL, "popeye").
 		Gatherer(registry).
 		Grouping("instance", instance)
 
 	if config.IsStrSet(gtwy.BasicAuth.User) && config.IsStrSet(gtwy.BasicAuth.Password) {
-		log.Debug().Msgf("Using basic auth: %s", *gtwy.BasicAuth.User)
+		l.Log(internal.DebugLog, "using basic auth", "user", *gtwy.BasicAuth.User)
 		pusher = pusher.BasicAuth(*gtwy.BasicAuth.User, *gtwy.BasicAuth.Password)
 	}
 
@@ -0,0 +1,66 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Popeye
+
+package report
+
+import (
+	"testing"
+
+	"github.com/derailed/popeye/internal"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUtilizationCollect(t *testing.T) {
+	u := internal.NewUtilization()
+	u.Record("ns1", 0.2, 0.5)
+	u.Record("ns1", 1.2, 0.9)
+	u.Record("ns2", 3, 0.1)
+
+	b := NewBuilder()
+	b.ClusterName = "c1"
+	b.SetUtilization(u)
+
+	cpu, mem := newUtilizationVecs([]float64{0.5, 1, 2})
+	b.utilizationCollect(cpu, mem)
+
+	r := prometheus.NewRegistry()
+	r.MustRegister(cpu, mem)
+	ff, err := r.Gather()
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(ff))
+
+	uu := map[string]struct {
+		counts  map[string]uint64
+		buckets map[string][]uint64
+	}{
+		"popeye_container_cpu_utilization_ratio": {
+			counts:  map[string]uint64{"ns1": 2, "ns2": 1},
+			buckets: map[string][]uint64{"ns1": {1, 1, 2}, "ns2": {0, 0, 0}},
+		},
+		"popeye_container_memory_utilization_ratio": {
+			counts:  map[string]uint64{"ns1": 2, "ns2": 1},
+			buckets: map[string][]uint64{"ns1": {1, 2, 2}, "ns2": {1, 1, 1}},
+		},
+	}
+	for _, f := range ff {
+		u, ok := uu[f.GetName()]
+		assert.True(t, ok)
+		assert.Equal(t, "HISTOGRAM", f.GetType().String())
+		for _, m := range f.GetMetric() {
+			var ns string
+			for _, l := range m.GetLabel() {
+				if l.GetName() == "namespace" {
+					ns = l.GetValue()
+				}
+			}
+			h := m.GetHistogram()
+			assert.Equal(t, u.counts[ns], h.GetSampleCount())
+			bb := make([]uint64, 0, len(h.GetBucket()))
+			for _, b := range h.GetBucket() {
+				bb = append(bb, b.GetCumulativeCount())
+			}
+			assert.Equal(t, u.buckets[ns], bb)
+		}
+	}
+}
@@ -15,11 +15,12 @@ import (
 
 // Report represents a popeye scan report.
 type Report struct {
-	Timestamp     string   `json:"report_time" yaml:"report_time"`
-	Score         int      `json:"score" yaml:"score"`
-	Grade         string   `json:"grade" yaml:"grade"`
-	Sections      Sections `json:"sections,omitempty" yaml:"sections,omitempty"`
-	Errors        Errors   `json:"errors,omitempty" yaml:"errors,omitempty"`
+	Timestamp     string      `json:"report_time" yaml:"report_time"`
+	Score         int         `json:"score" yaml:"score"`
+	Grade         string      `json:"grade" yaml:"grade"`
+	Sections      Sections    `json:"sections,omitempty" yaml:"sections,omitempty"`
+	Errors        Errors      `json:"errors,omitempty" yaml:"errors,omitempty"`
+	Histogram     []CodeCount `json:"histogram,omitempty" yaml:"histogram,omitempty"`
 	sectionsCount int
 	totalScore    int
 }
@@ -37,9 +38,22 @@ type Section struct {
 	GVR      string         `json:"gvr" yaml:"gvr"`
 	Tally    *Tally         `json:"tally" yaml:"tally"`
 	Outcome  issues.Outcome `json:"issues,omitempty" yaml:"issues,omitempty"`
+	Elapsed  Elapsed        `json:"elapsed,omitempty" yaml:"elapsed,omitempty"`
+	Scanned  int            `json:"scanned" yaml:"scanned"`
+	Flagged  int            `json:"flagged" yaml:"flagged"`
 	singular string
 }
 
+// mergeTally folds another section tally and counts into this one.
+func (s *Section) mergeTally(o Section) {
+	if s.Tally == nil {
+		s.Tally = NewTally()
+	}
+	s.Tally.merge(o.Tally)
+	s.Scanned += o.Scanned
+	s.Flagged += o.Flagged
+}
+
 // Len returns the list size.
 func (s Sections) Len() int {
 	return len(s)
@@ -54,6 +68,16 @@ func (s Sections) CodeTallies() tally.Linter {
 	return ss
 }
 
+func (s Sections) indexOf(gvr string) int {
+	for i := range s {
+		if s[i].GVR == gvr {
+			return i
+		}
+	}
+
+	return -1
+}
+
 // Swap swaps list values.
 func (s Sections) Swap(i, j int) {
 	s[i], s[j] = s[j], s[i]
@@ -0,0 +1,55 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Popeye
+
+package report
+
+import (
+	"slices"
+
+	"github.com/derailed/popeye/internal/issues"
+	"github.com/derailed/popeye/internal/rules"
+)
+
+const (
+	// SortByName orders resources alphabetically by FQN.
+	SortByName = "name"
+
+	// SortBySeverity orders resources by highest severity first, then by FQN.
+	SortBySeverity = "severity"
+)
+
+// SortedKeys returns the outcome resources in the requested order.
+// Unknown modes fallback to ordering by name.
+func SortedKeys(o issues.Outcome, by string) []string {
+	kk := make([]string, 0, len(o))
+	for k := range o {
+		kk = append(kk, k)
+	}
+	if by != SortBySeverity {
+		slices.SortFunc(kk, issues.SortKeys)
+		return kk
+	}
+	slices.SortFunc(kk, func(k1, k2 string) int {
+		if l1, l2 := o.MaxSeverity(k1), o.MaxSeverity(k2); l1 != l2 {
+			return int(l2) - int(l1)
+		}
+		return issues.SortKeys(k1, k2)
+	})
+
+	return kk
+}
+
+// ordered returns a copy of the outcome with issues grouped by container
+// in a stable order.
+func ordered(o issues.Outcome) issues.Outcome {
+	oo := make(issues.Outcome, len(o))
+	for k, ii := range o {
+		if len(ii) == 0 {
+			oo[k] = ii
+			continue
+		}
+		oo[k] = ii.Sort(rules.OkLevel)
+	}
+
+	return oo
+}
@@ -67,6 +67,24 @@ func (t *Tally) Rollup(o issues.Outcome) *Tally {
 	return t
 }
 
+// merge folds another tally counts into this one and recomputes the score.
+func (t *Tally) merge(o *Tally) {
+	if o == nil || !o.valid {
+		return
+	}
+	var total int
+	for i, v := range o.counts {
+		t.counts[i] += v
+	}
+	for _, v := range t.counts {
+		total += v
+	}
+	t.valid, t.score = true, 100
+	if total > 0 {
+		t.computeScore()
+	}
+}
+
 // ComputeScore calculates the completed run score.
 func (t *Tally) computeScore() int {
 	var issues, ok int
@@ -0,0 +1,67 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Popeye
+
+package report
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/derailed/popeye/types"
+)
+
+// Elapsed tracks how long a linter took to run.
+type Elapsed time.Duration
+
+// Duration returns the elapsed time as a duration.
+func (e Elapsed) Duration() time.Duration {
+	return time.Duration(e)
+}
+
+// String returns a human readable elapsed time.
+func (e Elapsed) String() string {
+	return time.Duration(e).Round(time.Microsecond).String()
+}
+
+// MarshalJSON renders the elapsed time as a duration string.
+func (e Elapsed) MarshalJSON() ([]byte, error) {
+	return json.Marshal(e.String())
+}
+
+// MarshalYAML renders the elapsed time as a duration string.
+func (e Elapsed) MarshalYAML() (interface{}, error) {
+	return e.String(), nil
+}
+
+// SetElapsed records how long the linter for the given section took.
+func (b *Builder) SetElapsed(gvr types.GVR, d time.Duration) {
+	if idx := b.Report.Sections.indexOf(gvr.String()); idx >= 0 {
+		b.Report.Sections[idx].Elapsed = Elapsed(d)
+	}
+}
+
+// Timings returns the elapsed time per linter.
+func (b *Builder) Timings() map[string]time.Duration {
+	tt := make(map[string]time.Duration, len(b.Report.Sections))
+	for _, s := range b.Report.Sections {
+		tt[s.Title] = s.Elapsed.Duration()
+	}
+
+	return tt
+}
+
+// PrintTimings prints out how long each linter took.
+func (b *Builder) PrintTimings(s *ScanReport) {
+	if b.Report.sectionsCount == 0 {
+		return
+	}
+
+	s.Open("TIMINGS", nil)
+	{
+		for _, section := range b.Report.Sections {
+			fmt.Fprintf(s, "  · %-30s %12s\n", section.Title, section.Elapsed)
+		}
+	}
+	s.Close()
+}
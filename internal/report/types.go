@@ -18,6 +18,9 @@ const (
 	// JSONFormat dumps report as JSON.
 	JSONFormat = "json"
 
+	// JSONLFormat streams issues as JSON Lines.
+	JSONLFormat = "jsonl"
+
 	// HTMLFormat dumps report as HTML
 	HTMLFormat = "html"
 
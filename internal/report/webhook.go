@@ -0,0 +1,92 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Popeye
+
+package report
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const defaultWebhookBackoff = 500 * time.Millisecond
+
+// Webhook posts the JSON scan report to a user provided endpoint.
+type Webhook struct {
+	url     string
+	token   string
+	retries int
+	backoff time.Duration
+	client  *http.Client
+}
+
+// NewWebhook returns a webhook sink. Failed posts are retried up to the
+// given count with an exponential backoff.
+func NewWebhook(url, token string, timeout time.Duration, retries int) *Webhook {
+	return &Webhook{
+		url:     url,
+		token:   token,
+		retries: retries,
+		backoff: defaultWebhookBackoff,
+		client:  &http.Client{Timeout: timeout},
+	}
+}
+
+// SetBackoff sets the initial delay between retries.
+func (w *Webhook) SetBackoff(d time.Duration) {
+	w.backoff = d
+}
+
+// URL returns the webhook endpoint.
+func (w *Webhook) URL() string {
+	return w.url
+}
+
+// Post sends the report to the webhook.
+func (w *Webhook) Post(ctx context.Context, b *Builder) error {
+	raw, err := b.ToJSON()
+	if err != nil {
+		return err
+	}
+
+	delay := w.backoff
+	for attempt := 0; ; attempt++ {
+		retry, err := w.post(ctx, raw)
+		if err == nil || !retry || attempt >= w.retries {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+		delay *= 2
+	}
+}
+
+// post issues a single request and reports whether a failure is retryable.
+func (w *Webhook) post(ctx context.Context, raw string) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, strings.NewReader(raw))
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if w.token != "" {
+		req.Header.Set("Authorization", "Bearer "+w.token)
+	}
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return true, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode/100 == 2 {
+		return false, nil
+	}
+	retry := resp.StatusCode >= http.StatusInternalServerError || resp.StatusCode == http.StatusTooManyRequests
+
+	return retry, fmt.Errorf("webhook post to %s failed: %s", w.url, resp.Status)
+}
@@ -0,0 +1,90 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Popeye
+
+package report_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/derailed/popeye/internal/report"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebhookPost(t *testing.T) {
+	uu := map[string]struct {
+		token    string
+		retries  int
+		statuses []int
+		calls    int
+		auth     string
+		err      bool
+	}{
+		"ok": {
+			statuses: []int{http.StatusOK},
+			calls:    1,
+		},
+		"retry": {
+			token:    "t1",
+			retries:  3,
+			statuses: []int{http.StatusServiceUnavailable, http.StatusOK},
+			calls:    2,
+			auth:     "Bearer t1",
+		},
+		"exhausted": {
+			retries:  2,
+			statuses: []int{http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusServiceUnavailable},
+			calls:    3,
+			err:      true,
+		},
+		"no-retry": {
+			retries:  3,
+			statuses: []int{http.StatusBadRequest},
+			calls:    1,
+			err:      true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var (
+				calls         int
+				ct, auth      string
+				body          []byte
+				expected, err = goldenBuilder().ToJSON()
+			)
+			assert.NoError(t, err)
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				ct, auth = r.Header.Get("Content-Type"), r.Header.Get("Authorization")
+				body, _ = io.ReadAll(r.Body)
+				w.WriteHeader(u.statuses[calls])
+				calls++
+			}))
+			defer srv.Close()
+
+			h := report.NewWebhook(srv.URL, u.token, time.Second, u.retries)
+			h.SetBackoff(time.Millisecond)
+			err = h.Post(context.Background(), goldenBuilder())
+			if u.err {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, u.calls, calls)
+			assert.Equal(t, "application/json", ct)
+			assert.Equal(t, u.auth, auth)
+			assert.JSONEq(t, expected, string(body))
+
+			var r map[string]any
+			assert.NoError(t, json.Unmarshal(body, &r))
+			assert.Contains(t, r, "popeye")
+		})
+	}
+}
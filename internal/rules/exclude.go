@@ -79,8 +79,8 @@ func (e Exclude) matchGlob(spec Spec) bool {
 	log.Debug().Msgf("  Rule: %s", e)
 
 	var matches int
-	if len(e.FQNs) > 0 && e.FQNs.match(spec.FQN) {
-		log.Debug().Msgf("  match fqn: %q -- %s", spec.FQN, e.FQNs)
+	if len(e.FQNs) > 0 && e.FQNs.match(spec.globalFQN()) {
+		log.Debug().Msgf("  match fqn: %q -- %s", spec.globalFQN(), e.FQNs)
 		matches++
 	}
 	if len(e.Labels) > 0 && e.Labels.match(spec.Labels) {
@@ -190,6 +190,71 @@ func Test_excludesMatchGlobal(t *testing.T) {
 				Code: 100,
 			},
 		},
+		"cluster-scoped-ns-filter": {
+			exc: Excludes{
+				{
+					FQNs: expressions{"rx:^kube-", "kube-node1"},
+				},
+			},
+			spec: Spec{
+				GVR:  types.NewGVR("v1/nodes"),
+				FQN:  "kube-node1",
+				Code: 700,
+			},
+		},
+		"cluster-scoped-explicit": {
+			exc: Excludes{
+				{
+					FQNs: expressions{"-/n1"},
+				},
+			},
+			spec: Spec{
+				GVR:  types.NewGVR("v1/nodes"),
+				FQN:  "n1",
+				Code: 700,
+			},
+			e: true,
+		},
+		"cluster-scoped-explicit-rx": {
+			exc: Excludes{
+				{
+					FQNs: expressions{"rx:^-/system:"},
+				},
+			},
+			spec: Spec{
+				GVR:  types.NewGVR("rbac.authorization.k8s.io/v1/clusterroles"),
+				FQN:  "system:kube-dns",
+				Code: 400,
+			},
+			e: true,
+		},
+		"cluster-scoped-labels": {
+			exc: Excludes{
+				{
+					Labels: keyVals{"a": expressions{"b"}},
+				},
+			},
+			spec: Spec{
+				GVR:    types.NewGVR("v1/persistentvolumes"),
+				FQN:    "pv1",
+				Labels: Labels{"a": "b"},
+				Code:   1000,
+			},
+			e: true,
+		},
+		"namespace-filter": {
+			exc: Excludes{
+				{
+					FQNs: expressions{"rx:^kube-"},
+				},
+			},
+			spec: Spec{
+				GVR:  types.NewGVR("v1/namespaces"),
+				FQN:  "kube-system",
+				Code: 400,
+			},
+			e: true,
+		},
 	}
 
 	for k := range uu {
@@ -796,3 +861,41 @@ func Test_excludeMatch(t *testing.T) {
 		})
 	}
 }
+
+func TestExclusionsMatchOwnerKind(t *testing.T) {
+	uu := map[string]struct {
+		kinds  []string
+		owners []string
+		e      bool
+	}{
+		"empty": {
+			owners: []string{"Job"},
+		},
+		"no-owners": {
+			kinds: []string{"Job"},
+		},
+		"match": {
+			kinds:  []string{"Job", "CronJob"},
+			owners: []string{"Job"},
+			e:      true,
+		},
+		"case": {
+			kinds:  []string{"job"},
+			owners: []string{"Job"},
+			e:      true,
+		},
+		"no-match": {
+			kinds:  []string{"Job"},
+			owners: []string{"ReplicaSet"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			e := NewExclusions()
+			e.OwnerKinds = u.kinds
+			assert.Equal(t, u.e, e.MatchOwnerKind(u.owners...))
+		})
+	}
+}
@@ -5,6 +5,7 @@ package rules
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/rs/zerolog/log"
 )
@@ -15,6 +16,9 @@ type Exclusions struct {
 
 	// Linters tracks exclusions
 	Linters Linters `yaml:"linters"`
+
+	// OwnerKinds tracks pod owner kinds to skip.
+	OwnerKinds []string `yaml:"ownerKinds"`
 }
 
 func NewExclusions() Exclusions {
@@ -33,6 +37,19 @@ func (e Exclusions) Match(spec Spec) bool {
 	return e.Linters.Match(spec, false)
 }
 
+// MatchOwnerKind checks if any of the given owner kinds is excluded.
+func (e Exclusions) MatchOwnerKind(kinds ...string) bool {
+	for _, k := range kinds {
+		for _, ok := range e.OwnerKinds {
+			if strings.EqualFold(k, ok) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
 func (e Exclusions) Dump() {
 	fmt.Println("Globals")
 	e.Global.Dump("  ")
@@ -8,7 +8,12 @@ import (
 	"strings"
 )
 
-const rxMarker = "rx:"
+const (
+	rxMarker = "rx:"
+
+	// clusterScope qualifies cluster scoped resource FQNs in global exclusions.
+	clusterScope = "-"
+)
 
 func rxMatch(exp, name string) (bool, error) {
 	if !isRegex(exp) {
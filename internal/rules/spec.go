@@ -29,6 +29,26 @@ func (s Spec) isEmpty() bool {
 		s.Code == ZeroCode
 }
 
+// isClusterScoped checks if the spec targets a cluster scoped resource.
+// Namespaces are considered namespaced as namespace filters apply to them.
+func (s Spec) isClusterScoped() bool {
+	if s.FQN == "" || strings.Contains(s.FQN, "/") {
+		return false
+	}
+
+	return s.GVR.R() != "namespaces"
+}
+
+// globalFQN returns the spec FQN as seen by global exclusions. Cluster scoped
+// resources are qualified so namespace filters do not accidentally match them.
+func (s Spec) globalFQN() string {
+	if s.isClusterScoped() {
+		return clusterScope + "/" + s.FQN
+	}
+
+	return s.FQN
+}
+
 func (s Spec) String() string {
 	ss := fmt.Sprintf("[%s] %s", s.GVR, s.FQN)
 	if len(s.Containers) != 0 {
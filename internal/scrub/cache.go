@@ -77,5 +77,9 @@ func Scrubers() map[internal.R]ScrubFn {
 		internal.GWC:  NewGatewayClass,
 		internal.GW:   NewGateway,
 		internal.GWR:  NewHTTPRoute,
+		internal.SC:   NewStorageClass,
+		internal.INGC: NewIngressClass,
+		internal.EP:   NewEndpoints,
+		internal.EPS:  NewEndpointSlice,
 	}
 }
@@ -36,13 +36,14 @@ func (s *CronJob) Preloads() Preloads {
 		internal.PO:   db.LoadResource[*v1.Pod],
 		internal.SA:   db.LoadResource[*v1.ServiceAccount],
 		internal.PMX:  db.LoadResource[*mv1beta1.PodMetrics],
+		internal.NO:   db.LoadResource[*v1.Node],
 	}
 }
 
 // Lint all available CronJobs.
 func (s *CronJob) Lint(ctx context.Context) error {
 	for k, f := range s.Preloads() {
-		if err := f(ctx, s.Loader, internal.Glossary[k]); err != nil {
+		if err := f(ctx, s.Loader, s.DB.GVR(k)); err != nil {
 			return err
 		}
 	}
@@ -6,12 +6,16 @@ package scrub
 import (
 	"context"
 
+	"github.com/derailed/popeye/internal"
 	"github.com/derailed/popeye/internal/cache"
+	"github.com/derailed/popeye/internal/client"
+	"github.com/derailed/popeye/internal/db"
 	"github.com/derailed/popeye/internal/issues"
 	"github.com/derailed/popeye/internal/lint"
 	"github.com/derailed/popeye/pkg/config"
 	"github.com/derailed/popeye/types"
-	"github.com/rs/zerolog/log"
+	v1 "k8s.io/api/core/v1"
+	mv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
 )
 
 // Cluster represents a Cluster scruber.
@@ -21,12 +25,14 @@ type Cluster struct {
 	*config.Config
 
 	client types.Connection
+	cache  *Cache
 }
 
 // NewCluster returns a new instance.
 func NewCluster(ctx context.Context, c *Cache, codes *issues.Codes) Linter {
 	cl := Cluster{
 		client:    c.factory.Client(),
+		cache:     c,
 		Config:    c.Config,
 		Collector: issues.NewCollector(codes, c.Config),
 	}
@@ -34,21 +40,49 @@ func NewCluster(ctx context.Context, c *Cache, codes *issues.Codes) Linter {
 	var err error
 	cl.Cluster, err = c.cluster(ctx)
 	if err != nil {
-		log.Error().Err(err).Msgf("Unable to gather cluster info")
+		internal.ExtractLogger(ctx).Log(internal.ErrorLog, "Unable to gather cluster info", "error", err)
 	}
 
 	return &cl
 }
 
 func (d *Cluster) Preloads() Preloads {
-	return nil
+	return Preloads{
+		internal.PO:  db.LoadResource[*v1.Pod],
+		internal.PMX: db.LoadResource[*mv1beta1.PodMetrics],
+	}
 }
 
 // Lint all available Clusters.
 func (d *Cluster) Lint(ctx context.Context) error {
+	for k, f := range d.Preloads() {
+		if err := f(ctx, d.cache.Loader, d.cache.DB.GVR(k)); err != nil {
+			return err
+		}
+	}
+
 	return lint.NewCluster(d.Collector, d).Lint(ctx)
 }
 
+// PodsMetricsCoverage returns the number of running pods and how many of those report metrics.
+func (d *Cluster) PodsMetricsCoverage() (int, int) {
+	var pods, covered int
+	txn, it := d.cache.DB.MustITFor(d.cache.DB.GVR(internal.PO))
+	defer txn.Abort()
+	for o := it.Next(); o != nil; o = it.Next() {
+		po, ok := o.(*v1.Pod)
+		if !ok || po.Status.Phase != v1.PodRunning {
+			continue
+		}
+		pods++
+		if mx, err := d.cache.DB.FindPMX(client.FQN(po.Namespace, po.Name)); err == nil && mx != nil {
+			covered++
+		}
+	}
+
+	return pods, covered
+}
+
 func (d *Cluster) HasMetrics() bool {
 	return d.client.HasMetrics()
 }
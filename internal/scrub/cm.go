@@ -37,7 +37,7 @@ func (s *ConfigMap) Preloads() Preloads {
 // Lint all available ConfigMaps.
 func (s *ConfigMap) Lint(ctx context.Context) error {
 	for k, f := range s.Preloads() {
-		if err := f(ctx, s.Loader, internal.Glossary[k]); err != nil {
+		if err := f(ctx, s.Loader, s.DB.GVR(k)); err != nil {
 			return err
 		}
 	}
@@ -11,6 +11,7 @@ import (
 	"github.com/derailed/popeye/internal/issues"
 	"github.com/derailed/popeye/internal/lint"
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
 	v1 "k8s.io/api/core/v1"
 	mv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
 )
@@ -34,14 +35,18 @@ func (s *Deployment) Preloads() Preloads {
 		internal.DP:  db.LoadResource[*appsv1.Deployment],
 		internal.PO:  db.LoadResource[*v1.Pod],
 		internal.SA:  db.LoadResource[*v1.ServiceAccount],
+		internal.CM:  db.LoadResource[*v1.ConfigMap],
+		internal.PVC: db.LoadResource[*v1.PersistentVolumeClaim],
 		internal.PMX: db.LoadResource[*mv1beta1.PodMetrics],
+		internal.NO:  db.LoadResource[*v1.Node],
+		internal.HPA: db.LoadResource[*autoscalingv1.HorizontalPodAutoscaler],
 	}
 }
 
 // Lint all available Deployments.
 func (s *Deployment) Lint(ctx context.Context) error {
 	for k, f := range s.Preloads() {
-		if err := f(ctx, s.Loader, internal.Glossary[k]); err != nil {
+		if err := f(ctx, s.Loader, s.DB.GVR(k)); err != nil {
 			return err
 		}
 	}
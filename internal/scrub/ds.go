@@ -35,14 +35,16 @@ func (s *DaemonSet) Preloads() Preloads {
 		internal.DS:  db.LoadResource[*appsv1.DaemonSet],
 		internal.PO:  db.LoadResource[*v1.Pod],
 		internal.SA:  db.LoadResource[*v1.ServiceAccount],
+		internal.CM:  db.LoadResource[*v1.ConfigMap],
 		internal.PMX: db.LoadResource[*mv1beta1.PodMetrics],
+		internal.NO:  db.LoadResource[*v1.Node],
 	}
 }
 
 // Lint all available DaemonSets.
 func (s *DaemonSet) Lint(ctx context.Context) error {
 	for k, f := range s.Preloads() {
-		if err := f(ctx, s.Loader, internal.Glossary[k]); err != nil {
+		if err := f(ctx, s.Loader, s.DB.GVR(k)); err != nil {
 			return err
 		}
 	}
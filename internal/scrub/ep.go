@@ -0,0 +1,46 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Popeye
+
+package scrub
+
+import (
+	"context"
+
+	"github.com/derailed/popeye/internal"
+	"github.com/derailed/popeye/internal/db"
+	"github.com/derailed/popeye/internal/issues"
+	"github.com/derailed/popeye/internal/lint"
+	v1 "k8s.io/api/core/v1"
+)
+
+// Endpoints represents an Endpoints scruber.
+type Endpoints struct {
+	*issues.Collector
+	*Cache
+}
+
+// NewEndpoints return a new instance.
+func NewEndpoints(ctx context.Context, c *Cache, codes *issues.Codes) Linter {
+	return &Endpoints{
+		Collector: issues.NewCollector(codes, c.Config),
+		Cache:     c,
+	}
+}
+
+func (s *Endpoints) Preloads() Preloads {
+	return Preloads{
+		internal.EP:  db.LoadResource[*v1.Endpoints],
+		internal.SVC: db.LoadResource[*v1.Service],
+	}
+}
+
+// Lint all available Endpoints.
+func (s *Endpoints) Lint(ctx context.Context) error {
+	for k, f := range s.Preloads() {
+		if err := f(ctx, s.Loader, s.DB.GVR(k)); err != nil {
+			return err
+		}
+	}
+
+	return lint.NewEndpoints(s.Collector, s.DB).Lint(ctx)
+}
@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Popeye
+
+package scrub
+
+import (
+	"context"
+
+	"github.com/derailed/popeye/internal"
+	"github.com/derailed/popeye/internal/db"
+	"github.com/derailed/popeye/internal/issues"
+	"github.com/derailed/popeye/internal/lint"
+	v1 "k8s.io/api/core/v1"
+	dv1 "k8s.io/api/discovery/v1"
+)
+
+// EndpointSlice represents an EndpointSlice scruber.
+type EndpointSlice struct {
+	*issues.Collector
+	*Cache
+}
+
+// NewEndpointSlice return a new instance.
+func NewEndpointSlice(ctx context.Context, c *Cache, codes *issues.Codes) Linter {
+	return &EndpointSlice{
+		Collector: issues.NewCollector(codes, c.Config),
+		Cache:     c,
+	}
+}
+
+func (s *EndpointSlice) Preloads() Preloads {
+	return Preloads{
+		internal.EPS: db.LoadResource[*dv1.EndpointSlice],
+		internal.SVC: db.LoadResource[*v1.Service],
+	}
+}
+
+// Lint all available EndpointSlices.
+func (s *EndpointSlice) Lint(ctx context.Context) error {
+	for k, f := range s.Preloads() {
+		if err := f(ctx, s.Loader, s.DB.GVR(k)); err != nil {
+			return err
+		}
+	}
+
+	return lint.NewEndpointSlice(s.Collector, s.DB).Lint(ctx)
+}
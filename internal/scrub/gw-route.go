@@ -38,7 +38,7 @@ func (s *HTTPRoute) Preloads() Preloads {
 // Lint all available HTTPRoute.
 func (s *HTTPRoute) Lint(ctx context.Context) error {
 	for k, f := range s.Preloads() {
-		if err := f(ctx, s.Loader, internal.Glossary[k]); err != nil {
+		if err := f(ctx, s.Loader, s.DB.GVR(k)); err != nil {
 			return err
 		}
 	}
@@ -47,7 +47,7 @@ func (s *HorizontalPodAutoscaler) Preloads() Preloads {
 // Lint all available HorizontalPodAutoscalers.
 func (s *HorizontalPodAutoscaler) Lint(ctx context.Context) error {
 	for k, f := range s.Preloads() {
-		if err := f(ctx, s.Loader, internal.Glossary[k]); err != nil {
+		if err := f(ctx, s.Loader, s.DB.GVR(k)); err != nil {
 			return err
 		}
 	}
@@ -30,15 +30,16 @@ func NewIngress(ctx context.Context, c *Cache, codes *issues.Codes) Linter {
 
 func (s *Ingress) Preloads() Preloads {
 	return Preloads{
-		internal.ING: db.LoadResource[*netv1.Ingress],
-		internal.SVC: db.LoadResource[*v1.Service],
+		internal.ING:  db.LoadResource[*netv1.Ingress],
+		internal.INGC: db.LoadResource[*netv1.IngressClass],
+		internal.SVC:  db.LoadResource[*v1.Service],
 	}
 }
 
 // Lint all available Ingress.
 func (s *Ingress) Lint(ctx context.Context) error {
 	for k, f := range s.Preloads() {
-		if err := f(ctx, s.Loader, internal.Glossary[k]); err != nil {
+		if err := f(ctx, s.Loader, s.DB.GVR(k)); err != nil {
 			return err
 		}
 	}
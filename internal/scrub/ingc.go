@@ -0,0 +1,45 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Popeye
+
+package scrub
+
+import (
+	"context"
+
+	"github.com/derailed/popeye/internal"
+	"github.com/derailed/popeye/internal/db"
+	"github.com/derailed/popeye/internal/issues"
+	"github.com/derailed/popeye/internal/lint"
+	netv1 "k8s.io/api/networking/v1"
+)
+
+// IngressClass represents an IngressClass scruber.
+type IngressClass struct {
+	*issues.Collector
+	*Cache
+}
+
+// NewIngressClass return a new instance.
+func NewIngressClass(ctx context.Context, c *Cache, codes *issues.Codes) Linter {
+	return &IngressClass{
+		Collector: issues.NewCollector(codes, c.Config),
+		Cache:     c,
+	}
+}
+
+func (s *IngressClass) Preloads() Preloads {
+	return Preloads{
+		internal.INGC: db.LoadResource[*netv1.IngressClass],
+	}
+}
+
+// Lint all available IngressClasses.
+func (s *IngressClass) Lint(ctx context.Context) error {
+	for k, f := range s.Preloads() {
+		if err := f(ctx, s.Loader, s.DB.GVR(k)); err != nil {
+			return err
+		}
+	}
+
+	return lint.NewIngressClass(s.Collector, s.DB).Lint(ctx)
+}
@@ -39,7 +39,7 @@ func (s *Node) Preloads() Preloads {
 // Lint all available Nodes.
 func (s *Node) Lint(ctx context.Context) error {
 	for k, f := range s.Preloads() {
-		if err := f(ctx, s.Loader, internal.Glossary[k]); err != nil {
+		if err := f(ctx, s.Loader, s.DB.GVR(k)); err != nil {
 			return err
 		}
 	}
@@ -39,7 +39,7 @@ func (s *NetworkPolicy) Preloads() Preloads {
 // Lint all available NetworkPolicies.
 func (s *NetworkPolicy) Lint(ctx context.Context) error {
 	for k, f := range s.Preloads() {
-		if err := f(ctx, s.Loader, internal.Glossary[k]); err != nil {
+		if err := f(ctx, s.Loader, s.DB.GVR(k)); err != nil {
 			return err
 		}
 	}
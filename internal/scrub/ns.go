@@ -11,6 +11,7 @@ import (
 	"github.com/derailed/popeye/internal/issues"
 	"github.com/derailed/popeye/internal/lint"
 	v1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
 )
 
 // Namespace represents a Namespace scruber.
@@ -32,13 +33,14 @@ func (s *Namespace) Preloads() Preloads {
 		internal.NS: db.LoadResource[*v1.Namespace],
 		internal.PO: db.LoadResource[*v1.Pod],
 		internal.SA: db.LoadResource[*v1.ServiceAccount],
+		internal.NP: db.LoadResource[*netv1.NetworkPolicy],
 	}
 }
 
 // Lint all available Namespaces.
 func (s *Namespace) Lint(ctx context.Context) error {
 	for k, f := range s.Preloads() {
-		if err := f(ctx, s.Loader, internal.Glossary[k]); err != nil {
+		if err := f(ctx, s.Loader, s.DB.GVR(k)); err != nil {
 			return err
 		}
 	}
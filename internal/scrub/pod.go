@@ -12,7 +12,10 @@ import (
 	"github.com/derailed/popeye/internal/lint"
 	v1 "k8s.io/api/core/v1"
 	netv1 "k8s.io/api/networking/v1"
+	nodev1 "k8s.io/api/node/v1"
 	polv1 "k8s.io/api/policy/v1"
+	rav1alpha2 "k8s.io/api/resource/v1alpha2"
+	schedv1 "k8s.io/api/scheduling/v1"
 	mv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
 )
 
@@ -33,17 +36,26 @@ func NewPod(ctx context.Context, c *Cache, codes *issues.Codes) Linter {
 func (s *Pod) Preloads() Preloads {
 	return Preloads{
 		internal.PO:  db.LoadResource[*v1.Pod],
+		internal.NS:  db.LoadResource[*v1.Namespace],
+		internal.NO:  db.LoadResource[*v1.Node],
 		internal.SA:  db.LoadResource[*v1.ServiceAccount],
+		internal.SVC: db.LoadResource[*v1.Service],
+		internal.SEC: db.LoadResource[*v1.Secret],
+		internal.CM:  db.LoadResource[*v1.ConfigMap],
 		internal.PDB: db.LoadResource[*polv1.PodDisruptionBudget],
 		internal.NP:  db.LoadResource[*netv1.NetworkPolicy],
 		internal.PMX: db.LoadResource[*mv1beta1.PodMetrics],
+		internal.PC:  db.LoadResource[*schedv1.PriorityClass],
+		internal.RTC: db.LoadResource[*nodev1.RuntimeClass],
+		internal.RCL: db.LoadResource[*rav1alpha2.ResourceClaim],
+		internal.RCT: db.LoadResource[*rav1alpha2.ResourceClaimTemplate],
 	}
 }
 
 // Lint all available Pods.
 func (s *Pod) Lint(ctx context.Context) error {
 	for k, f := range s.Preloads() {
-		if err := f(ctx, s.Loader, internal.Glossary[k]); err != nil {
+		if err := f(ctx, s.Loader, s.DB.GVR(k)); err != nil {
 			return err
 		}
 	}
@@ -37,7 +37,7 @@ func (s *PersistentVolume) Preloads() Preloads {
 // Lint all available PersistentVolumes.
 func (s *PersistentVolume) Lint(ctx context.Context) error {
 	for k, f := range s.Preloads() {
-		if err := f(ctx, s.Loader, internal.Glossary[k]); err != nil {
+		if err := f(ctx, s.Loader, s.DB.GVR(k)); err != nil {
 			return err
 		}
 	}
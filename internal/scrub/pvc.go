@@ -11,6 +11,7 @@ import (
 	"github.com/derailed/popeye/internal/issues"
 	"github.com/derailed/popeye/internal/lint"
 	v1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
 )
 
 // PersistentVolumeClaim represents a PersistentVolumeClaim scruber.
@@ -31,13 +32,14 @@ func (s *PersistentVolumeClaim) Preloads() Preloads {
 	return Preloads{
 		internal.PVC: db.LoadResource[*v1.PersistentVolumeClaim],
 		internal.PO:  db.LoadResource[*v1.Pod],
+		internal.SC:  db.LoadResource[*storagev1.StorageClass],
 	}
 }
 
 // Lint all available PersistentVolumeClaims.
 func (s *PersistentVolumeClaim) Lint(ctx context.Context) error {
 	for k, f := range s.Preloads() {
-		if err := f(ctx, s.Loader, internal.Glossary[k]); err != nil {
+		if err := f(ctx, s.Loader, s.DB.GVR(k)); err != nil {
 			return err
 		}
 	}
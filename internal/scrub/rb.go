@@ -41,7 +41,7 @@ func (s *RoleBinding) Preloads() Preloads {
 // Lint all available RoleBindings.
 func (s *RoleBinding) Lint(ctx context.Context) error {
 	for k, f := range s.Preloads() {
-		if err := f(ctx, s.Loader, internal.Glossary[k]); err != nil {
+		if err := f(ctx, s.Loader, s.DB.GVR(k)); err != nil {
 			return err
 		}
 	}
@@ -38,7 +38,7 @@ func (s *Role) Preloads() Preloads {
 // Lint all available Roles.
 func (s *Role) Lint(ctx context.Context) error {
 	for k, f := range s.Preloads() {
-		if err := f(ctx, s.Loader, internal.Glossary[k]); err != nil {
+		if err := f(ctx, s.Loader, s.DB.GVR(k)); err != nil {
 			return err
 		}
 	}
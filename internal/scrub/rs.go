@@ -38,7 +38,7 @@ func (s *ReplicaSet) Preloads() Preloads {
 // Lint all available ReplicaSets.
 func (s *ReplicaSet) Lint(ctx context.Context) error {
 	for k, f := range s.Preloads() {
-		if err := f(ctx, s.Loader, internal.Glossary[k]); err != nil {
+		if err := f(ctx, s.Loader, s.DB.GVR(k)); err != nil {
 			return err
 		}
 	}
@@ -43,7 +43,7 @@ func (s *ServiceAccount) Preloads() Preloads {
 // Lint all available ServiceAccounts.
 func (s *ServiceAccount) Lint(ctx context.Context) error {
 	for k, f := range s.Preloads() {
-		if err := f(ctx, s.Loader, internal.Glossary[k]); err != nil {
+		if err := f(ctx, s.Loader, s.DB.GVR(k)); err != nil {
 			return err
 		}
 	}
@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Popeye
+
+package scrub
+
+import (
+	"context"
+
+	"github.com/derailed/popeye/internal"
+	"github.com/derailed/popeye/internal/db"
+	"github.com/derailed/popeye/internal/issues"
+	"github.com/derailed/popeye/internal/lint"
+	appsv1 "k8s.io/api/apps/v1"
+	storagev1 "k8s.io/api/storage/v1"
+)
+
+// StorageClass represents a StorageClass scruber.
+type StorageClass struct {
+	*issues.Collector
+	*Cache
+}
+
+// NewStorageClass return a new instance.
+func NewStorageClass(ctx context.Context, c *Cache, codes *issues.Codes) Linter {
+	return &StorageClass{
+		Collector: issues.NewCollector(codes, c.Config),
+		Cache:     c,
+	}
+}
+
+func (s *StorageClass) Preloads() Preloads {
+	return Preloads{
+		internal.SC:  db.LoadResource[*storagev1.StorageClass],
+		internal.STS: db.LoadResource[*appsv1.StatefulSet],
+	}
+}
+
+// Lint all available StorageClasses.
+func (s *StorageClass) Lint(ctx context.Context) error {
+	for k, f := range s.Preloads() {
+		if err := f(ctx, s.Loader, s.DB.GVR(k)); err != nil {
+			return err
+		}
+	}
+
+	return lint.NewStorageClass(s.Collector, s.DB).Lint(ctx)
+}
@@ -40,7 +40,7 @@ func (s *Secret) Preloads() Preloads {
 // Lint all available Secrets.
 func (s *Secret) Lint(ctx context.Context) error {
 	for k, f := range s.Preloads() {
-		if err := f(ctx, s.Loader, internal.Glossary[k]); err != nil {
+		if err := f(ctx, s.Loader, s.DB.GVR(k)); err != nil {
 			return err
 		}
 	}
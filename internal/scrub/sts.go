@@ -34,14 +34,17 @@ func (s *StatefulSet) Preloads() Preloads {
 		internal.STS: db.LoadResource[*appsv1.StatefulSet],
 		internal.PO:  db.LoadResource[*v1.Pod],
 		internal.SA:  db.LoadResource[*v1.ServiceAccount],
+		internal.CM:  db.LoadResource[*v1.ConfigMap],
+		internal.PVC: db.LoadResource[*v1.PersistentVolumeClaim],
 		internal.PMX: db.LoadResource[*mv1beta1.PodMetrics],
+		internal.NO:  db.LoadResource[*v1.Node],
 	}
 }
 
 // Lint all available StatefulSets.
 func (s *StatefulSet) Lint(ctx context.Context) error {
 	for k, f := range s.Preloads() {
-		if err := f(ctx, s.Loader, internal.Glossary[k]); err != nil {
+		if err := f(ctx, s.Loader, s.DB.GVR(k)); err != nil {
 			return err
 		}
 	}
@@ -10,6 +10,7 @@ import (
 	"github.com/derailed/popeye/internal/db"
 	"github.com/derailed/popeye/internal/issues"
 	"github.com/derailed/popeye/internal/lint"
+	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
 )
 
@@ -32,13 +33,15 @@ func (s *Service) Preloads() Preloads {
 		internal.SVC: db.LoadResource[*v1.Service],
 		internal.PO:  db.LoadResource[*v1.Pod],
 		internal.EP:  db.LoadResource[*v1.Endpoints],
+		internal.DP:  db.LoadResource[*appsv1.Deployment],
+		internal.STS: db.LoadResource[*appsv1.StatefulSet],
 	}
 }
 
 // Lint all available Services.
 func (s *Service) Lint(ctx context.Context) error {
 	for k, f := range s.Preloads() {
-		if err := f(ctx, s.Loader, internal.Glossary[k]); err != nil {
+		if err := f(ctx, s.Loader, s.DB.GVR(k)); err != nil {
 			return err
 		}
 	}
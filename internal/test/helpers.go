@@ -26,56 +26,57 @@ import (
 	"sigs.k8s.io/yaml"
 )
 
+// Glossary represents the linters glossary used by tests.
+var Glossary = internal.Linters{
+	internal.CM:   types.NewGVR("v1/configmaps"),
+	internal.EP:   types.NewGVR("v1/endpoints"),
+	internal.NS:   types.NewGVR("v1/namespaces"),
+	internal.NO:   types.NewGVR("v1/nodes"),
+	internal.PV:   types.NewGVR("v1/persistentvolumes"),
+	internal.PVC:  types.NewGVR("v1/persistentvolumeclaims"),
+	internal.PO:   types.NewGVR("v1/pods"),
+	internal.SEC:  types.NewGVR("v1/secrets"),
+	internal.SA:   types.NewGVR("v1/serviceaccounts"),
+	internal.SVC:  types.NewGVR("v1/services"),
+	internal.DS:   types.NewGVR("apps/v1/daemonsets"),
+	internal.DP:   types.NewGVR("apps/v1/deployments"),
+	internal.RS:   types.NewGVR("apps/v1/replicasets"),
+	internal.STS:  types.NewGVR("apps/v1/statefulsets"),
+	internal.CR:   types.NewGVR("rbac.authorization.k8s.io/v1/clusterroles"),
+	internal.CRB:  types.NewGVR("rbac.authorization.k8s.io/v1/clusterrolebindings"),
+	internal.RO:   types.NewGVR("rbac.authorization.k8s.io/v1/roles"),
+	internal.ROB:  types.NewGVR("rbac.authorization.k8s.io/v1/rolebindings"),
+	internal.ING:  types.NewGVR("networking.k8s.io/v1/ingresses"),
+	internal.NP:   types.NewGVR("networking.k8s.io/v1/networkpolicies"),
+	internal.PDB:  types.NewGVR("policy/v1/poddisruptionbudgets"),
+	internal.HPA:  types.NewGVR("autoscaling/v1/horizontalpodautoscalers"),
+	internal.PMX:  types.NewGVR("metrics.k8s.io/v1beta1/podmetrics"),
+	internal.NMX:  types.NewGVR("metrics.k8s.io/v1beta1/nodemetrics"),
+	internal.CJOB: types.NewGVR("batch/v1/cronjobs"),
+	internal.JOB:  types.NewGVR("batch/v1/jobs"),
+	internal.GW:   types.NewGVR("gateway.networking.k8s.io/v1/gateways"),
+	internal.GWC:  types.NewGVR("gateway.networking.k8s.io/v1/gatewayclasses"),
+	internal.GWR:  types.NewGVR("gateway.networking.k8s.io/v1/httproutes"),
+	internal.PC:   types.NewGVR("scheduling.k8s.io/v1/priorityclasses"),
+	internal.RTC:  types.NewGVR("node.k8s.io/v1/runtimeclasses"),
+	internal.SC:   types.NewGVR("storage.k8s.io/v1/storageclasses"),
+	internal.INGC: types.NewGVR("networking.k8s.io/v1/ingressclasses"),
+	internal.EPS:  types.NewGVR("discovery.k8s.io/v1/endpointslices"),
+	internal.RCL:  types.NewGVR("resource.k8s.io/v1alpha2/resourceclaims"),
+	internal.RCT:  types.NewGVR("resource.k8s.io/v1alpha2/resourceclaimtemplates"),
+	cilium.CID:    types.NewGVR("cilium.io/v2/ciliumidentities"),
+	cilium.CEP:    types.NewGVR("cilium.io/v2/ciliumendpoints"),
+	cilium.CNP:    types.NewGVR("cilium.io/v2/ciliumnetworkpolicies"),
+	cilium.CCNP:   types.NewGVR("cilium.io/v2/ciliumclusterwidenetworkpolicies"),
+}
+
 func NewTestDB() (*db.DB, error) {
-	initLinters()
-	initCiliumLinters()
-	d, err := memdb.NewMemDB(schema.Init())
+	d, err := memdb.NewMemDB(schema.Init(Glossary))
 	if err != nil {
 		return nil, err
 	}
 
-	return db.NewDB(d), nil
-}
-
-func initCiliumLinters() {
-	internal.Glossary[cilium.CID] = types.NewGVR("cilium.io/v2/ciliumidentities")
-	internal.Glossary[cilium.CEP] = types.NewGVR("cilium.io/v2/ciliumendpoints")
-	internal.Glossary[cilium.CNP] = types.NewGVR("cilium.io/v2/ciliumnetworkpolicies")
-	internal.Glossary[cilium.CCNP] = types.NewGVR("cilium.io/v2/ciliumclusterwidenetworkpolicies")
-}
-
-func initLinters() {
-	internal.Glossary = internal.Linters{
-		internal.CM:   types.NewGVR("v1/configmaps"),
-		internal.EP:   types.NewGVR("v1/endpoints"),
-		internal.NS:   types.NewGVR("v1/namespaces"),
-		internal.NO:   types.NewGVR("v1/nodes"),
-		internal.PV:   types.NewGVR("v1/persistentvolumes"),
-		internal.PVC:  types.NewGVR("v1/persistentvolumeclaims"),
-		internal.PO:   types.NewGVR("v1/pods"),
-		internal.SEC:  types.NewGVR("v1/secrets"),
-		internal.SA:   types.NewGVR("v1/serviceaccounts"),
-		internal.SVC:  types.NewGVR("v1/services"),
-		internal.DS:   types.NewGVR("apps/v1/daemonsets"),
-		internal.DP:   types.NewGVR("apps/v1/deployments"),
-		internal.RS:   types.NewGVR("apps/v1/replicasets"),
-		internal.STS:  types.NewGVR("apps/v1/statefulsets"),
-		internal.CR:   types.NewGVR("rbac.authorization.k8s.io/v1/clusterroles"),
-		internal.CRB:  types.NewGVR("rbac.authorization.k8s.io/v1/clusterrolebindings"),
-		internal.RO:   types.NewGVR("rbac.authorization.k8s.io/v1/roles"),
-		internal.ROB:  types.NewGVR("rbac.authorization.k8s.io/v1/rolebindings"),
-		internal.ING:  types.NewGVR("networking.k8s.io/v1/ingresses"),
-		internal.NP:   types.NewGVR("networking.k8s.io/v1/networkpolicies"),
-		internal.PDB:  types.NewGVR("policy/v1/poddisruptionbudgets"),
-		internal.HPA:  types.NewGVR("autoscaling/v1/horizontalpodautoscalers"),
-		internal.PMX:  types.NewGVR("metrics.k8s.io/v1beta1/podmetrics"),
-		internal.NMX:  types.NewGVR("metrics.k8s.io/v1beta1/nodemetrics"),
-		internal.CJOB: types.NewGVR("batch/v1/cronjobs"),
-		internal.JOB:  types.NewGVR("batch/v1/jobs"),
-		internal.GW:   types.NewGVR("gateway.networking.k8s.io/v1/gateways"),
-		internal.GWC:  types.NewGVR("gateway.networking.k8s.io/v1/gatewayclasses"),
-		internal.GWR:  types.NewGVR("gateway.networking.k8s.io/v1/httproutes"),
-	}
+	return db.NewDB(d, Glossary), nil
 }
 
 func MakeRes(c, m string) v1.ResourceList {
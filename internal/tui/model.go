@@ -0,0 +1,169 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Popeye
+
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/derailed/popeye/internal/report"
+	"github.com/derailed/popeye/internal/rules"
+	"github.com/muesli/termenv"
+)
+
+const helpLine = "↑/↓ move • enter/→ open • ←/esc back • f filter • c copy fqn • q quit"
+
+// Model represents a scan report browser.
+type Model struct {
+	builder *report.Builder
+	level   rules.Level
+	root    *Node
+	path    []*Node
+	cursors []int
+	status  string
+	copy    func(string)
+}
+
+// NewModel returns a new browser for the given scan report.
+func NewModel(b *report.Builder) *Model {
+	m := Model{
+		builder: b,
+		copy:    termenv.Copy,
+	}
+	m.reset()
+
+	return &m
+}
+
+// Run browses the scan report until the user quits.
+func Run(b *report.Builder) error {
+	_, err := tea.NewProgram(NewModel(b), tea.WithAltScreen()).Run()
+
+	return err
+}
+
+// Root returns the current results tree.
+func (m *Model) Root() *Node {
+	return m.root
+}
+
+// Current returns the node being browsed.
+func (m *Model) Current() *Node {
+	return m.path[len(m.path)-1]
+}
+
+// Selected returns the node under the cursor if any.
+func (m *Model) Selected() *Node {
+	n := m.Current()
+	if len(n.Children) == 0 {
+		return nil
+	}
+
+	return n.Children[m.cursors[len(m.cursors)-1]]
+}
+
+// Init initializes the model.
+func (m *Model) Init() tea.Cmd {
+	return nil
+}
+
+// Update handles key presses.
+func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	k, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return m, nil
+	}
+	m.status = ""
+	switch k.String() {
+	case "q", "ctrl+c":
+		return m, tea.Quit
+	case "up", "k":
+		m.move(-1)
+	case "down", "j":
+		m.move(1)
+	case "enter", "right", "l":
+		m.open()
+	case "left", "h", "esc", "backspace":
+		m.back()
+	case "f":
+		m.level = (m.level + 1) % (rules.ErrorLevel + 1)
+		m.reset()
+	case "c", "y":
+		m.copyFQN()
+	}
+
+	return m, nil
+}
+
+// View renders the current tree level.
+func (m *Model) View() string {
+	var b strings.Builder
+	names := make([]string, 0, len(m.path))
+	for _, n := range m.path {
+		names = append(names, n.Name)
+	}
+	fmt.Fprintf(&b, "%s  [level>=%s]\n\n", strings.Join(names, " › "), m.level.ToHumanLevel())
+
+	cur := m.Current()
+	if len(cur.Children) == 0 {
+		b.WriteString("  Nothing to report.\n")
+	}
+	for i, c := range cur.Children {
+		marker := "  "
+		if i == m.cursors[len(m.cursors)-1] {
+			marker = "> "
+		}
+		fmt.Fprintf(&b, "%s%-5s %s", marker, c.Level.ToHumanLevel(), c.Name)
+		if c.Kind != IssueNode && len(c.Children) > 0 {
+			fmt.Fprintf(&b, " (%d)", len(c.Children))
+		}
+		b.WriteString("\n")
+	}
+	b.WriteString("\n" + helpLine + "\n")
+	if m.status != "" {
+		b.WriteString(m.status + "\n")
+	}
+
+	return b.String()
+}
+
+func (m *Model) reset() {
+	m.root = NewTree(m.builder, m.level)
+	m.path, m.cursors = []*Node{m.root}, []int{0}
+}
+
+func (m *Model) move(d int) {
+	n := len(m.Current().Children)
+	if n == 0 {
+		return
+	}
+	i := len(m.cursors) - 1
+	m.cursors[i] = (m.cursors[i] + d + n) % n
+}
+
+func (m *Model) open() {
+	s := m.Selected()
+	if s == nil || len(s.Children) == 0 {
+		return
+	}
+	m.path, m.cursors = append(m.path, s), append(m.cursors, 0)
+}
+
+func (m *Model) back() {
+	if len(m.path) == 1 {
+		return
+	}
+	m.path, m.cursors = m.path[:len(m.path)-1], m.cursors[:len(m.cursors)-1]
+}
+
+func (m *Model) copyFQN() {
+	s := m.Selected()
+	if s == nil || s.FQN == "" {
+		m.status = "Select a resource or issue to copy its FQN"
+		return
+	}
+	m.copy(s.FQN)
+	m.status = fmt.Sprintf("Copied %s", s.FQN)
+}
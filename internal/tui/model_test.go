@@ -0,0 +1,171 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Popeye
+
+package tui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/derailed/popeye/internal/issues"
+	"github.com/derailed/popeye/internal/report"
+	"github.com/derailed/popeye/internal/rules"
+	"github.com/derailed/popeye/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewTree(t *testing.T) {
+	uu := map[string]struct {
+		level rules.Level
+		e     string
+	}{
+		"all": {
+			level: rules.OkLevel,
+			e: `popeye
+  nodes
+    -
+      n1
+  pods
+    ns1
+      p1
+        [POP-201] root warn
+      p2
+    ns2
+      p1
+        [POP-200] root info
+        c1: [POP-101] c1 error
+`,
+		},
+		"warn": {
+			level: rules.WarnLevel,
+			e: `popeye
+  pods
+    ns1
+      p1
+        [POP-201] root warn
+    ns2
+      p1
+        c1: [POP-101] c1 error
+`,
+		},
+		"error": {
+			level: rules.ErrorLevel,
+			e: `popeye
+  pods
+    ns2
+      p1
+        c1: [POP-101] c1 error
+`,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, dumpTree(NewTree(makeBuilder(), u.level), ""))
+		})
+	}
+}
+
+func TestNewTreeLevels(t *testing.T) {
+	root := NewTree(makeBuilder(), rules.OkLevel)
+
+	assert.Equal(t, rules.ErrorLevel, root.Level)
+	assert.Equal(t, LinterNode, root.Children[0].Kind)
+	assert.Equal(t, rules.OkLevel, root.Children[0].Level)
+
+	ns := root.Children[1].Children[1]
+	assert.Equal(t, NamespaceNode, ns.Kind)
+	assert.Equal(t, rules.ErrorLevel, ns.Level)
+
+	res := ns.Children[0]
+	assert.Equal(t, ResourceNode, res.Kind)
+	assert.Equal(t, "ns2/p1", res.FQN)
+	assert.Equal(t, IssueNode, res.Children[0].Kind)
+	assert.Equal(t, "ns2/p1", res.Children[0].FQN)
+	assert.Equal(t, rules.InfoLevel, res.Children[0].Level)
+}
+
+func TestModelNavigate(t *testing.T) {
+	m := NewModel(makeBuilder())
+	var copied string
+	m.copy = func(s string) { copied = s }
+
+	assert.Equal(t, "nodes", m.Selected().Name)
+	press(m, "down", "enter")
+	assert.Equal(t, "pods", m.Current().Name)
+	assert.Equal(t, "ns1", m.Selected().Name)
+
+	press(m, "up", "enter")
+	assert.Equal(t, "ns2", m.Current().Name)
+	press(m, "c")
+	assert.Equal(t, "ns2/p1", copied)
+
+	press(m, "enter", "down")
+	assert.Equal(t, "c1: [POP-101] c1 error", m.Selected().Name)
+	press(m, "enter")
+	assert.Equal(t, "p1", m.Current().Name)
+
+	press(m, "esc", "esc", "esc", "esc")
+	assert.Equal(t, RootNode, m.Current().Kind)
+
+	press(m, "f", "f")
+	assert.Equal(t, rules.WarnLevel, m.level)
+	assert.Equal(t, 1, len(m.Root().Children))
+	assert.Equal(t, "pods", m.Selected().Name)
+
+	press(m, "f", "f")
+	assert.Equal(t, rules.OkLevel, m.level)
+	assert.Equal(t, 2, len(m.Root().Children))
+}
+
+// Helpers...
+
+func press(m *Model, kk ...string) {
+	for _, k := range kk {
+		msg := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(k)}
+		switch k {
+		case "up":
+			msg = tea.KeyMsg{Type: tea.KeyUp}
+		case "down":
+			msg = tea.KeyMsg{Type: tea.KeyDown}
+		case "enter":
+			msg = tea.KeyMsg{Type: tea.KeyEnter}
+		case "esc":
+			msg = tea.KeyMsg{Type: tea.KeyEsc}
+		}
+		m.Update(msg)
+	}
+}
+
+func dumpTree(n *Node, indent string) string {
+	s := indent + n.Name + "\n"
+	for _, c := range n.Children {
+		s += dumpTree(c, indent+"  ")
+	}
+
+	return s
+}
+
+func makeBuilder() *report.Builder {
+	b := report.NewBuilder()
+
+	gvr := types.NewGVR("v1/pods")
+	o := issues.Outcome{
+		"ns2/p1": issues.Issues{
+			issues.New(gvr, "c1", rules.ErrorLevel, "[POP-101] c1 error"),
+			issues.New(gvr, issues.Root, rules.InfoLevel, "[POP-200] root info"),
+		},
+		"ns1/p1": issues.Issues{
+			issues.New(gvr, issues.Root, rules.WarnLevel, "[POP-201] root warn"),
+		},
+		"ns1/p2": issues.Issues{},
+	}
+	b.AddSection(gvr, "pod", o, report.NewTally().Rollup(o))
+
+	gvr = types.NewGVR("v1/nodes")
+	o = issues.Outcome{"n1": issues.Issues{}}
+	b.AddSection(gvr, "node", o, report.NewTally().Rollup(o))
+
+	return b
+}
@@ -0,0 +1,116 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Popeye
+
+package tui
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/derailed/popeye/internal/client"
+	"github.com/derailed/popeye/internal/issues"
+	"github.com/derailed/popeye/internal/report"
+	"github.com/derailed/popeye/internal/rules"
+)
+
+// ClusterScope labels resources that do not live in a namespace.
+const ClusterScope = "-"
+
+// Kind tracks a tree node kind.
+type Kind int
+
+const (
+	// RootNode denotes the scan report.
+	RootNode Kind = iota
+	// LinterNode denotes a linter section.
+	LinterNode
+	// NamespaceNode denotes a namespace within a linter section.
+	NamespaceNode
+	// ResourceNode denotes a linted resource.
+	ResourceNode
+	// IssueNode denotes a resource issue.
+	IssueNode
+)
+
+// Node represents a navigable entry in the results tree.
+type Node struct {
+	Kind     Kind
+	Name     string
+	FQN      string
+	Level    rules.Level
+	Children []*Node
+}
+
+// NewTree builds a linter -> namespace -> resource -> issue tree from a scan
+// report. Resources below the given level are left out.
+func NewTree(b *report.Builder, level rules.Level) *Node {
+	root := Node{Kind: RootNode, Name: "popeye"}
+	for _, s := range b.Sections() {
+		l := linterNode(s, b.SortBy(), level)
+		if l == nil {
+			continue
+		}
+		root.add(l)
+	}
+
+	return &root
+}
+
+func linterNode(s report.Section, by string, level rules.Level) *Node {
+	l := Node{Kind: LinterNode, Name: s.Title}
+	nss := make(map[string]*Node)
+	for _, fqn := range report.SortedKeys(s.Outcome, by) {
+		ii := s.Outcome[fqn]
+		if ii.MaxSeverity() < level {
+			continue
+		}
+		ns, _ := client.Namespaced(fqn)
+		if ns == "" {
+			ns = ClusterScope
+		}
+		n, ok := nss[ns]
+		if !ok {
+			n = &Node{Kind: NamespaceNode, Name: ns}
+			nss[ns] = n
+		}
+		n.add(resourceNode(fqn, ii, level))
+	}
+	if len(nss) == 0 {
+		return nil
+	}
+
+	kk := make([]string, 0, len(nss))
+	for k := range nss {
+		kk = append(kk, k)
+	}
+	sort.Strings(kk)
+	for _, k := range kk {
+		l.add(nss[k])
+	}
+
+	return &l
+}
+
+func resourceNode(fqn string, ii issues.Issues, level rules.Level) *Node {
+	_, n := client.Namespaced(fqn)
+	r := Node{Kind: ResourceNode, Name: n, FQN: fqn}
+	for _, i := range ii {
+		if i.Level < level {
+			continue
+		}
+		name := i.Message
+		if i.Group != issues.Root {
+			name = fmt.Sprintf("%s: %s", i.Group, i.Message)
+		}
+		r.add(&Node{Kind: IssueNode, Name: name, FQN: fqn, Level: i.Level})
+	}
+
+	return &r
+}
+
+func (n *Node) add(c *Node) {
+	n.Children = append(n.Children, c)
+	if c.Level > n.Level {
+		n.Level = c.Level
+	}
+}
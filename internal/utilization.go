@@ -0,0 +1,43 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Popeye
+
+package internal
+
+import "sync"
+
+// UtilizationSample tracks a container current vs requested resources ratios.
+type UtilizationSample struct {
+	Namespace string
+	CPU       float64
+	MEM       float64
+}
+
+// Utilization records container utilization samples across linters.
+type Utilization struct {
+	samples []UtilizationSample
+	mx      sync.Mutex
+}
+
+// NewUtilization returns a new instance.
+func NewUtilization() *Utilization {
+	return &Utilization{}
+}
+
+// Record adds a container utilization sample.
+func (u *Utilization) Record(ns string, cpu, mem float64) {
+	u.mx.Lock()
+	defer u.mx.Unlock()
+
+	u.samples = append(u.samples, UtilizationSample{Namespace: ns, CPU: cpu, MEM: mem})
+}
+
+// Samples returns all recorded samples.
+func (u *Utilization) Samples() []UtilizationSample {
+	u.mx.Lock()
+	defer u.mx.Unlock()
+
+	ss := make([]UtilizationSample, len(u.samples))
+	copy(ss, u.samples)
+
+	return ss
+}
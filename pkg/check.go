@@ -0,0 +1,52 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Popeye
+
+package pkg
+
+import (
+	"context"
+
+	"github.com/derailed/popeye/internal"
+	"github.com/derailed/popeye/internal/issues"
+	"github.com/derailed/popeye/internal/lint"
+	"github.com/derailed/popeye/internal/rules"
+)
+
+// Collector records issues raised by custom checks.
+type Collector interface {
+	// AddCode records an issue for the current resource.
+	AddCode(ctx context.Context, code int, args ...any)
+
+	// AddSubCode records an issue for the current resource sub group, ie container.
+	AddSubCode(ctx context.Context, code int, args ...any)
+}
+
+// RegisterCode registers a custom linter code to be raised by custom checks.
+// Severity ranges from 1 (info) to 3 (error), any other value is rejected.
+func RegisterCode(code int, message string, severity int) error {
+	return issues.RegisterCode(rules.ID(code), rules.Code{
+		Message:  message,
+		Severity: rules.Level(severity),
+	})
+}
+
+// RegisterCheck registers a custom check for a given resource ie pods, deployments.
+// The check is handed the typed resource, ie *v1.Pod, and runs once the
+// native checks are done.
+func RegisterCheck[T any](res string, fn func(ctx context.Context, c Collector, o T)) {
+	lint.RegisterCheck[T](internal.R(res), lint.CheckFunc[T](func(ctx context.Context, c lint.Collector, o T) {
+		fn(ctx, collector{c: c}, o)
+	}))
+}
+
+type collector struct {
+	c lint.Collector
+}
+
+func (c collector) AddCode(ctx context.Context, code int, args ...any) {
+	c.c.AddCode(ctx, rules.ID(code), args...)
+}
+
+func (c collector) AddSubCode(ctx context.Context, code int, args ...any) {
+	c.c.AddSubCode(ctx, rules.ID(code), args...)
+}
@@ -6,6 +6,8 @@ package config
 import (
 	"fmt"
 	"os"
+	"strings"
+	"time"
 
 	"github.com/derailed/popeye/internal/client"
 	"github.com/derailed/popeye/internal/rules"
@@ -14,7 +16,22 @@ import (
 	"gopkg.in/yaml.v2"
 )
 
-const defaultLintLevel = "ok"
+const (
+	defaultLintLevel      = "ok"
+	defaultFinalizerGrace = time.Hour
+	defaultCertWindow     = 30 * 24 * time.Hour
+	defaultAPIQPS         = 100
+	defaultAPIBurst       = 100
+)
+
+// defaultSensitivePorts tracks ssh, etcd, mysql, postgres, redis, elasticsearch and mongo ports.
+var defaultSensitivePorts = []int32{22, 2379, 3306, 5432, 6379, 9200, 27017}
+
+// recommendedLabelPrefix tracks the kubernetes recommended labels prefix.
+const recommendedLabelPrefix = "app.kubernetes.io/"
+
+// defaultRecommendedLabels tracks the kubernetes recommended labels.
+var defaultRecommendedLabels = []string{"name", "instance", "version", "component", "part-of", "managed-by"}
 
 // Config tracks Popeye configuration options.
 type Config struct {
@@ -121,6 +138,84 @@ func (c *Config) RestartsLimit() int {
 	return l
 }
 
+// MaxContainersLimit returns the pod containers count threshold.
+func (c *Config) MaxContainersLimit() int {
+	l := c.Resources.Pod.MaxContainers
+	if l == 0 {
+		return defaultMaxContainers
+	}
+	return l
+}
+
+// MaxEnvValueSize returns the env var value size threshold in bytes.
+func (c *Config) MaxEnvValueSize() int {
+	l := c.Resources.Pod.MaxEnvSize
+	if l <= 0 {
+		return defaultMaxEnvSize
+	}
+	return l
+}
+
+// MaxVolumesLimit returns the pod volumes count threshold.
+func (c *Config) MaxVolumesLimit() int {
+	l := c.Resources.Pod.MaxVolumes
+	if l <= 0 {
+		return defaultMaxVolumes
+	}
+	return l
+}
+
+// MaxVolumeMountsLimit returns the container volume mounts count threshold.
+func (c *Config) MaxVolumeMountsLimit() int {
+	l := c.Resources.Pod.MaxVolumeMounts
+	if l <= 0 {
+		return defaultMaxMounts
+	}
+	return l
+}
+
+// MaxInitContainersLimit returns the pod init containers chain threshold.
+func (c *Config) MaxInitContainersLimit() int {
+	l := c.Resources.Pod.MaxInits
+	if l <= 0 {
+		return defaultMaxInits
+	}
+	return l
+}
+
+// PodOrphanAge returns the age past which bare pods are flagged.
+func (c *Config) PodOrphanAge() time.Duration {
+	d, err := time.ParseDuration(c.Resources.Pod.OrphanAge)
+	if err != nil || d <= 0 {
+		return defaultOrphanAge
+	}
+	return d
+}
+
+// PodSchedulingGateAge returns the age past which gated pods are flagged.
+func (c *Config) PodSchedulingGateAge() time.Duration {
+	d, err := time.ParseDuration(c.Resources.Pod.GateAge)
+	if err != nil || d <= 0 {
+		return defaultGateAge
+	}
+	return d
+}
+
+// PodImageScan returns the image scan verdict settings.
+func (c *Config) PodImageScan() ImageScan {
+	return c.Resources.Pod.ImageScan
+}
+
+// PodSidecar returns the expected injected sidecar if any.
+func (c *Config) PodSidecar() Sidecar {
+	return c.Resources.Pod.Sidecar
+}
+
+// PodProductionLabels returns the labels identifying production namespaces.
+func (c *Config) PodProductionLabels() map[string]string {
+	return c.Resources.Pod.ProductionLabels
+}
+
 // PodMEMLimit returns the pod mem threshold if set otherwise the default.
 func (c *Config) PodMEMLimit() float64 {
 	l := c.Resources.Pod.Limits.Memory
@@ -144,6 +239,55 @@ func (c *Config) AllowedRegistries() []string {
 	return c.Registries
 }
 
+// ConfigHashAnnotation returns the pod template config hash annotation if any.
+func (c *Config) ConfigHashAnnotation() string {
+	return c.ConfigHash
+}
+
+// FinalizerGrace returns how long resources may remain terminating.
+func (c *Config) FinalizerGrace() time.Duration {
+	d, err := time.ParseDuration(c.FinalizerWindow)
+	if err != nil || d <= 0 {
+		return defaultFinalizerGrace
+	}
+	return d
+}
+
+// CertExpiryWindow returns how soon before expiry TLS certificates are flagged.
+func (c *Config) CertExpiryWindow() time.Duration {
+	d, err := time.ParseDuration(c.CertWindow)
+	if err != nil || d <= 0 {
+		return defaultCertWindow
+	}
+	return d
+}
+
+// SensitivePorts returns ports that should not be exposed outside the cluster.
+func (c *Config) SensitivePorts() []int32 {
+	if len(c.SensitivePortList) == 0 {
+		return defaultSensitivePorts
+	}
+	return c.SensitivePortList
+}
+
+// RecommendedLabels returns the recommended label keys workloads must carry.
+// Names without a prefix are scoped to app.kubernetes.io.
+func (c *Config) RecommendedLabels() []string {
+	ll := c.RecommendedLabelList
+	if ll == nil {
+		ll = defaultRecommendedLabels
+	}
+	kk := make([]string, 0, len(ll))
+	for _, l := range ll {
+		if !strings.Contains(l, "/") {
+			l = recommendedLabelPrefix + l
+		}
+		kk = append(kk, l)
+	}
+
+	return kk
+}
+
 // ----------------------------------------------------------------------------
 // Helpers...
 
@@ -5,6 +5,7 @@ package config_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/derailed/popeye/internal/rules"
 	"github.com/derailed/popeye/pkg/config"
@@ -321,3 +322,151 @@ func TestNewConfigFileNoExists(t *testing.T) {
 	_, err := config.NewConfig(f)
 	assert.NotNil(t, err)
 }
+
+func TestConfigRecommendedLabels(t *testing.T) {
+	uu := map[string]struct {
+		ll []string
+		e  []string
+	}{
+		"default": {
+			e: []string{
+				"app.kubernetes.io/name",
+				"app.kubernetes.io/instance",
+				"app.kubernetes.io/version",
+				"app.kubernetes.io/component",
+				"app.kubernetes.io/part-of",
+				"app.kubernetes.io/managed-by",
+			},
+		},
+		"subset": {
+			ll: []string{"name", "example.com/team"},
+			e:  []string{"app.kubernetes.io/name", "example.com/team"},
+		},
+		"disabled": {
+			ll: []string{},
+			e:  []string{},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			cfg, err := config.NewConfig(config.NewFlags())
+			assert.NoError(t, err)
+			cfg.RecommendedLabelList = u.ll
+			assert.Equal(t, u.e, cfg.RecommendedLabels())
+		})
+	}
+}
+
+func TestConfigMaxEnvValueSize(t *testing.T) {
+	uu := map[string]struct {
+		size, e int
+	}{
+		"default": {
+			e: 4096,
+		},
+		"custom": {
+			size: 1024,
+			e:    1024,
+		},
+		"negative": {
+			size: -1,
+			e:    4096,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			cfg, err := config.NewConfig(config.NewFlags())
+			assert.NoError(t, err)
+			if u.size != 0 {
+				cfg.Resources.Pod.MaxEnvSize = u.size
+			}
+			assert.Equal(t, u.e, cfg.MaxEnvValueSize())
+		})
+	}
+}
+
+func TestConfigMaxVolumesLimits(t *testing.T) {
+	uu := map[string]struct {
+		volumes, mounts   int
+		eVolumes, eMounts int
+	}{
+		"default": {
+			eVolumes: 20,
+			eMounts:  20,
+		},
+		"custom": {
+			volumes:  10,
+			mounts:   5,
+			eVolumes: 10,
+			eMounts:  5,
+		},
+		"negative": {
+			volumes:  -1,
+			mounts:   -1,
+			eVolumes: 20,
+			eMounts:  20,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			cfg, err := config.NewConfig(config.NewFlags())
+			assert.NoError(t, err)
+			if u.volumes != 0 {
+				cfg.Resources.Pod.MaxVolumes = u.volumes
+			}
+			if u.mounts != 0 {
+				cfg.Resources.Pod.MaxVolumeMounts = u.mounts
+			}
+			assert.Equal(t, u.eVolumes, cfg.MaxVolumesLimit())
+			assert.Equal(t, u.eMounts, cfg.MaxVolumeMountsLimit())
+		})
+	}
+}
+
+func TestConfigMaxInitContainersLimit(t *testing.T) {
+	uu := map[string]struct {
+		max, e int
+	}{
+		"default":  {e: 3},
+		"custom":   {max: 5, e: 5},
+		"negative": {max: -1, e: 3},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			cfg, err := config.NewConfig(config.NewFlags())
+			assert.NoError(t, err)
+			cfg.Resources.Pod.MaxInits = u.max
+			assert.Equal(t, u.e, cfg.MaxInitContainersLimit())
+		})
+	}
+}
+
+func TestConfigCertExpiryWindow(t *testing.T) {
+	uu := map[string]struct {
+		window string
+		e      time.Duration
+	}{
+		"default":  {e: 720 * time.Hour},
+		"custom":   {window: "48h", e: 48 * time.Hour},
+		"negative": {window: "-1h", e: 720 * time.Hour},
+		"toast":    {window: "blee", e: 720 * time.Hour},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			cfg, err := config.NewConfig(config.NewFlags())
+			assert.NoError(t, err)
+			cfg.CertWindow = u.window
+			assert.Equal(t, u.e, cfg.CertExpiryWindow())
+		})
+	}
+}
@@ -16,12 +16,25 @@ var outputs = []string{
 	"jurassic",
 	"yaml",
 	"json",
+	"jsonl",
 	"html",
 	"junit",
 	"score",
 	"prometheus",
 }
 
+var sortModes = []string{
+	"name",
+	"severity",
+}
+
+var logLevels = []string{
+	"debug",
+	"info",
+	"warn",
+	"error",
+}
+
 // Flags represents Popeye CLI flags.
 type Flags struct {
 	*genericclioptions.ConfigFlags
@@ -42,6 +55,18 @@ type Flags struct {
 	ActiveNamespace *string
 	ForceExitZero   *bool
 	MinScore        *int
+	Contexts        *[]string
+	NoColor         *bool
+	LogLevel        *string
+	HistoryFile     *string
+	MaxIssues       *int
+	List            *bool
+	Sort            *string
+	APIQPS          *float32
+	APIBurst        *int
+	TUI             *bool
+	OTLPEndpoint    *string
+	Webhook         *Webhook
 }
 
 // NewFlags returns new configuration flags.
@@ -62,6 +87,18 @@ func NewFlags() *Flags {
 		PushGateway:     newPushGateway(),
 		ForceExitZero:   boolPtr(false),
 		MinScore:        intPtr(0),
+		Contexts:        &[]string{},
+		NoColor:         boolPtr(false),
+		LogLevel:        strPtr(""),
+		HistoryFile:     strPtr(""),
+		MaxIssues:       intPtr(0),
+		List:            boolPtr(false),
+		Sort:            strPtr("name"),
+		APIQPS:          float32Ptr(defaultAPIQPS),
+		APIBurst:        intPtr(defaultAPIBurst),
+		TUI:             boolPtr(false),
+		OTLPEndpoint:    strPtr(""),
+		Webhook:         newWebhook(),
 	}
 }
 
@@ -73,10 +110,49 @@ func (f *Flags) Validate() error {
 		return errors.New("'--save' cannot be used in conjunction with 's3-bucket'.")
 	}
 
+	if IsStrSet(f.LogLevel) && !in(logLevels, f.LogLevel) {
+		return fmt.Errorf("invalid log level. [%s]", strings.Join(logLevels, ","))
+	}
+
+	if f.MaxIssues != nil && *f.MaxIssues < 0 {
+		return errors.New("max issues per resource must be positive")
+	}
+
+	if (f.APIQPS != nil && *f.APIQPS <= 0) || (f.APIBurst != nil && *f.APIBurst <= 0) {
+		return errors.New("api qps and burst must be positive")
+	}
+
+	if IsStrSet(f.Sort) && !in(sortModes, f.Sort) {
+		return fmt.Errorf("invalid sort order. [%s]", strings.Join(sortModes, ","))
+	}
+
+	if w := f.Webhook; w != nil && IsStrSet(w.URL) {
+		if (w.Timeout != nil && *w.Timeout <= 0) || (w.Retries != nil && *w.Retries < 0) {
+			return errors.New("webhook timeout must be positive and retries cannot be negative")
+		}
+	}
+
+	if IsBoolSet(f.List) && f.IsMultiContext() {
+		return errors.New("'--list' cannot be used in conjunction with multiple contexts")
+	}
+
+	if IsBoolSet(f.TUI) {
+		if IsBoolSet(f.Save) || (f.S3 != nil && IsStrSet(f.S3.Bucket)) {
+			return errors.New("'--tui' cannot be used in conjunction with '--save' or 's3-bucket'")
+		}
+		if IsStrSet(f.Output) && *f.Output != "standard" {
+			return errors.New("'--tui' cannot be used in conjunction with '--out'")
+		}
+	}
+
 	if !in(outputs, f.Output) {
 		return fmt.Errorf("invalid output format. [%s]", strings.Join(outputs, ","))
 	}
 
+	if f.PushGateway != nil && !isIncreasing(f.PushGateway.UtilizationBuckets()) {
+		return errors.New("prometheus utilization buckets must be in increasing order")
+	}
+
 	if IsStrSet(f.Output) && *f.Output == "prometheus" {
 		if f.PushGateway == nil || !IsStrSet(f.PushGateway.URL) {
 			return errors.New("you must set --push-gtwy-url when prometheus report is enabled")
@@ -86,10 +162,40 @@ func (f *Flags) Validate() error {
 	return nil
 }
 
+// IsMultiContext checks if several kube contexts were requested.
+func (f *Flags) IsMultiContext() bool {
+	return f.Contexts != nil && len(*f.Contexts) > 1
+}
+
+// ForContext returns a copy of the flags targeting a given kube context.
+func (f *Flags) ForContext(ct string) *Flags {
+	cf := genericclioptions.NewConfigFlags(false)
+	if f.ConfigFlags != nil {
+		cf.CacheDir, cf.KubeConfig = f.CacheDir, f.KubeConfig
+		cf.ClusterName, cf.AuthInfoName = f.ClusterName, f.AuthInfoName
+		cf.Namespace, cf.APIServer, cf.TLSServerName = f.Namespace, f.APIServer, f.TLSServerName
+		cf.Insecure, cf.CertFile, cf.KeyFile, cf.CAFile = f.Insecure, f.CertFile, f.KeyFile, f.CAFile
+		cf.BearerToken, cf.Username, cf.Password = f.BearerToken, f.Username, f.Password
+		cf.Impersonate, cf.ImpersonateUID, cf.ImpersonateGroup = f.Impersonate, f.ImpersonateUID, f.ImpersonateGroup
+		cf.Timeout, cf.DisableCompression = f.Timeout, f.DisableCompression
+	}
+	cf.Context = strPtr(ct)
+
+	ff := *f
+	ff.ConfigFlags, ff.Contexts = cf, &[]string{ct}
+
+	return &ff
+}
+
 func (f *Flags) IsPersistent() bool {
 	return IsBoolSet(f.Save) || IsStrSet(f.OutputFile) || (f.S3 != nil && IsStrSet(f.S3.Bucket))
 }
 
+// IsVerbose checks if debug logs were requested.
+func (f *Flags) IsVerbose() bool {
+	return IsStrSet(f.LogLevel) && *f.LogLevel == "debug"
+}
+
 // OutputFormat returns the report output format.
 func (f *Flags) OutputFormat() string {
 	if f.Output != nil && *f.Output != "" {
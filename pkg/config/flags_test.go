@@ -107,6 +107,25 @@ func TestOutputFormat(t *testing.T) {
 	}
 }
 
+func TestIsVerbose(t *testing.T) {
+	uu := map[string]struct {
+		f Flags
+		e bool
+	}{
+		"debug": {Flags{LogLevel: strPtr("debug")}, true},
+		"info":  {Flags{LogLevel: strPtr("info")}, false},
+		"blank": {Flags{LogLevel: strPtr("")}, false},
+		"nil":   {Flags{}, false},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, u.f.IsVerbose())
+		})
+	}
+}
+
 func TestParseBucket(t *testing.T) {
 	var uu = map[string]struct {
 		uri    string
@@ -3,7 +3,10 @@
 
 package config
 
-import "regexp"
+import (
+	"regexp"
+	"time"
+)
 
 var invalidPathCharsRX = regexp.MustCompile(`[:/]+`)
 
@@ -46,3 +49,21 @@ func strPtr(s string) *string {
 func intPtr(i int) *int {
 	return &i
 }
+
+func float32Ptr(f float32) *float32 {
+	return &f
+}
+
+func durationPtr(d time.Duration) *time.Duration {
+	return &d
+}
+
+func isIncreasing(ff []float64) bool {
+	for i := 1; i < len(ff); i++ {
+		if ff[i] <= ff[i-1] {
+			return false
+		}
+	}
+
+	return true
+}
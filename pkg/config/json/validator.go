@@ -9,6 +9,8 @@ import (
 	"errors"
 	"fmt"
 	"slices"
+	"strconv"
+	"strings"
 
 	"github.com/rs/zerolog/log"
 	"github.com/xeipuuv/gojsonschema"
@@ -53,10 +55,14 @@ func (v *Validator) register() {
 }
 
 // Validate runs document thru given schema validation.
+// Errors are reported with the offending field and its line in the document.
 func (v *Validator) Validate(k string, bb []byte) error {
+	var root yaml.Node
+	if err := yaml.Unmarshal(bb, &root); err != nil {
+		return err
+	}
 	var m interface{}
-	err := yaml.Unmarshal(bb, &m)
-	if err != nil {
+	if err := root.Decode(&m); err != nil {
 		return err
 	}
 
@@ -72,13 +78,99 @@ func (v *Validator) Validate(k string, bb []byte) error {
 		return nil
 	}
 
-	slices.SortFunc(result.Errors(), func(a, b gojsonschema.ResultError) int {
-		return cmp.Compare(a.Description(), b.Description())
+	ee := make([]fieldError, 0, len(result.Errors()))
+	for _, re := range result.Errors() {
+		ee = append(ee, newFieldError(&root, re))
+	}
+	slices.SortFunc(ee, func(a, b fieldError) int {
+		if c := cmp.Compare(a.line, b.line); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.msg, b.msg)
 	})
 	var errs error
-	for _, re := range result.Errors() {
-		errs = errors.Join(errs, errors.New(re.Description()))
+	for _, e := range ee {
+		errs = errors.Join(errs, errors.New(e.String()))
 	}
 
 	return errs
 }
+
+// fieldError tracks a schema violation and its location.
+type fieldError struct {
+	line  int
+	field string
+	msg   string
+}
+
+func newFieldError(root *yaml.Node, re gojsonschema.ResultError) fieldError {
+	field := re.Field()
+	if field == gojsonschema.STRING_ROOT_SCHEMA_PROPERTY {
+		field = ""
+	}
+	path := splitField(field)
+	if p, ok := re.Details()["property"].(string); ok && re.Type() == "additional_property_not_allowed" {
+		path = append(path, splitField(p)...)
+	}
+
+	return fieldError{
+		line:  lineFor(root, path),
+		field: field,
+		msg:   re.Description(),
+	}
+}
+
+// String returns the error with its location if known.
+func (e fieldError) String() string {
+	var loc []string
+	if e.line > 0 {
+		loc = append(loc, "line "+strconv.Itoa(e.line))
+	}
+	if e.field != "" {
+		loc = append(loc, e.field)
+	}
+	loc = append(loc, e.msg)
+
+	return strings.Join(loc, ": ")
+}
+
+func splitField(field string) []string {
+	if field == "" {
+		return nil
+	}
+
+	return strings.Split(field, ".")
+}
+
+// lineFor returns the document line for a given field path.
+// Path segments are greedily joined to match keys containing dots.
+func lineFor(n *yaml.Node, path []string) int {
+	if n.Kind == yaml.DocumentNode && len(n.Content) > 0 {
+		return lineFor(n.Content[0], path)
+	}
+	if len(path) == 0 {
+		return n.Line
+	}
+
+	switch n.Kind {
+	case yaml.MappingNode:
+		for i := len(path); i > 0; i-- {
+			key := strings.Join(path[:i], ".")
+			for j := 0; j+1 < len(n.Content); j += 2 {
+				if n.Content[j].Value != key {
+					continue
+				}
+				if i == len(path) {
+					return n.Content[j].Line
+				}
+				return lineFor(n.Content[j+1], path[i:])
+			}
+		}
+	case yaml.SequenceNode:
+		if idx, err := strconv.Atoi(path[0]); err == nil && idx >= 0 && idx < len(n.Content) {
+			return lineFor(n.Content[idx], path[1:])
+		}
+	}
+
+	return n.Line
+}
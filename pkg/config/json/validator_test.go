@@ -21,7 +21,15 @@ func TestValidateSpinach(t *testing.T) {
 		},
 		"toast": {
 			f:   "testdata/toast.yaml",
-			err: "Additional property rbac.authorization.k8s.io/v1/clusterroles is not allowed",
+			err: "line 16: popeye.excludes: Additional property rbac.authorization.k8s.io/v1/clusterroles is not allowed",
+		},
+		"unknown-key": {
+			f:   "testdata/unknown.yaml",
+			err: "line 8: popeye.resources.pod: Additional property restart is not allowed\nline 9: popeye.resources.pod.maxContainers: Invalid type. Expected: integer, given: string",
+		},
+		"bad-level": {
+			f:   "testdata/bad_level.yaml",
+			err: "line 7: popeye.overrides.1.severity: Must be less than or equal to 3",
 		},
 	}
 
@@ -31,9 +39,12 @@ func TestValidateSpinach(t *testing.T) {
 		t.Run(k, func(t *testing.T) {
 			bb, err := os.ReadFile(u.f)
 			assert.NoError(t, err)
-			if err := v.Validate(json.SpinachSchema, bb); err != nil {
-				assert.Equal(t, u.err, err.Error())
+			err = v.Validate(json.SpinachSchema, bb)
+			if u.err == "" {
+				assert.NoError(t, err)
+				return
 			}
+			assert.EqualError(t, err, u.err)
 		})
 	}
 }
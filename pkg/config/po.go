@@ -3,18 +3,67 @@
 
 package config
 
-const defaultRestarts = 5
+import "time"
+
+const (
+	defaultRestarts      = 5
+	defaultMaxContainers = 5
+	defaultOrphanAge     = 7 * 24 * time.Hour
+	defaultGateAge       = 15 * time.Minute
+	defaultMaxEnvSize    = 4096
+	defaultMaxVolumes    = 20
+	defaultMaxMounts     = 20
+	defaultMaxInits      = 3
+)
 
 // Pod tracks pod configurations.
 type Pod struct {
-	Restarts int    `yaml:"restarts"`
-	Limits   Limits `yaml:"limits"`
+	Restarts         int               `yaml:"restarts"`
+	MaxContainers    int               `yaml:"maxContainers"`
+	MaxEnvSize       int               `yaml:"maxEnvValueSize"`
+	MaxVolumes       int               `yaml:"maxVolumes"`
+	MaxVolumeMounts  int               `yaml:"maxVolumeMounts"`
+	MaxInits         int               `yaml:"maxInitContainers"`
+	OrphanAge        string            `yaml:"orphanAge"`
+	GateAge          string            `yaml:"schedulingGateAge"`
+	Limits           Limits            `yaml:"limits"`
+	Sidecar          Sidecar           `yaml:"sidecar"`
+	ImageScan        ImageScan         `yaml:"imageScan"`
+	ProductionLabels map[string]string `yaml:"productionLabels"`
+}
+
+// Sidecar tracks a sidecar container injected in labeled namespaces.
+type Sidecar struct {
+	Container       string            `yaml:"container"`
+	NamespaceLabels map[string]string `yaml:"namespaceLabels"`
+}
+
+// IsSet checks if a sidecar expectation is configured.
+func (s Sidecar) IsSet() bool {
+	return s.Container != "" && len(s.NamespaceLabels) > 0
+}
+
+// ImageScan tracks image vulnerability scan verdicts recorded by an admission
+// scanner as pod annotations.
+type ImageScan struct {
+	Annotation string   `yaml:"annotation"`
+	Accepted   []string `yaml:"accepted"`
+}
+
+// IsSet checks if an image scan annotation is configured.
+func (s ImageScan) IsSet() bool {
+	return s.Annotation != ""
 }
 
 // NewPod create a new pod configuration.
 func newPod() Pod {
 	return Pod{
-		Restarts: defaultRestarts,
+		Restarts:        defaultRestarts,
+		MaxContainers:   defaultMaxContainers,
+		MaxEnvSize:      defaultMaxEnvSize,
+		MaxVolumes:      defaultMaxVolumes,
+		MaxVolumeMounts: defaultMaxMounts,
+		MaxInits:        defaultMaxInits,
 		Limits: Limits{
 			CPU:    defaultCPULimit,
 			Memory: defaultMEMLimit,
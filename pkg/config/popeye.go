@@ -48,6 +48,21 @@ type (
 
 		// Registries tracks allowed docker registries.
 		Registries []string `yaml:"registries"`
+
+		// ConfigHash tracks the pod template annotation holding the referenced ConfigMaps hash.
+		ConfigHash string `yaml:"configHashAnnotation"`
+
+		// FinalizerWindow tracks how long resources may be terminating before being flagged.
+		FinalizerWindow string `yaml:"finalizerGrace"`
+
+		// CertWindow tracks how soon before expiry TLS certificates are flagged.
+		CertWindow string `yaml:"certExpiryWindow"`
+
+		// SensitivePortList tracks ports that should not be exposed outside the cluster.
+		SensitivePortList []int32 `yaml:"sensitivePorts"`
+
+		// RecommendedLabelList tracks the app.kubernetes.io labels workloads must carry.
+		RecommendedLabelList []string `yaml:"recommendedLabels"`
 	}
 )
 
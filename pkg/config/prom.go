@@ -16,15 +16,32 @@ func newBasicAuth() BasicAuth {
 	}
 }
 
+// DefaultUtilizationBuckets tracks the default container utilization histogram buckets.
+var DefaultUtilizationBuckets = []float64{0.1, 0.25, 0.5, 0.75, 1, 1.5, 2, 4}
+
 // PushGateway tracks prometheus gateway representations.
 type PushGateway struct {
 	URL       *string
 	BasicAuth BasicAuth
+	Buckets   *[]float64
 }
 
 func newPushGateway() *PushGateway {
+	bb := make([]float64, len(DefaultUtilizationBuckets))
+	copy(bb, DefaultUtilizationBuckets)
+
 	return &PushGateway{
 		URL:       strPtr(""),
 		BasicAuth: newBasicAuth(),
+		Buckets:   &bb,
+	}
+}
+
+// UtilizationBuckets returns the container utilization histogram buckets.
+func (p *PushGateway) UtilizationBuckets() []float64 {
+	if p.Buckets == nil || len(*p.Buckets) == 0 {
+		return DefaultUtilizationBuckets
 	}
+
+	return *p.Buckets
 }
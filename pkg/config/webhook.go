@@ -0,0 +1,30 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Popeye
+
+package config
+
+import "time"
+
+const (
+	defaultWebhookTimeout = 10 * time.Second
+	defaultWebhookRetries = 3
+)
+
+// Webhook tracks the report webhook sink configuration.
+type Webhook struct {
+	URL     *string
+	Token   *string
+	Timeout *time.Duration
+	Retries *int
+	Fail    *bool
+}
+
+func newWebhook() *Webhook {
+	return &Webhook{
+		URL:     strPtr(""),
+		Token:   strPtr(""),
+		Timeout: durationPtr(defaultWebhookTimeout),
+		Retries: intPtr(defaultWebhookRetries),
+		Fail:    boolPtr(false),
+	}
+}
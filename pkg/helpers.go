@@ -11,15 +11,34 @@ import (
 	"runtime/debug"
 	"strings"
 
+	"github.com/derailed/popeye/internal"
 	"github.com/derailed/popeye/internal/report"
-	"github.com/rs/zerolog/log"
+	"github.com/derailed/popeye/pkg/config"
 )
 
+// useColors checks if the report output should be colorized.
+func (p *Popeye) useColors() bool {
+	if config.IsBoolSet(p.flags.NoColor) || os.Getenv("NO_COLOR") != "" {
+		return false
+	}
+
+	return isTerminal(p.outputTarget)
+}
+
+func isTerminal(w io.Writer) bool {
+	f, ok := w.(*os.File)
+	if !ok {
+		return false
+	}
+	fi, err := f.Stat()
+
+	return err == nil && fi.Mode()&os.ModeCharDevice != 0
+}
+
 func BailOut(err error) {
 	printMsgLogo("DOH", "X", report.ColorOrangish, report.ColorRed)
 	fmt.Printf("\n\nBoom! %v (see logs)\n", err)
-	log.Error().Msgf("%v", err)
-	log.Error().Msg(string(debug.Stack()))
+	internal.DefaultLogger().Log(internal.ErrorLog, "scan failed", "error", err, "stack", string(debug.Stack()))
 	os.Exit(1)
 }
 
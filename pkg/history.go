@@ -0,0 +1,42 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Popeye
+
+package pkg
+
+import (
+	"time"
+
+	"github.com/derailed/popeye/internal"
+	"github.com/derailed/popeye/internal/history"
+	"github.com/derailed/popeye/internal/report"
+	"github.com/derailed/popeye/internal/rules"
+	"github.com/derailed/popeye/pkg/config"
+)
+
+// recordHistory appends a scan summary to the history file if one was requested.
+// Failing to record history is logged but does not fail the scan.
+func (p *Popeye) recordHistory(b *report.Builder, cl, ct string) {
+	if !config.IsStrSet(p.flags.HistoryFile) || !b.HasContent() {
+		return
+	}
+	s := history.NewStore(*p.flags.HistoryFile)
+	if err := s.Append(scanSummary(b, cl, ct, time.Now())); err != nil {
+		p.logger.Log(internal.WarnLog, "history record failed", "file", s.Path(), "error", err)
+	}
+}
+
+func scanSummary(b *report.Builder, cl, ct string, t time.Time) history.Scan {
+	score, _ := b.ToScore()
+	cc := b.LevelCounts()
+
+	return history.Scan{
+		Timestamp: t.UTC(),
+		Cluster:   cl,
+		Context:   ct,
+		Score:     score,
+		Grade:     b.Report.Grade,
+		Errors:    cc[rules.ErrorLevel],
+		Warnings:  cc[rules.WarnLevel],
+		Infos:     cc[rules.InfoLevel],
+	}
+}
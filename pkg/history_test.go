@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Popeye
+
+package pkg
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/derailed/popeye/internal/history"
+	"github.com/derailed/popeye/internal/rules"
+	"github.com/derailed/popeye/pkg/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecordHistory(t *testing.T) {
+	var (
+		l    captureLogger
+		file = filepath.Join(t.TempDir(), "history.json")
+	)
+	flags := config.NewFlags()
+	flags.HistoryFile = &file
+	p := Popeye{flags: flags, logger: &l}
+
+	for _, u := range []struct {
+		ct    string
+		level rules.Level
+	}{
+		{ct: "c1", level: rules.ErrorLevel},
+		{ct: "c2", level: rules.WarnLevel},
+		{ct: "c1", level: rules.InfoLevel},
+	} {
+		b, err := fakeScan("default/p1", u.level)(u.ct)
+		assert.NoError(t, err)
+		p.recordHistory(b, "cl", u.ct)
+	}
+	assert.Empty(t, l.events)
+
+	ss, err := history.NewStore(file).Last(0, "")
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(ss))
+	assert.Equal(t, []string{"c1", "c2", "c1"}, []string{ss[0].Context, ss[1].Context, ss[2].Context})
+	assert.Equal(t, 1, ss[0].Errors)
+	assert.Equal(t, 1, ss[1].Warnings)
+	assert.Equal(t, 1, ss[2].Infos)
+	assert.Equal(t, "cl", ss[2].Cluster)
+
+	ss, err = history.NewStore(file).Last(1, "c1")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(ss))
+	assert.Equal(t, 1, ss[0].Infos)
+}
+
+func TestRecordHistoryDisabled(t *testing.T) {
+	b, err := fakeScan("default/p1", rules.ErrorLevel)("c1")
+	assert.NoError(t, err)
+
+	p := Popeye{flags: config.NewFlags()}
+	p.recordHistory(b, "cl", "c1")
+}
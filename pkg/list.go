@@ -0,0 +1,124 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Popeye
+
+package pkg
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/derailed/popeye/internal"
+	"github.com/derailed/popeye/internal/client"
+	"github.com/derailed/popeye/internal/issues"
+	"github.com/derailed/popeye/internal/scrub"
+	"github.com/derailed/popeye/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// listing tracks the resources a linter would scan.
+type listing struct {
+	linter   string
+	gvr      types.GVR
+	fqns     []string
+	excluded int
+}
+
+// List prints out the resources that would be scanned without linting them.
+func (p *Popeye) List() error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ctx = p.buildCtx(ctx)
+
+	codes, err := issues.LoadCodes()
+	if err != nil {
+		return err
+	}
+	ns := p.activeNamespace()
+	ll, err := p.listings(ctx, scrub.NewCache(p.db, p.factory, p.config), codes, scrub.Scrubers(), ns)
+	if err != nil {
+		return err
+	}
+	writeListings(p.outputTarget, ns, ll)
+
+	return nil
+}
+
+// listings loads the resources each selected linter would scan.
+func (p *Popeye) listings(ctx context.Context, cache *scrub.Cache, codes *issues.Codes, ss scrub.Scrubs, ns string) ([]listing, error) {
+	ll := make([]listing, 0, len(ss))
+	for k, fn := range ss {
+		gvr := p.db.GVR(k)
+		if gvr == types.BlankGVR {
+			continue
+		}
+		if reason, ok := p.skipLinter(gvr); ok {
+			p.logger.Log(internal.DebugLog, "linter skipped", "linter", k, "reason", reason)
+			continue
+		}
+		lctx, lns := ctx, ns
+		if !p.aliases.IsNamespaced(gvr) {
+			lctx, lns = context.WithValue(ctx, internal.KeyNamespace, client.ClusterScope), client.ClusterScope
+		}
+		load, ok := fn(lctx, cache, codes).Preloads()[k]
+		if !ok {
+			continue
+		}
+		if err := load(lctx, cache.Loader, gvr); err != nil {
+			return nil, err
+		}
+		ll = append(ll, p.listFor(k, gvr, lns))
+	}
+	slices.SortFunc(ll, func(a, b listing) int {
+		return strings.Compare(a.linter, b.linter)
+	})
+
+	return ll, nil
+}
+
+func (p *Popeye) listFor(k internal.R, gvr types.GVR, ns string) listing {
+	l := listing{linter: string(k), gvr: gvr}
+	txn, it := p.db.MustITFor(gvr)
+	defer txn.Abort()
+	for o := it.Next(); o != nil; o = it.Next() {
+		m, ok := o.(metav1.ObjectMetaAccessor)
+		if !ok {
+			continue
+		}
+		meta := m.GetObjectMeta()
+		if !client.IsClusterWide(ns) && meta.GetNamespace() != ns {
+			continue
+		}
+		fqn := client.FQN(meta.GetNamespace(), meta.GetName())
+		if p.config.ExcludeFQN(gvr, fqn, nil) {
+			l.excluded++
+			continue
+		}
+		l.fqns = append(l.fqns, fqn)
+	}
+	slices.Sort(l.fqns)
+
+	return l
+}
+
+func writeListings(w io.Writer, ns string, ll []listing) {
+	if client.IsAllNamespaces(ns) {
+		ns = client.NamespaceAll
+	}
+	var total int
+	fmt.Fprintf(w, "Namespace: %s\n", ns)
+	for _, l := range ll {
+		total += len(l.fqns)
+		fmt.Fprintf(w, "\n%s (%s) -- %d resource(s)", l.linter, l.gvr, len(l.fqns))
+		if l.excluded > 0 {
+			fmt.Fprintf(w, ", %d excluded", l.excluded)
+		}
+		fmt.Fprintln(w)
+		for _, fqn := range l.fqns {
+			fmt.Fprintf(w, "  · %s\n", fqn)
+		}
+	}
+	fmt.Fprintf(w, "\n%d linter(s), %d resource(s) would be scanned\n", len(ll), total)
+}
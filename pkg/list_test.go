@@ -0,0 +1,200 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Popeye
+
+package pkg
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/derailed/popeye/internal"
+	"github.com/derailed/popeye/internal/client"
+	"github.com/derailed/popeye/internal/db"
+	"github.com/derailed/popeye/internal/issues"
+	"github.com/derailed/popeye/internal/scrub"
+	"github.com/derailed/popeye/internal/test"
+	"github.com/derailed/popeye/pkg/config"
+	"github.com/derailed/popeye/types"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/discovery/cached/disk"
+	"k8s.io/client-go/rest"
+)
+
+func TestListings(t *testing.T) {
+	uu := map[string]struct {
+		ns       string
+		sections []string
+		e        map[string][]string
+	}{
+		"all": {
+			ns: client.NamespaceAll,
+			e: map[string][]string{
+				"pods":     {"ns1/p1", "ns1/p2", "ns2/p3"},
+				"services": {"ns1/s1", "ns2/s2"},
+			},
+		},
+		"namespace": {
+			ns: "ns1",
+			e: map[string][]string{
+				"pods":     {"ns1/p1", "ns1/p2"},
+				"services": {"ns1/s1"},
+			},
+		},
+		"sections": {
+			ns:       "ns2",
+			sections: []string{"po"},
+			e: map[string][]string{
+				"pods": {"ns2/p3"},
+			},
+		},
+	}
+
+	dba, aliases := listDB(t), internal.NewAliases()
+	assert.NoError(t, aliases.Init(newDiscoveryConn(t)))
+	aliases.Realize()
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			flags := config.NewFlags()
+			flags.Sections = &u.sections
+			cfg, err := config.NewConfig(flags)
+			assert.NoError(t, err)
+			p := Popeye{
+				config:  cfg,
+				db:      dba,
+				logger:  &captureLogger{},
+				aliases: aliases,
+			}
+			ss := scrub.Scrubs{
+				internal.PO:  listScrub(internal.PO),
+				internal.SVC: listScrub(internal.SVC),
+			}
+
+			ll, err := p.listings(context.Background(), scrub.NewCache(dba, nil, cfg), nil, ss, u.ns)
+			assert.NoError(t, err)
+			e := make(map[string][]string, len(ll))
+			for _, l := range ll {
+				e[l.gvr.R()] = l.fqns
+			}
+			assert.Equal(t, u.e, e)
+		})
+	}
+}
+
+func TestWriteListings(t *testing.T) {
+	ll := []listing{
+		{
+			linter: "pods",
+			gvr:    test.Glossary[internal.PO],
+			fqns:   []string{"ns1/p1", "ns1/p2"},
+		},
+		{
+			linter:   "services",
+			gvr:      test.Glossary[internal.SVC],
+			excluded: 1,
+		},
+	}
+	var buff bytes.Buffer
+	writeListings(&buff, "", ll)
+
+	assert.Equal(t, `Namespace: all
+
+pods (v1/pods) -- 2 resource(s)
+  · ns1/p1
+  · ns1/p2
+
+services (v1/services) -- 0 resource(s), 1 excluded
+
+2 linter(s), 2 resource(s) would be scanned
+`, buff.String())
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func listDB(t *testing.T) *db.DB {
+	dba, err := test.NewTestDB()
+	assert.NoError(t, err)
+
+	txn := dba.Txn(true)
+	for _, po := range []*v1.Pod{makeListPod("ns1", "p1"), makeListPod("ns1", "p2"), makeListPod("ns2", "p3")} {
+		assert.NoError(t, txn.Insert(test.Glossary[internal.PO].String(), po))
+	}
+	for _, svc := range []*v1.Service{makeListSvc("ns1", "s1"), makeListSvc("ns2", "s2")} {
+		assert.NoError(t, txn.Insert(test.Glossary[internal.SVC].String(), svc))
+	}
+	txn.Commit()
+
+	return dba
+}
+
+func makeListPod(ns, n string) *v1.Pod {
+	return &v1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: ns, Name: n}}
+}
+
+func makeListSvc(ns, n string) *v1.Service {
+	return &v1.Service{ObjectMeta: metav1.ObjectMeta{Namespace: ns, Name: n}}
+}
+
+// discoveryConn serves core pods and services discovery.
+type discoveryConn struct {
+	types.Connection
+	cfg *rest.Config
+	dir string
+}
+
+func newDiscoveryConn(t *testing.T) discoveryConn {
+	rr := metav1.APIResourceList{
+		GroupVersion: "v1",
+		APIResources: []metav1.APIResource{
+			{Name: "pods", SingularName: "pod", Kind: "Pod", ShortNames: []string{"po"}, Namespaced: true, Verbs: metav1.Verbs{"list"}},
+			{Name: "services", SingularName: "service", Kind: "Service", ShortNames: []string{"svc"}, Namespaced: true, Verbs: metav1.Verbs{"list"}},
+		},
+	}
+	mm := map[string]any{
+		"/api":    metav1.APIVersions{Versions: []string{"v1"}},
+		"/apis":   metav1.APIGroupList{},
+		"/api/v1": rr,
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		o, ok := mm[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		assert.NoError(t, json.NewEncoder(w).Encode(o))
+	}))
+	t.Cleanup(srv.Close)
+
+	return discoveryConn{cfg: &rest.Config{Host: srv.URL}, dir: t.TempDir()}
+}
+
+func (c discoveryConn) CachedDiscovery() (*disk.CachedDiscoveryClient, error) {
+	return disk.NewCachedDiscoveryClientForConfig(c.cfg, filepath.Join(c.dir, "discovery"), filepath.Join(c.dir, "http"), time.Minute)
+}
+
+type listLinter struct {
+	fakeLinter
+	r internal.R
+}
+
+func (l listLinter) Preloads() scrub.Preloads {
+	return scrub.Preloads{
+		l.r: func(context.Context, *db.Loader, types.GVR) error { return nil },
+	}
+}
+
+func listScrub(r internal.R) scrub.ScrubFn {
+	return func(context.Context, *scrub.Cache, *issues.Codes) scrub.Linter {
+		return listLinter{r: r}
+	}
+}
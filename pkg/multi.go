@@ -0,0 +1,126 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Popeye
+
+package pkg
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/derailed/popeye/internal/issues"
+	"github.com/derailed/popeye/internal/report"
+	"github.com/derailed/popeye/pkg/config"
+	"golang.org/x/sync/errgroup"
+)
+
+// ScanFn lints a given kube context and returns its report.
+type ScanFn func(ct string) (*report.Builder, error)
+
+// maxContextScans bounds the number of kube contexts linted concurrently.
+const maxContextScans = 4
+
+// ScanContexts lints the given kube contexts concurrently and combines their
+// reports. A failing context is recorded as a report error and does not abort
+// the others.
+func ScanContexts(cts []string, scan ScanFn) *report.Builder {
+	var (
+		b  = report.NewBuilder()
+		mx sync.Mutex
+		g  errgroup.Group
+	)
+	g.SetLimit(maxContextScans)
+	for _, ct := range cts {
+		ct := ct
+		g.Go(func() error {
+			sb, err := safeScan(scan, ct)
+			mx.Lock()
+			defer mx.Unlock()
+			if err != nil {
+				b.AddError(fmt.Errorf("context %q scan failed: %w", ct, err))
+				return nil
+			}
+			b.AddContext(ct, sb)
+			return nil
+		})
+	}
+	_ = g.Wait()
+
+	return b
+}
+
+// safeScan lints a context, reporting panics as errors.
+func safeScan(scan ScanFn, ct string) (b *report.Builder, err error) {
+	defer func() {
+		if e := recover(); e != nil {
+			err = fmt.Errorf("%v", e)
+		}
+	}()
+
+	return scan(ct)
+}
+
+func (p *Popeye) lintContexts() (int, int, error) {
+	codes, err := issues.LoadCodes()
+	if err != nil {
+		return 0, 0, err
+	}
+	codes.Refine(p.config.Overrides)
+	p.codes, p.metrics = codes, true
+
+	p.builder = ScanContexts(*p.flags.Contexts, p.scanContext)
+	p.builder.SetUtilization(p.utilization)
+	if !p.builder.HasContent() {
+		return 0, 0, fmt.Errorf("all context scans failed: %v", p.builder.Report.Errors)
+	}
+	score, err := p.builder.ToScore()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return p.builder.ErrCount(), score, nil
+}
+
+func (p *Popeye) scanContext(ct string) (*report.Builder, error) {
+	pp, err := NewPopeye(p.flags.ForContext(ct), p.log)
+	if err != nil {
+		return nil, err
+	}
+	pp.SetLogger(p.logger)
+	if p.streamer != nil {
+		pp.streamer = p.streamer.ForContext(ct)
+	}
+	if err := pp.initFactory(); err != nil {
+		return nil, err
+	}
+
+	if err := pp.initScan(); err != nil {
+		return nil, err
+	}
+	if _, _, err := pp.lint(); err != nil {
+		return nil, err
+	}
+
+	p.mx.Lock()
+	defer p.mx.Unlock()
+	p.recordHistory(pp.builder, pp.fetchClusterName(), pp.fetchContextName())
+	p.metrics = p.metrics && pp.client().HasMetrics()
+	for _, s := range pp.utilization.Samples() {
+		p.utilization.Record(s.Namespace, s.CPU, s.MEM)
+	}
+
+	return pp.builder, nil
+}
+
+func (p *Popeye) contextNames() string {
+	return strings.Join(*p.flags.Contexts, ",")
+}
+
+func contextPath(cts []string) string {
+	nn := make([]string, 0, len(cts))
+	for _, ct := range cts {
+		nn = append(nn, config.SanitizeFileName(ct))
+	}
+
+	return strings.Join(nn, "_")
+}
@@ -0,0 +1,90 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Popeye
+
+package pkg
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/derailed/popeye/internal/issues"
+	"github.com/derailed/popeye/internal/report"
+	"github.com/derailed/popeye/internal/rules"
+	"github.com/derailed/popeye/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScanContexts(t *testing.T) {
+	scans := map[string]ScanFn{
+		"c1": fakeScan("default/p1", rules.ErrorLevel),
+		"c2": fakeScan("default/p1", rules.WarnLevel),
+		"c3": func(string) (*report.Builder, error) {
+			return nil, errors.New("cluster down")
+		},
+		"c4": func(string) (*report.Builder, error) {
+			panic("boom")
+		},
+	}
+	b := ScanContexts([]string{"c1", "c2", "c3", "c4"}, func(ct string) (*report.Builder, error) {
+		return scans[ct](ct)
+	})
+
+	assert.True(t, b.HasContent())
+	assert.Equal(t, 1, len(b.Report.Sections))
+	o := b.Report.Sections[0].Outcome
+	assert.Equal(t, 2, len(o))
+	assert.Equal(t, rules.ErrorLevel, o.MaxSeverity("c1/default/p1"))
+	assert.Equal(t, rules.WarnLevel, o.MaxSeverity("c2/default/p1"))
+	assert.Equal(t, 1, b.ErrCount())
+
+	ee := make([]string, 0, len(b.Report.Errors))
+	for _, e := range b.Report.Errors {
+		ee = append(ee, e.Error())
+	}
+	assert.ElementsMatch(t, []string{
+		`context "c3" scan failed: cluster down`,
+		`context "c4" scan failed: boom`,
+	}, ee)
+}
+
+func TestScanContextsSameCode(t *testing.T) {
+	gvr := types.NewGVR("v1/configmaps")
+	scan := func(ct string) (*report.Builder, error) {
+		o := issues.Outcome{
+			"default/cm1": issues.Issues{
+				issues.New(gvr, issues.Root, rules.InfoLevel, `[POP-401] Key "`+ct+`-k1" used? Unable to locate key reference`),
+				issues.New(gvr, issues.Root, rules.InfoLevel, `[POP-401] Key "`+ct+`-k2" used? Unable to locate key reference`),
+			},
+		}
+		b := report.NewBuilder()
+		b.AddSection(gvr, "configmap", o, report.NewTally().Rollup(o))
+
+		return b, nil
+	}
+	b := ScanContexts([]string{"c1", "c2"}, scan)
+
+	assert.Equal(t, 1, len(b.Report.Sections))
+	o := b.Report.Sections[0].Outcome
+	assert.Equal(t, 2, len(o))
+	for _, ct := range []string{"c1", "c2"} {
+		ii := o[report.ContextFQN(ct, "default/cm1")]
+		assert.Equal(t, 2, len(ii))
+		assert.Equal(t, `[POP-401] Key "`+ct+`-k1" used? Unable to locate key reference`, ii[0].Message)
+		assert.Equal(t, `[POP-401] Key "`+ct+`-k2" used? Unable to locate key reference`, ii[1].Message)
+	}
+}
+
+func fakeScan(fqn string, l rules.Level) ScanFn {
+	return func(string) (*report.Builder, error) {
+		gvr := types.NewGVR("v1/pods")
+		o := issues.Outcome{
+			fqn: issues.Issues{
+				issues.New(gvr, issues.Root, l, "blah"),
+			},
+		}
+		b := report.NewBuilder()
+		b.AddSection(gvr, "pod", o, report.NewTally().Rollup(o))
+
+		return b, nil
+	}
+}
@@ -0,0 +1,27 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Popeye
+
+package pkg
+
+import (
+	"context"
+
+	"github.com/derailed/popeye/internal"
+	"github.com/derailed/popeye/internal/report"
+	"github.com/derailed/popeye/pkg/config"
+)
+
+// exportOTLP ships the scan issues to an OpenTelemetry collector.
+// Export failures are logged and do not fail the scan.
+func (p *Popeye) exportOTLP(b *report.Builder) {
+	if !config.IsStrSet(p.flags.OTLPEndpoint) || !b.HasContent() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), defaultGtwyTimeout)
+	defer cancel()
+
+	e := report.NewOTLPExporter(*p.flags.OTLPEndpoint)
+	if err := e.Export(ctx, b); err != nil {
+		p.logger.Log(internal.WarnLog, "otlp export failed", "endpoint", e.URL(), "error", err)
+	}
+}
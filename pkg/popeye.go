@@ -14,6 +14,8 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"runtime/debug"
+	"sync"
 	"time"
 
 	"github.com/derailed/popeye/internal"
@@ -26,12 +28,12 @@ import (
 	"github.com/derailed/popeye/internal/report"
 	"github.com/derailed/popeye/internal/rules"
 	"github.com/derailed/popeye/internal/scrub"
+	"github.com/derailed/popeye/internal/tui"
 	"github.com/derailed/popeye/pkg/config"
 	"github.com/derailed/popeye/types"
 	"github.com/hashicorp/go-memdb"
 	"github.com/prometheus/common/expfmt"
 	"github.com/rs/zerolog"
-	"github.com/rs/zerolog/log"
 )
 
 const (
@@ -52,6 +54,8 @@ var (
 type run struct {
 	outcome issues.Outcome
 	gvr     types.GVR
+	elapsed time.Duration
+	err     error
 }
 
 // Popeye represents a kubernetes linter/linter.
@@ -61,10 +65,15 @@ type Popeye struct {
 	config       *config.Config
 	outputTarget io.ReadWriteCloser
 	log          *zerolog.Logger
+	logger       internal.Logger
 	flags        *config.Flags
 	builder      *report.Builder
 	aliases      *internal.Aliases
 	codes        *issues.Codes
+	metrics      bool
+	utilization  *internal.Utilization
+	streamer     *report.Streamer
+	mx           sync.Mutex
 }
 
 // NewPopeye returns a new instance.
@@ -74,32 +83,52 @@ func NewPopeye(flags *config.Flags, log *zerolog.Logger) (*Popeye, error) {
 		return nil, err
 	}
 
-	return &Popeye{
-		config:  cfg,
-		log:     log,
-		flags:   flags,
-		builder: report.NewBuilder(),
-		aliases: internal.NewAliases(),
-	}, nil
+	p := Popeye{
+		config:      cfg,
+		log:         log,
+		logger:      internal.NewZeroLogger(*log),
+		flags:       flags,
+		builder:     report.NewBuilder(),
+		aliases:     internal.NewAliases(),
+		utilization: internal.NewUtilization(),
+	}
+	p.builder.SetUtilization(p.utilization)
+	p.builder.SetLogger(p.logger)
+
+	return &p, nil
 }
 
 func (p *Popeye) initDB() (*db.DB, error) {
-	d, err := memdb.NewMemDB(schema.Init())
+	gl := p.aliases.Glossary()
+	d, err := memdb.NewMemDB(schema.Init(gl))
 	if err != nil {
 		return nil, err
 	}
 
-	return db.NewDB(d), nil
+	dba := db.NewDB(d, gl)
+	dba.SetLogger(p.logger)
+
+	return dba, nil
 }
 
 // Init configures popeye prior to sanitization.
 func (p *Popeye) Init() error {
+	if p.flags.IsMultiContext() {
+		return p.initOutput()
+	}
 	if p.factory == nil {
 		if err := p.initFactory(); err != nil {
 			return err
 		}
 	}
+	if err := p.initScan(); err != nil {
+		return err
+	}
+
+	return p.initOutput()
+}
 
+func (p *Popeye) initScan() error {
 	if err := p.aliases.Init(p.client()); err != nil {
 		return err
 	}
@@ -107,9 +136,11 @@ func (p *Popeye) Init() error {
 
 	var err error
 	p.db, err = p.initDB()
-	if err != nil {
-		return err
-	}
+
+	return err
+}
+
+func (p *Popeye) initOutput() error {
 	if !config.IsBoolSet(p.flags.Save) {
 		return p.ensureOutput()
 	}
@@ -120,13 +151,21 @@ func (p *Popeye) Init() error {
 	return p.ensureOutput()
 }
 
+// SetLogger sets the scan logger.
+func (p *Popeye) SetLogger(l internal.Logger) {
+	p.logger = l
+	p.builder.SetLogger(l)
+}
+
 // SetFactory sets the resource factory.
 func (p *Popeye) SetFactory(f types.Factory) {
 	p.factory = f
 }
 
 func (p *Popeye) initFactory() error {
-	clt, err := client.InitConnectionOrDie(client.NewConfig(p.flags.ConfigFlags))
+	cfg := client.NewConfig(p.flags.ConfigFlags)
+	cfg.SetRateLimits(p.apiLimits())
+	clt, err := client.InitConnectionOrDie(cfg)
 	if err != nil {
 		return err
 	}
@@ -143,9 +182,9 @@ func (p *Popeye) initFactory() error {
 	}
 
 	f.Start(ns)
-	for k, gvr := range internal.Glossary {
+	for k, gvr := range p.aliases.Glossary() {
 		if gvr == types.BlankGVR {
-			log.Debug().Msgf("Skipping linter %q", k)
+			p.logger.Log(internal.DebugLog, "linter skipped", "linter", k, "reason", "resource not available")
 			continue
 		}
 		ok, err := clt.CanI(client.AllNamespaces, gvr, "", types.ReadAllAccess)
@@ -162,6 +201,10 @@ func (p *Popeye) initFactory() error {
 }
 
 func (p *Popeye) clusterPath() string {
+	if p.flags.IsMultiContext() {
+		return contextPath(*p.flags.Contexts)
+	}
+
 	return filepath.Join(
 		config.SanitizeFileName(p.client().ActiveCluster()),
 		config.SanitizeFileName(p.client().ActiveContext()),
@@ -169,7 +212,7 @@ func (p *Popeye) clusterPath() string {
 }
 
 // Lint scans a cluster for potential issues.
-func (p *Popeye) Lint() (int, int, error) {
+func (p *Popeye) Lint() (errCount, score int, err error) {
 	defer func() {
 		switch {
 		case config.IsBoolSet(p.flags.Save):
@@ -178,25 +221,47 @@ func (p *Popeye) Lint() (int, int, error) {
 			}
 		case config.IsStrSet(p.flags.S3.Bucket):
 			asset := filepath.Join(p.clusterPath(), p.scanFileName())
-			if err := p.flags.S3.Upload(asset, p.outputTarget); err != nil {
-				log.Fatal().Msgf("S3 upload failed: %s", err)
+			if e := p.flags.S3.Upload(asset, p.outputTarget); e != nil {
+				p.logger.Log(internal.ErrorLog, "s3 upload failed", "asset", asset, "error", e)
+				err = errors.Join(err, fmt.Errorf("s3 upload failed: %w", e))
 			}
 		}
 	}()
 
-	errCount, score, err := p.lint()
+	lint := p.lint
+	if p.flags.IsMultiContext() {
+		lint = p.lintContexts
+	}
+	if p.flags.OutputFormat() == report.JSONLFormat {
+		p.streamer = report.NewStreamer(p.outputTarget)
+		p.streamer.SetMaxIssues(p.maxIssues())
+		p.streamer.SetSort(p.sortBy())
+	}
+	errCount, score, err = lint()
+	if p.streamer != nil {
+		err = errors.Join(err, p.streamer.Close())
+	}
 	if err != nil {
 		return 0, 0, err
 	}
-	log.Debug().Msgf("Score [%d]", score)
+	p.logger.Log(internal.InfoLog, "scan scored", "score", score, "errors", errCount)
+	if !p.flags.IsMultiContext() {
+		p.recordHistory(p.builder, p.fetchClusterName(), p.fetchContextName())
+	}
 
-	return errCount, score, p.dump(true, p.flags.Exhaust())
+	err = p.dump(true, p.flags.Exhaust())
+	p.exportOTLP(p.builder)
+	err = errors.Join(err, p.postWebhook(p.builder))
+
+	return errCount, score, err
 }
 
 func (p *Popeye) buildCtx(ctx context.Context) context.Context {
 	ctx = context.WithValue(ctx, internal.KeyOverAllocs, *p.flags.CheckOverAllocs)
 	ctx = context.WithValue(ctx, internal.KeyFactory, p.factory)
 	ctx = context.WithValue(ctx, internal.KeyConfig, p.config)
+	ctx = context.WithValue(ctx, internal.KeyUtilization, p.utilization)
+	ctx = internal.WithLogger(ctx, p.logger)
 	if version, err := p.client().ServerVersion(); err == nil {
 		ctx = context.WithValue(ctx, internal.KeyVersion, version)
 	}
@@ -222,8 +287,13 @@ func (p *Popeye) validateSpinach(ss scrub.Scrubs) error {
 }
 
 func (p *Popeye) lint() (int, int, error) {
+	var cache *scrub.Cache
 	defer func(t time.Time) {
-		log.Debug().Msgf("Lint %v", time.Since(t))
+		var calls int64
+		if cache != nil {
+			calls = cache.Loader.Calls()
+		}
+		p.logger.Log(internal.InfoLog, "lint completed", "duration", time.Since(t).String(), "loaderCalls", calls)
 	}(time.Now())
 
 	ctx, cancel := context.WithCancel(context.Background())
@@ -237,8 +307,8 @@ func (p *Popeye) lint() (int, int, error) {
 	codes.Refine(p.config.Overrides)
 	p.codes = codes
 
+	cache = scrub.NewCache(p.db, p.factory, p.config)
 	var (
-		cache    = scrub.NewCache(p.db, p.factory, p.config)
 		runners  = make(map[types.GVR]scrub.Linter)
 		scrubers = scrub.Scrubers()
 	)
@@ -251,16 +321,13 @@ func (p *Popeye) lint() (int, int, error) {
 		return 0, 0, err
 	}
 	for k, fn := range scrubers {
-		gvr, ok := internal.Glossary[k]
-		if !ok || gvr == types.BlankGVR {
+		gvr := p.db.GVR(k)
+		if gvr == types.BlankGVR {
 			continue
 		}
 
-		if p.aliases.Exclude(gvr, p.config.Sections()) {
-			continue
-		}
-		// Skip node linter if active namespace is set.
-		if gvr == internal.Glossary[internal.NO] && p.client().ActiveNamespace() != client.AllNamespaces {
+		if reason, ok := p.skipLinter(gvr); ok {
+			p.logger.Log(internal.DebugLog, "linter skipped", "linter", k, "reason", reason)
 			continue
 		}
 		if !p.aliases.IsNamespaced(gvr) {
@@ -281,15 +348,24 @@ func (p *Popeye) lint() (int, int, error) {
 
 	var score, count int
 	for run := range c {
+		total--
+		if total == 0 {
+			close(c)
+		}
+		if run.err != nil {
+			continue
+		}
 		count++
 		tally := report.NewTally()
 		tally.Rollup(run.outcome)
 		score, errCount = score+tally.Score(), errCount+tally.ErrCount()
-		p.builder.AddSection(run.gvr, p.aliases.Singular(run.gvr), run.outcome, tally)
-		total--
-		if total == 0 {
-			close(c)
+		if p.streamer != nil {
+			p.streamer.Push(run.gvr, run.outcome)
+			p.builder.AddStreamedSection(run.gvr, p.aliases.Singular(run.gvr), run.outcome, tally)
+		} else {
+			p.builder.AddSection(run.gvr, p.aliases.Singular(run.gvr), run.outcome, tally)
 		}
+		p.builder.SetElapsed(run.gvr, run.elapsed)
 	}
 	if count == 0 {
 		return errCount, 0, nil
@@ -298,18 +374,39 @@ func (p *Popeye) lint() (int, int, error) {
 	return errCount, score / count, nil
 }
 
+// skipLinter checks if a linter should be skipped and why. Cluster scoped
+// linters still run on namespaced scans so their findings are not hidden.
+func (p *Popeye) skipLinter(gvr types.GVR) (string, bool) {
+	if p.aliases.Exclude(gvr, p.config.Sections()) {
+		return "excluded section", true
+	}
+
+	return "", false
+}
+
 func (p *Popeye) runLinter(ctx context.Context, gvr types.GVR, l scrub.Linter, c chan run, cache *scrub.Cache, codes *issues.Codes) {
 	defer func() {
 		if e := recover(); e != nil {
-			BailOut(fmt.Errorf("%s", e))
+			err := fmt.Errorf("linter %s panicked: %v", gvr.R(), e)
+			p.logger.Log(internal.ErrorLog, "linter panicked", "linter", gvr.R(), "error", err, "stack", string(debug.Stack()))
+			p.builder.AddError(err)
+			c <- run{gvr: gvr, err: err}
 		}
 	}()
 
+	t := time.Now()
 	if err := l.Lint(ctx); err != nil {
+		p.logger.Log(internal.ErrorLog, "linter failed", "linter", gvr.R(), "error", err)
 		p.builder.AddError(err)
 	}
-	o := l.Outcome().Filter(rules.Level(p.config.LintLevel))
-	c <- run{gvr: gvr, outcome: o}
+	o, elapsed := l.Outcome().Filter(rules.Level(p.config.LintLevel)), time.Since(t)
+	p.logger.Log(internal.DebugLog, "linter completed",
+		"linter", gvr.R(),
+		"duration", elapsed.String(),
+		"preloads", len(l.Preloads()),
+		"resources", len(o),
+	)
+	c <- run{gvr: gvr, outcome: o, elapsed: elapsed}
 }
 
 func (p *Popeye) dumpJunit() error {
@@ -370,13 +467,19 @@ func (p *Popeye) dumpStd(header bool) error {
 		w = bufio.NewWriter(p.outputTarget)
 		s = report.New(w, p.flags.OutputFormat() == report.JurassicFormat)
 	)
+	report.DisableColors(!p.useColors())
 
 	if header {
 		p.builder.PrintHeader(s)
 	}
-	p.builder.PrintClusterInfo(s, p.client().HasMetrics())
+	p.builder.PrintClusterInfo(s, p.hasMetrics())
 	p.builder.PrintReport(rules.Level(p.config.LintLevel), s)
 	p.builder.PrintSummary(s)
+	if p.flags.IsVerbose() {
+		p.builder.PrintTimings(s)
+	}
+	p.builder.PrintTopIssues(s)
+	p.builder.PrintFooter(s)
 
 	return w.Flush()
 }
@@ -402,6 +505,25 @@ func (p *Popeye) client() types.Connection {
 	return p.factory.Client()
 }
 
+func (p *Popeye) hasMetrics() bool {
+	if p.flags.IsMultiContext() {
+		return p.metrics
+	}
+
+	return p.client().HasMetrics()
+}
+
+func (p *Popeye) activeNamespace() string {
+	if !p.flags.IsMultiContext() {
+		return p.client().ActiveNamespace()
+	}
+	if config.IsStrSet(p.flags.Namespace) {
+		return *p.flags.Namespace
+	}
+
+	return client.AllNamespaces
+}
+
 func (p *Popeye) dumpPrometheus(ctx context.Context, asset string, persist bool) error {
 	if !config.IsStrSet(p.flags.PushGateway.URL) {
 		return nil
@@ -415,7 +537,7 @@ func (p *Popeye) dumpPrometheus(ctx context.Context, asset string, persist bool)
 	pusher := p.builder.ToPrometheus(
 		p.flags.PushGateway,
 		instance,
-		p.client().ActiveNamespace(),
+		p.activeNamespace(),
 		asset,
 		p.codes.Glossary,
 	)
@@ -428,10 +550,43 @@ func (p *Popeye) dumpPrometheus(ctx context.Context, asset string, persist bool)
 	return pusher.AddContext(ctx)
 }
 
+func (p *Popeye) maxIssues() int {
+	if p.flags.MaxIssues == nil {
+		return 0
+	}
+
+	return *p.flags.MaxIssues
+}
+
+func (p *Popeye) apiLimits() (float32, int) {
+	var (
+		qps   float32
+		burst int
+	)
+	if p.flags.APIQPS != nil {
+		qps = *p.flags.APIQPS
+	}
+	if p.flags.APIBurst != nil {
+		burst = *p.flags.APIBurst
+	}
+
+	return qps, burst
+}
+
+func (p *Popeye) sortBy() string {
+	if !config.IsStrSet(p.flags.Sort) {
+		return report.SortByName
+	}
+
+	return *p.flags.Sort
+}
+
 func (p *Popeye) fetchClusterName() string {
 	switch {
 	case config.IsStrSet(p.flags.InClusterName):
 		return *p.flags.InClusterName
+	case p.flags.IsMultiContext():
+		return p.contextNames()
 	case p.client().ActiveCluster() != "":
 		return p.client().ActiveCluster()
 	default:
@@ -440,6 +595,9 @@ func (p *Popeye) fetchClusterName() string {
 }
 
 func (p *Popeye) fetchContextName() string {
+	if p.flags.IsMultiContext() {
+		return p.contextNames()
+	}
 	if ct := p.client().ActiveContext(); ct != "" {
 		return ct
 	}
@@ -456,6 +614,11 @@ func (p *Popeye) dump(printHeader bool, asset string) error {
 	ctx, cancel := context.WithTimeout(context.Background(), defaultGtwyTimeout)
 	defer cancel()
 	p.builder.SetClusterContext(p.fetchClusterName(), p.fetchContextName())
+	p.builder.SetMaxIssues(p.maxIssues())
+	p.builder.SetSort(p.sortBy())
+	if config.IsBoolSet(p.flags.TUI) {
+		return tui.Run(p.builder)
+	}
 	var errs error
 	switch p.flags.OutputFormat() {
 	case report.JunitFormat:
@@ -464,6 +627,8 @@ func (p *Popeye) dump(printHeader bool, asset string) error {
 		errs = errors.Join(errs, p.dumpYAML())
 	case report.JSONFormat:
 		errs = errors.Join(errs, p.dumpJSON())
+	case report.JSONLFormat:
+		// Issues were streamed out while linting.
 	case report.HTMLFormat:
 		errs = errors.Join(errs, p.dumpHTML())
 	case report.ScoreFormat:
@@ -526,7 +691,7 @@ func (p *Popeye) scanFileName() string {
 		return *p.flags.OutputFile
 	}
 
-	ns := p.client().ActiveNamespace()
+	ns := p.activeNamespace()
 	if ns == client.BlankNamespace {
 		ns = client.NamespaceAll
 	}
@@ -537,7 +702,7 @@ func (p *Popeye) fileExt() string {
 	switch *p.flags.Output {
 	case "junit":
 		return "xml"
-	case "json", "yaml", "html":
+	case "json", "jsonl", "yaml", "html":
 		return *p.flags.Output
 	default:
 		return "txt"
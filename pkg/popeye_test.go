@@ -0,0 +1,208 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Popeye
+
+package pkg
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/derailed/popeye/internal"
+	"github.com/derailed/popeye/internal/issues"
+	"github.com/derailed/popeye/internal/report"
+	"github.com/derailed/popeye/internal/rules"
+	"github.com/derailed/popeye/internal/scrub"
+	"github.com/derailed/popeye/pkg/config"
+	"github.com/derailed/popeye/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRunLinterLogs(t *testing.T) {
+	var (
+		l   captureLogger
+		gvr = types.NewGVR("v1/pods")
+		c   = make(chan run, 1)
+	)
+	p := Popeye{
+		config:  &config.Config{},
+		builder: report.NewBuilder(),
+		logger:  &l,
+	}
+	p.runLinter(context.Background(), gvr, fakeLinter{}, c, nil, nil)
+	r := <-c
+
+	assert.Equal(t, gvr, r.gvr)
+	assert.Equal(t, 1, len(l.events))
+	e := l.events[0]
+	assert.Equal(t, internal.DebugLog, e.level)
+	assert.Equal(t, "linter completed", e.msg)
+	assert.Equal(t, "linter", e.kv[0])
+	assert.Equal(t, "pods", e.kv[1])
+	assert.Equal(t, "duration", e.kv[2])
+	assert.NotEmpty(t, e.kv[3])
+	assert.Equal(t, []any{"preloads", 2, "resources", 1}, e.kv[4:])
+}
+
+func TestScannedCount(t *testing.T) {
+	p := Popeye{
+		config:  &config.Config{},
+		builder: report.NewBuilder(),
+		logger:  &captureLogger{},
+		aliases: internal.NewAliases(),
+	}
+	ll := map[types.GVR]scrub.Linter{
+		types.NewGVR("v1/pods"):     scanLinter{count: 5, flagged: 2},
+		types.NewGVR("v1/services"): scanLinter{count: 3},
+	}
+	c := make(chan run, len(ll))
+	for gvr, l := range ll {
+		p.runLinter(context.Background(), gvr, l, c, nil, nil)
+	}
+	close(c)
+	for r := range c {
+		p.builder.AddSection(r.gvr, r.gvr.R(), r.outcome, report.NewTally().Rollup(r.outcome))
+	}
+
+	assert.Equal(t, 8, p.builder.Scanned())
+	assert.Equal(t, map[string]int{"pods": 5, "services": 3}, p.builder.ScannedBy())
+	assert.Equal(t, 2, p.builder.Flagged())
+}
+
+func TestLinterTimings(t *testing.T) {
+	p := Popeye{
+		config:  &config.Config{},
+		builder: report.NewBuilder(),
+		logger:  &captureLogger{},
+		aliases: internal.NewAliases(),
+	}
+	gg := []types.GVR{types.NewGVR("v1/pods"), types.NewGVR("v1/services")}
+	c := make(chan run, len(gg))
+	for _, gvr := range gg {
+		p.runLinter(context.Background(), gvr, scanLinter{count: 1}, c, nil, nil)
+	}
+	close(c)
+	for r := range c {
+		p.builder.AddSection(r.gvr, r.gvr.R(), r.outcome, report.NewTally().Rollup(r.outcome))
+		p.builder.SetElapsed(r.gvr, r.elapsed)
+	}
+
+	tt := p.builder.Timings()
+	assert.Equal(t, len(gg), len(tt))
+	for _, gvr := range gg {
+		d, ok := tt[gvr.R()]
+		assert.True(t, ok)
+		assert.GreaterOrEqual(t, d, time.Duration(0))
+	}
+}
+
+func TestSkipLinter(t *testing.T) {
+	uu := map[string]struct {
+		ns       string
+		sections []string
+		gvr      types.GVR
+		reason   string
+		e        bool
+	}{
+		"all-namespaces": {
+			gvr: types.NewGVR("v1/nodes"),
+		},
+		"namespaced-nodes": {
+			ns:  "foo",
+			gvr: types.NewGVR("v1/nodes"),
+		},
+		"namespaced-pods": {
+			ns:  "foo",
+			gvr: types.NewGVR("v1/pods"),
+		},
+		"excluded-section": {
+			ns:       "foo",
+			sections: []string{"po"},
+			gvr:      types.NewGVR("v1/nodes"),
+			reason:   "excluded section",
+			e:        true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			flags := config.NewFlags()
+			flags.Namespace, flags.Sections = &u.ns, &u.sections
+			cfg, err := config.NewConfig(flags)
+			assert.NoError(t, err)
+			p := Popeye{config: cfg, aliases: internal.NewAliases()}
+
+			reason, ok := p.skipLinter(u.gvr)
+			assert.Equal(t, u.e, ok)
+			assert.Equal(t, u.reason, reason)
+		})
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+type logEvent struct {
+	level internal.LogLevel
+	msg   string
+	kv    []any
+}
+
+type captureLogger struct {
+	events []logEvent
+	mx     sync.Mutex
+}
+
+func (c *captureLogger) Log(l internal.LogLevel, msg string, kv ...any) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	c.events = append(c.events, logEvent{level: l, msg: msg, kv: kv})
+}
+
+type fakeLinter struct{}
+
+func (fakeLinter) MaxSeverity(string) rules.Level { return rules.ErrorLevel }
+
+func (fakeLinter) Outcome() issues.Outcome {
+	return issues.Outcome{
+		"default/p1": issues.Issues{
+			issues.New(types.NewGVR("v1/pods"), issues.Root, rules.ErrorLevel, "blah"),
+		},
+	}
+}
+
+func (fakeLinter) Lint(context.Context) error { return nil }
+
+func (fakeLinter) Preloads() scrub.Preloads {
+	return scrub.Preloads{
+		internal.PO: nil,
+		internal.NS: nil,
+	}
+}
+
+type scanLinter struct {
+	count, flagged int
+}
+
+func (scanLinter) MaxSeverity(string) rules.Level { return rules.OkLevel }
+
+func (l scanLinter) Outcome() issues.Outcome {
+	o := make(issues.Outcome, l.count)
+	for i := 0; i < l.count; i++ {
+		fqn := fmt.Sprintf("default/r%d", i)
+		o[fqn] = issues.Issues{}
+		if i < l.flagged {
+			o[fqn] = append(o[fqn], issues.New(types.NewGVR("v1/pods"), issues.Root, rules.WarnLevel, "blah"))
+		}
+	}
+
+	return o
+}
+
+func (scanLinter) Lint(context.Context) error { return nil }
+
+func (scanLinter) Preloads() scrub.Preloads { return nil }
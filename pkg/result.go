@@ -0,0 +1,23 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Popeye
+
+package pkg
+
+import (
+	"github.com/derailed/popeye/internal/report"
+)
+
+// Result represents a scan report.
+type Result = *report.Builder
+
+// Merge combines scan results from several sources into a single report.
+// Issues reported by more than one source for the same resource and code are
+// only kept once and scores are recomputed from the merged issues.
+func Merge(rr ...Result) Result {
+	return report.Merge(rr...)
+}
+
+// Result returns the latest scan result.
+func (p *Popeye) Result() Result {
+	return p.builder
+}
@@ -0,0 +1,30 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Popeye
+
+package pkg
+
+import (
+	"context"
+
+	"github.com/derailed/popeye/internal"
+	"github.com/derailed/popeye/internal/report"
+	"github.com/derailed/popeye/pkg/config"
+)
+
+// postWebhook sends the JSON scan report to the configured webhook.
+// Failures are logged and only surface when the webhook is set to fail.
+func (p *Popeye) postWebhook(b *report.Builder) error {
+	w := p.flags.Webhook
+	if w == nil || !config.IsStrSet(w.URL) || !b.HasContent() {
+		return nil
+	}
+	hook := report.NewWebhook(*w.URL, *w.Token, *w.Timeout, *w.Retries)
+	if err := hook.Post(context.Background(), b); err != nil {
+		p.logger.Log(internal.WarnLog, "webhook post failed", "url", hook.URL(), "error", err)
+		if config.IsBoolSet(w.Fail) {
+			return err
+		}
+	}
+
+	return nil
+}